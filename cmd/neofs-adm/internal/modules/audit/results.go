@@ -0,0 +1,204 @@
+package audit
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/cli/input"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/address"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	ircontrol "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+	ircontrolsrv "github.com/nspcc-dev/neofs-node/pkg/services/control/ir/server"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func listResults(cmd *cobra.Command, _ []string) error {
+	var nodeKey []byte
+	if strKey := viper.GetString(nodeFlag); strKey != "" {
+		pub, err := keys.NewPublicKeyFromString(strKey)
+		if err != nil {
+			return fmt.Errorf("invalid storage node public key in flag --%s: %w", nodeFlag, err)
+		}
+
+		nodeKey = pub.Bytes()
+	}
+
+	acc, err := unlockAccount()
+	if err != nil {
+		return err
+	}
+
+	timeout := viper.GetDuration(timeoutFlag)
+
+	cli := rawclient.New(
+		rawclient.WithNetworkAddress(viper.GetString(endpointFlag)),
+		rawclient.WithDialTimeout(timeout),
+		rawclient.WithRWTimeout(timeout),
+	)
+	defer func() {
+		if conn := cli.Conn(); conn != nil {
+			_ = conn.Close()
+		}
+	}()
+
+	var (
+		cursor []byte
+		found  bool
+	)
+
+	for {
+		body := new(ircontrol.ListAuditResultsRequest_Body)
+		body.SetEpoch(viper.GetUint64(epochFlag))
+		body.SetPublicKey(nodeKey)
+		body.SetCursor(cursor)
+
+		req := new(ircontrol.ListAuditResultsRequest)
+		req.SetBody(body)
+
+		err = ircontrolsrv.SignMessage(&acc.PrivateKey().PrivateKey, req)
+		if err != nil {
+			return fmt.Errorf("sign request: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+		resp, err := ircontrol.ListAuditResults(cli, req, rawclient.WithContext(ctx))
+		cancel()
+		if err != nil {
+			return fmt.Errorf("rpc error: %w", err)
+		}
+
+		if err = verifyResponse(resp.GetSignature(), resp.GetBody()); err != nil {
+			return err
+		}
+
+		for _, r := range resp.GetBody().GetResults() {
+			printResult(cmd, r)
+			found = true
+		}
+
+		cursor = resp.GetBody().GetCursor()
+		if len(cursor) == 0 {
+			break
+		}
+	}
+
+	if !found {
+		cmd.Println("No audit results found.")
+	}
+
+	return nil
+}
+
+func unlockAccount() (*wallet.Account, error) {
+	w, err := wallet.NewWalletFromFile(viper.GetString(walletFlag))
+	if err != nil {
+		return nil, fmt.Errorf("decode Neo wallet from file: %v", err)
+	}
+
+	var accAddr util.Uint160
+	if strAccAddr := viper.GetString(walletAccountFlag); strAccAddr != "" {
+		accAddr, err = address.StringToUint160(strAccAddr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Neo account address in flag --%s: %q", walletAccountFlag, strAccAddr)
+		}
+	} else {
+		accAddr = w.GetChangeAddress()
+	}
+
+	acc := w.GetAccount(accAddr)
+	if acc == nil {
+		return nil, fmt.Errorf("account %s not found in the wallet", address.Uint160ToString(accAddr))
+	}
+
+	prompt := fmt.Sprintf("Enter password for %s >", address.Uint160ToString(accAddr))
+	pass, err := input.ReadPassword(prompt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read account password: %v", err)
+	}
+
+	err = acc.Decrypt(pass, keys.NEP2ScryptParams())
+	if err != nil {
+		return nil, fmt.Errorf("failed to unlock the account with password: %v", err)
+	}
+
+	return acc, nil
+}
+
+func verifyResponse(sig *ircontrol.Signature, body interface{ StableMarshal([]byte) []byte }) error {
+	if sig == nil {
+		return errors.New("missing response signature")
+	}
+
+	var pubKey neofsecdsa.PublicKey
+
+	if err := pubKey.Decode(sig.GetKey()); err != nil {
+		return fmt.Errorf("decode public key from signature: %w", err)
+	}
+
+	if !neofscrypto.NewSignature(neofscrypto.ECDSA_SHA512, &pubKey, sig.GetSign()).Verify(body.StableMarshal(nil)) {
+		return errors.New("invalid response signature")
+	}
+
+	return nil
+}
+
+func printResult(cmd *cobra.Command, r *ircontrol.AuditCheckResult) {
+	status := "FAILED"
+	if r.GetPassed() {
+		status = "PASSED"
+	}
+
+	cnr := hex.EncodeToString(r.GetContainerId())
+
+	var cnrID cid.ID
+	if cnrID.Decode(r.GetContainerId()) == nil {
+		cnr = cnrID.EncodeToString()
+	}
+
+	cmd.Printf("Epoch %d, container %s, %s: %s\n", r.GetEpoch(), cnr, r.GetType(), status)
+
+	if sg := r.GetStorageGroupId(); len(sg) > 0 {
+		cmd.Printf("\tStorage group: %s\n", stringifyObjectID(sg))
+	}
+
+	if obj := r.GetObjectId(); len(obj) > 0 {
+		cmd.Printf("\tObject: %s\n", stringifyObjectID(obj))
+	}
+
+	for _, n := range r.GetNodes() {
+		cmd.Printf("\tNode: %s\n", hex.EncodeToString(n))
+	}
+
+	if reason := r.GetReason(); reason != "" {
+		cmd.Printf("\tReason: %s\n", reason)
+	}
+
+	if h := r.GetExpectedHash(); len(h) > 0 {
+		cmd.Printf("\tExpected hash: %s\n", hex.EncodeToString(h))
+	}
+
+	if h := r.GetActualHash(); len(h) > 0 {
+		cmd.Printf("\tActual hash: %s\n", hex.EncodeToString(h))
+	}
+}
+
+// stringifyObjectID returns string representation of the binary object ID,
+// or HEX if the ID is invalid.
+func stringifyObjectID(b []byte) string {
+	var id oid.ID
+	if err := id.Decode(b); err != nil {
+		return hex.EncodeToString(b)
+	}
+
+	return id.EncodeToString()
+}
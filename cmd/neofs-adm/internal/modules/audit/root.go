@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	endpointFlag      = "endpoint"
+	walletFlag        = "wallet"
+	walletAccountFlag = "account"
+	epochFlag         = "epoch"
+	nodeFlag          = "node"
+	timeoutFlag       = "timeout"
+)
+
+var (
+	// RootCmd is a root command of audit section.
+	RootCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "Section for data audit commands",
+	}
+
+	resultsCmd = &cobra.Command{
+		Use:   "results",
+		Short: "List detailed data audit results stored by the Inner Ring node",
+		Long: "List detailed results of the data audit checks (PoR, PoP and PDP) stored by the Inner Ring node. " +
+			"Results are requested through the Control service of the Inner Ring node, the request is signed " +
+			"by the wallet account which public key must be authorized in the Control service configuration.",
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+			_ = viper.BindPFlag(walletFlag, cmd.Flags().Lookup(walletFlag))
+			_ = viper.BindPFlag(walletAccountFlag, cmd.Flags().Lookup(walletAccountFlag))
+			_ = viper.BindPFlag(epochFlag, cmd.Flags().Lookup(epochFlag))
+			_ = viper.BindPFlag(nodeFlag, cmd.Flags().Lookup(nodeFlag))
+			_ = viper.BindPFlag(timeoutFlag, cmd.Flags().Lookup(timeoutFlag))
+		},
+		RunE: listResults,
+	}
+)
+
+func init() {
+	fs := resultsCmd.Flags()
+	fs.StringP(endpointFlag, "r", "", "Control service endpoint of the Inner Ring node")
+	_ = resultsCmd.MarkFlagRequired(endpointFlag)
+	fs.StringP(walletFlag, "w", "", "Path to the Neo wallet file")
+	_ = resultsCmd.MarkFlagRequired(walletFlag)
+	fs.StringP(walletAccountFlag, "a", "", "Optional Neo address of the wallet account for signing requests. "+
+		"If omitted, default change address from the wallet is used")
+	fs.Uint64(epochFlag, 0, "Epoch of the audit. If omitted, results of all stored epochs are listed")
+	fs.String(nodeFlag, "", "HEX-encoded public key of the storage node. If omitted, results for all nodes are listed")
+	fs.Duration(timeoutFlag, 15*time.Second, "Timeout for the Control service request")
+
+	RootCmd.AddCommand(resultsCmd)
+}
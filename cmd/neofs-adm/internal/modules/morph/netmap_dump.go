@@ -0,0 +1,73 @@
+package morph
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/invoker"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/unwrap"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func dumpNetmap(cmd *cobra.Command, _ []string) error {
+	filename, err := cmd.Flags().GetString(netmapDumpFlag)
+	if err != nil {
+		return fmt.Errorf("invalid filename: %w", err)
+	}
+
+	c, err := getN3Client(viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("can't create N3 client: %w", err)
+	}
+
+	inv := invoker.New(c, nil)
+
+	nnsCs, err := c.GetContractStateByID(1)
+	if err != nil {
+		return fmt.Errorf("can't get NNS contract info: %w", err)
+	}
+
+	nmHash, err := nnsResolveHash(inv, nnsCs.Hash, netmapContract+".neofs")
+	if err != nil {
+		return fmt.Errorf("can't get netmap contract hash: %w", err)
+	}
+
+	epoch, err := unwrap.Int64(inv.Call(nmHash, "epoch"))
+	if err != nil {
+		return fmt.Errorf("can't fetch current epoch from the netmap contract: %w", err)
+	}
+
+	res, err := inv.Call(nmHash, "netmap")
+	if err != nil {
+		return fmt.Errorf("can't fetch network map from the netmap contract: %w", err)
+	}
+	if res.State != "HALT" {
+		return fmt.Errorf("netmap contract returned unexpected exception: %s", res.FaultException)
+	}
+
+	nm, err := netmap.DecodeNetMap(res.Stack)
+	if err != nil {
+		return fmt.Errorf("unable to decode netmap: %w", err)
+	}
+
+	out, err := json.Marshal(placement.NetmapSnapshot{
+		Epoch: uint64(epoch),
+		Nodes: nm.Nodes(),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(filename, out, 0o640)
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("Network map of epoch %d with %d nodes saved to %s\n", epoch, len(nm.Nodes()), filename)
+
+	return nil
+}
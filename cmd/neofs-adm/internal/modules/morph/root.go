@@ -40,6 +40,7 @@ const (
 	withdrawFeeInitFlag             = "network.fee.withdraw"
 	withdrawFeeCLIFlag              = "withdraw-fee"
 	containerDumpFlag               = "dump"
+	netmapDumpFlag                  = "dump"
 	containerContractFlag           = "container-contract"
 	containerIDsFlag                = "cid"
 	refillGasAmountFlag             = "gas"
@@ -298,6 +299,16 @@ Values for unknown keys are added exactly the way they're provided, no conversio
 		},
 		RunE: listNetmapCandidatesNodes,
 	}
+	dumpNetmapCmd = &cobra.Command{
+		Use:   "dump-netmap",
+		Short: "Dump current network map to file",
+		Long:  "Dump current network map to file in format suitable for 'placement simulate' command",
+		Args:  cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: dumpNetmap,
+	}
 
 	verifiedNodesDomainCmd = &cobra.Command{
 		Use:   "verified-nodes-domain",
@@ -441,6 +452,11 @@ func init() {
 	RootCmd.AddCommand(netmapCandidatesCmd)
 	netmapCandidatesCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
 
+	RootCmd.AddCommand(dumpNetmapCmd)
+	dumpNetmapCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	dumpNetmapCmd.Flags().String(netmapDumpFlag, "", "File where to save dumped network map")
+	_ = dumpNetmapCmd.MarkFlagRequired(netmapDumpFlag)
+
 	cmd := verifiedNodesDomainAccessListCmd
 	fs := cmd.Flags()
 	fs.StringP(endpointFlag, "r", "", "NeoFS Sidechain RPC endpoint")
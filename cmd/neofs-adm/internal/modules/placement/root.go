@@ -0,0 +1,68 @@
+package placement
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	netmapFlag      = "netmap"
+	containersFlag  = "containers"
+	containerIDFlag = "cid"
+	objectsFlag     = "objects"
+	addNodesFlag    = "add-nodes"
+	removeNodesFlag = "remove-nodes"
+	policyFlag      = "policy"
+	weightsFlag     = "weights"
+	jsonFlag        = "json"
+)
+
+var (
+	// RootCmd is a root command of placement section.
+	RootCmd = &cobra.Command{
+		Use:   "placement",
+		Short: "Section for object placement commands",
+	}
+
+	simulateCmd = &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate object placement and estimate data movement",
+		Long: "Simulate placement of the container objects in the network map snapshot in the same way the storage " +
+			"nodes do and estimate per-node load and data movement caused by the hypothetical changes: added or " +
+			"removed nodes and new placement policy. Works offline with the network map dumped by " +
+			"'morph dump-netmap' and containers dumped by 'morph dump-containers'. Estimations are made for the " +
+			"deterministic sample objects assuming all objects of the container have the same size. Containers " +
+			"are weighted by the stored data sizes or object counts from the --weights file (e.g. the container " +
+			"size estimations), without it all containers are assumed to store the same amount of data.",
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(netmapFlag, cmd.Flags().Lookup(netmapFlag))
+			_ = viper.BindPFlag(containersFlag, cmd.Flags().Lookup(containersFlag))
+			_ = viper.BindPFlag(containerIDFlag, cmd.Flags().Lookup(containerIDFlag))
+			_ = viper.BindPFlag(objectsFlag, cmd.Flags().Lookup(objectsFlag))
+			_ = viper.BindPFlag(addNodesFlag, cmd.Flags().Lookup(addNodesFlag))
+			_ = viper.BindPFlag(removeNodesFlag, cmd.Flags().Lookup(removeNodesFlag))
+			_ = viper.BindPFlag(policyFlag, cmd.Flags().Lookup(policyFlag))
+			_ = viper.BindPFlag(weightsFlag, cmd.Flags().Lookup(weightsFlag))
+			_ = viper.BindPFlag(jsonFlag, cmd.Flags().Lookup(jsonFlag))
+		},
+		RunE: simulatePlacement,
+	}
+)
+
+func init() {
+	fs := simulateCmd.Flags()
+	fs.String(netmapFlag, "", "File with the network map snapshot dumped by 'morph dump-netmap'")
+	_ = simulateCmd.MarkFlagRequired(netmapFlag)
+	fs.String(containersFlag, "", "File with the containers dumped by 'morph dump-containers'")
+	_ = simulateCmd.MarkFlagRequired(containersFlag)
+	fs.StringSlice(containerIDFlag, nil, "Containers to simulate. If omitted, all dumped containers are simulated")
+	fs.Uint64(objectsFlag, 1000, "Number of the sample objects per container")
+	fs.String(addNodesFlag, "", "File with JSON array of the nodes to add, in the network map snapshot format")
+	fs.StringSlice(removeNodesFlag, nil, "HEX-encoded public keys of the nodes to remove")
+	fs.String(policyFlag, "", "New placement policy of the simulated containers, QL or JSON encoded")
+	fs.String(weightsFlag, "", "File with JSON object mapping IDs of the simulated containers to their stored data sizes or object counts")
+	fs.Bool(jsonFlag, false, "Print the report in JSON format")
+
+	RootCmd.AddCommand(simulateCmd)
+}
@@ -0,0 +1,273 @@
+package placement
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func simulatePlacement(cmd *cobra.Command, _ []string) error {
+	snapshot, err := readNetmapSnapshot(viper.GetString(netmapFlag))
+	if err != nil {
+		return err
+	}
+
+	var before, after simNetwork
+
+	before.netmap.SetEpoch(snapshot.Epoch)
+	before.netmap.SetNodes(snapshot.Nodes)
+
+	afterNodes, err := changedNodes(snapshot.Nodes)
+	if err != nil {
+		return err
+	}
+
+	after.netmap.SetEpoch(snapshot.Epoch)
+	after.netmap.SetNodes(afterNodes)
+
+	var newPolicy *netmap.PlacementPolicy
+	if s := viper.GetString(policyFlag); s != "" {
+		newPolicy, err = parsePlacementPolicy(s)
+		if err != nil {
+			return err
+		}
+	}
+
+	objects := viper.GetUint64(objectsFlag)
+	if objects == 0 {
+		return fmt.Errorf("zero number of the sample objects in flag --%s", objectsFlag)
+	}
+
+	before.containers, err = loadContainers(viper.GetString(containersFlag), viper.GetStringSlice(containerIDFlag))
+	if err != nil {
+		return err
+	}
+
+	if path := viper.GetString(weightsFlag); path != "" {
+		weights, err := readWeights(path)
+		if err != nil {
+			return err
+		}
+
+		for i := range before.containers {
+			w, ok := weights[before.containers[i].id]
+			if !ok {
+				return fmt.Errorf("missing weight of container %s", before.containers[i].id)
+			}
+
+			before.containers[i].weight = w
+		}
+	} else {
+		for i := range before.containers {
+			before.containers[i].weight = float64(objects)
+		}
+	}
+
+	after.containers = make([]simContainer, len(before.containers))
+	copy(after.containers, before.containers)
+
+	if newPolicy != nil {
+		for i := range after.containers {
+			after.containers[i].policy = *newPolicy
+		}
+	}
+
+	res := simulate(before, after, objects)
+
+	if viper.GetBool(jsonFlag) {
+		data, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encode report: %w", err)
+		}
+
+		cmd.Println(string(data))
+
+		return nil
+	}
+
+	printReport(cmd, res)
+
+	return nil
+}
+
+// changedNodes applies nodes changes requested by the command flags to the
+// given nodes.
+func changedNodes(nodes []netmap.NodeInfo) ([]netmap.NodeInfo, error) {
+	removed := make(map[string]struct{})
+
+	for _, s := range viper.GetStringSlice(removeNodesFlag) {
+		key, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %s in flag --%s: %w", s, removeNodesFlag, err)
+		}
+
+		removed[string(key)] = struct{}{}
+	}
+
+	var added []netmap.NodeInfo
+	if path := viper.GetString(addNodesFlag); path != "" {
+		var err error
+
+		added, err = readNodes(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := make([]netmap.NodeInfo, 0, len(nodes)+len(added))
+
+	for i := range nodes {
+		if _, ok := removed[string(nodes[i].PublicKey())]; ok {
+			delete(removed, string(nodes[i].PublicKey()))
+			continue
+		}
+
+		for j := range added {
+			if bytes.Equal(added[j].PublicKey(), nodes[i].PublicKey()) {
+				return nil, fmt.Errorf("added node %s is already in the network map", netmap.StringifyPublicKey(added[j]))
+			}
+		}
+
+		res = append(res, nodes[i])
+	}
+
+	for key := range removed {
+		return nil, fmt.Errorf("removed node %s is not in the network map", hex.EncodeToString([]byte(key)))
+	}
+
+	return append(res, added...), nil
+}
+
+func loadContainers(path string, ids []string) ([]simContainer, error) {
+	dumped, err := readContainers(path)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := make(map[cid.ID]struct{}, len(ids))
+
+	for i := range ids {
+		var id cid.ID
+
+		if err = id.DecodeString(ids[i]); err != nil {
+			return nil, fmt.Errorf("can't parse CID %s: %w", ids[i], err)
+		}
+
+		filter[id] = struct{}{}
+	}
+
+	res := make([]simContainer, 0, len(dumped))
+
+	for i := range dumped {
+		var c simContainer
+
+		c.id.SetSHA256(sha256.Sum256(dumped[i].Value))
+
+		if len(filter) > 0 {
+			if _, ok := filter[c.id]; !ok {
+				continue
+			}
+
+			delete(filter, c.id)
+		}
+
+		var cnr container.Container
+
+		if err = cnr.Unmarshal(dumped[i].Value); err != nil {
+			return nil, fmt.Errorf("decode container %s: %w", c.id, err)
+		}
+
+		c.policy = cnr.PlacementPolicy()
+
+		c.ecRule, err = ec.RuleFromContainer(cnr)
+		if err != nil {
+			return nil, fmt.Errorf("container %s: %w", c.id, err)
+		}
+
+		res = append(res, c)
+	}
+
+	for id := range filter {
+		return nil, fmt.Errorf("container %s is not in the dump", id)
+	}
+
+	if len(res) == 0 {
+		return nil, errors.New("no containers to simulate")
+	}
+
+	return res, nil
+}
+
+func parsePlacementPolicy(s string) (*netmap.PlacementPolicy, error) {
+	var res netmap.PlacementPolicy
+
+	if err := res.DecodeString(s); err == nil {
+		return &res, nil
+	}
+
+	if err := res.UnmarshalJSON([]byte(s)); err == nil {
+		return &res, nil
+	}
+
+	return nil, fmt.Errorf("can't parse placement policy in flag --%s", policyFlag)
+}
+
+func printReport(cmd *cobra.Command, res simReport) {
+	share := func(v, total float64) float64 {
+		if total == 0 {
+			return 0
+		}
+		return 100 * v / total
+	}
+
+	buf := bytes.NewBuffer(nil)
+	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(tw, "Node\tBefore\tAfter\tChange\t")
+	for _, n := range res.Nodes {
+		b, a := share(n.DataBefore, res.DataBefore), share(n.DataAfter, res.DataAfter)
+		_, _ = fmt.Fprintf(tw, "%s\t%.2f%%\t%.2f%%\t%+.2f%%\t\n", n.PublicKey, b, a, a-b)
+	}
+
+	_ = tw.Flush()
+	cmd.Print(buf.String())
+
+	cmd.Printf("\nContainers: %d, sample objects: %d\n", len(res.Containers), res.ObjectsTotal)
+	cmd.Printf("Objects changing holders: %d (%.2f%%)\n",
+		res.ObjectsMoved, share(float64(res.ObjectsMoved), float64(res.ObjectsTotal)))
+	cmd.Printf("Data to copy: %.2f%% of the stored data\n", share(res.DataCopied, res.DataBefore))
+	cmd.Printf("Data to remove: %.2f%% of the stored data\n", share(res.DataRemoved, res.DataBefore))
+	cmd.Printf("Stored data change: %+.2f%%\n", share(res.DataAfter-res.DataBefore, res.DataBefore))
+
+	if len(res.Containers) > 1 {
+		buf.Reset()
+
+		_, _ = fmt.Fprintln(tw, "\nContainer\tMoved objects\tCopied data\tRemoved data\t")
+		for _, c := range res.Containers {
+			perCnr := float64(res.ObjectsTotal) / float64(len(res.Containers))
+			_, _ = fmt.Fprintf(tw, "%s\t%.2f%%\t%.2f\t%.2f\t\n", c.ID,
+				share(float64(c.ObjectsMoved), perCnr), c.DataCopied, c.DataRemoved)
+		}
+
+		_ = tw.Flush()
+		cmd.Print(buf.String())
+	}
+
+	if res.PlacementErrors > 0 {
+		cmd.Printf("\nPlacement errors: %d, first ones:\n", res.PlacementErrors)
+		for _, e := range res.ErrorSamples {
+			cmd.Printf("\t%s\n", e)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package placement
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// simContainer is a container placed by the simulation.
+type simContainer struct {
+	id     cid.ID
+	policy netmap.PlacementPolicy
+	ecRule ec.Rule
+
+	// weight is the amount of data stored in the container in any units
+	// (e.g. bytes or objects), it is evenly spread over the sample objects.
+	weight float64
+}
+
+// simNetwork is a state of the network placed by the simulation.
+type simNetwork struct {
+	netmap     netmap.NetMap
+	containers []simContainer
+}
+
+// holding is a copy of the object data stored by the node: full replica or
+// the erasure-coded part.
+type holding struct {
+	node string
+	part int // -1 for the full replica
+}
+
+// simReport is a result of the simulation. Stored data is measured in the
+// container weight units spread evenly over the sample objects of the
+// container: full replica of the sample object is weight/objects, the
+// erasure-coded part is weight/objects/K.
+type simReport struct {
+	Containers      []containerReport `json:"containers"`
+	Nodes           []nodeReport      `json:"nodes"`
+	ObjectsTotal    uint64            `json:"objects_total"`
+	ObjectsMoved    uint64            `json:"objects_moved"`
+	DataBefore      float64           `json:"data_before"`
+	DataAfter       float64           `json:"data_after"`
+	DataCopied      float64           `json:"data_copied"`
+	DataRemoved     float64           `json:"data_removed"`
+	PlacementErrors uint64            `json:"placement_errors"`
+	ErrorSamples    []string          `json:"error_samples,omitempty"`
+}
+
+type containerReport struct {
+	ID           string  `json:"id"`
+	ObjectsMoved uint64  `json:"objects_moved"`
+	DataCopied   float64 `json:"data_copied"`
+	DataRemoved  float64 `json:"data_removed"`
+}
+
+type nodeReport struct {
+	PublicKey  string  `json:"public_key"`
+	DataBefore float64 `json:"data_before"`
+	DataAfter  float64 `json:"data_after"`
+}
+
+// maxReportedErrors limits the number of the placement errors kept in the
+// report.
+const maxReportedErrors = 10
+
+// simulate places the given number of the sample objects of each container
+// in both network states and compares the placements. Containers of the
+// network states correspond to each other by index, their weights are taken
+// from the current state. Sample object IDs are derived from the container
+// ID, so the simulation is deterministic.
+func simulate(before, after simNetwork, objects uint64) simReport {
+	var (
+		res       simReport
+		nodesData = make(map[string]*nodeReport)
+
+		bBefore = placement.NewNetworkMapBuilder(&before.netmap)
+		bAfter  = placement.NewNetworkMapBuilder(&after.netmap)
+	)
+
+	addNode := func(key string) *nodeReport {
+		n, ok := nodesData[key]
+		if !ok {
+			n = &nodeReport{PublicKey: key}
+			nodesData[key] = n
+		}
+		return n
+	}
+
+	for i := range before.netmap.Nodes() {
+		addNode(hex.EncodeToString(before.netmap.Nodes()[i].PublicKey()))
+	}
+
+	for i := range after.netmap.Nodes() {
+		addNode(hex.EncodeToString(after.netmap.Nodes()[i].PublicKey()))
+	}
+
+	placementErr := func(err error) {
+		res.PlacementErrors++
+		if len(res.ErrorSamples) < maxReportedErrors {
+			res.ErrorSamples = append(res.ErrorSamples, err.Error())
+		}
+	}
+
+	for i := range before.containers {
+		cnrBefore, cnrAfter := before.containers[i], after.containers[i]
+		cnrRes := containerReport{ID: cnrBefore.id.EncodeToString()}
+
+		scale := cnrBefore.weight / float64(objects)
+
+		for j := uint64(0); j < objects; j++ {
+			obj := sampleObjectID(cnrBefore.id, j)
+
+			hBefore, err := objectHoldings(bBefore, cnrBefore, obj)
+			if err != nil {
+				placementErr(fmt.Errorf("container %s, current placement: %w", cnrRes.ID, err))
+			}
+
+			hAfter, err := objectHoldings(bAfter, cnrAfter, obj)
+			if err != nil {
+				placementErr(fmt.Errorf("container %s, new placement: %w", cnrRes.ID, err))
+			}
+
+			for h, v := range hBefore {
+				v *= scale
+
+				addNode(h.node).DataBefore += v
+				res.DataBefore += v
+
+				if _, ok := hAfter[h]; !ok {
+					cnrRes.DataRemoved += v
+				}
+			}
+
+			var moved bool
+
+			for h, v := range hAfter {
+				v *= scale
+
+				addNode(h.node).DataAfter += v
+				res.DataAfter += v
+
+				if _, ok := hBefore[h]; !ok {
+					cnrRes.DataCopied += v
+					moved = true
+				}
+			}
+
+			if moved {
+				cnrRes.ObjectsMoved++
+			}
+		}
+
+		res.ObjectsTotal += objects
+		res.ObjectsMoved += cnrRes.ObjectsMoved
+		res.DataCopied += cnrRes.DataCopied
+		res.DataRemoved += cnrRes.DataRemoved
+		res.Containers = append(res.Containers, cnrRes)
+	}
+
+	res.Nodes = make([]nodeReport, 0, len(nodesData))
+	for _, n := range nodesData {
+		res.Nodes = append(res.Nodes, *n)
+	}
+
+	sort.Slice(res.Nodes, func(i, j int) bool {
+		return res.Nodes[i].PublicKey < res.Nodes[j].PublicKey
+	})
+
+	return res
+}
+
+// objectHoldings returns copies of the object data stored by the container
+// nodes in the same way the storage nodes place the objects: full replicas
+// on the first nodes of each placement vector according to the policy, or
+// the erasure-coded parts if the container has the erasure coding rule.
+func objectHoldings(b placement.Builder, cnr simContainer, obj oid.ID) (map[holding]float64, error) {
+	vs, err := b.BuildPlacement(cnr.id, &obj, cnr.policy)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cnr.ecRule.IsZero() {
+		nodes, err := ec.PartNodes(vs, cnr.ecRule)
+		if err != nil {
+			return nil, err
+		}
+
+		res := make(map[holding]float64, len(nodes))
+		for i := range nodes {
+			res[holding{node: hex.EncodeToString(nodes[i].PublicKey()), part: i}] = 1 / float64(cnr.ecRule.DataParts)
+		}
+
+		return res, nil
+	}
+
+	res := make(map[holding]float64)
+
+	for i := range vs {
+		replicas := int(cnr.policy.ReplicaNumberByIndex(i))
+		if replicas > len(vs[i]) {
+			replicas = len(vs[i])
+		}
+
+		for j := 0; j < replicas; j++ {
+			res[holding{node: hex.EncodeToString(vs[i][j].PublicKey()), part: -1}] = 1
+		}
+	}
+
+	return res, nil
+}
+
+// sampleObjectID returns n-th identifier of the sample object of the
+// container.
+func sampleObjectID(cnr cid.ID, n uint64) oid.ID {
+	buf := make([]byte, sha256.Size+8)
+	cnr.Encode(buf)
+	binary.BigEndian.PutUint64(buf[sha256.Size:], n)
+
+	return sha256.Sum256(buf)
+}
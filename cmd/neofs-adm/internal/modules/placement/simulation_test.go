@@ -0,0 +1,115 @@
+package placement
+
+import (
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func testNodes(n int) []netmap.NodeInfo {
+	res := make([]netmap.NodeInfo, n)
+	for i := range res {
+		res[i].SetPublicKey([]byte{2, byte(i)})
+		res[i].SetNetworkEndpoints("localhost")
+	}
+
+	return res
+}
+
+func testNetwork(t *testing.T, nodes []netmap.NodeInfo, policy string, cnrs []simContainer) simNetwork {
+	var res simNetwork
+
+	res.netmap.SetEpoch(1)
+	res.netmap.SetNodes(nodes)
+
+	res.containers = make([]simContainer, len(cnrs))
+	copy(res.containers, cnrs)
+
+	for i := range res.containers {
+		require.NoError(t, res.containers[i].policy.DecodeString(policy))
+	}
+
+	return res
+}
+
+func TestSimulate(t *testing.T) {
+	const objects = 100
+
+	// fixed containers make the placement changes deterministic, objects of
+	// both containers are moved to the added nodes
+	cnrs := []simContainer{
+		{id: cidtest.IDWithChecksum([32]byte{1}), weight: objects},
+		{id: cidtest.IDWithChecksum([32]byte{2}), weight: objects},
+	}
+	nodes := testNodes(8)
+
+	t.Run("unchanged", func(t *testing.T) {
+		nw := testNetwork(t, nodes, "REP 2", cnrs)
+
+		res := simulate(nw, nw, objects)
+		require.Zero(t, res.PlacementErrors)
+		require.EqualValues(t, 2*objects, res.ObjectsTotal)
+		require.Zero(t, res.ObjectsMoved)
+		require.Zero(t, res.DataCopied)
+		require.Zero(t, res.DataRemoved)
+		require.EqualValues(t, 2*2*objects, res.DataBefore)
+		require.Equal(t, res.DataBefore, res.DataAfter)
+		require.Len(t, res.Nodes, len(nodes))
+
+		for _, n := range res.Nodes {
+			require.Equal(t, n.DataBefore, n.DataAfter)
+		}
+
+		require.Equal(t, res, simulate(nw, nw, objects), "simulation must be deterministic")
+	})
+
+	t.Run("added nodes", func(t *testing.T) {
+		more := testNodes(10)
+
+		res := simulate(testNetwork(t, nodes, "REP 2", cnrs), testNetwork(t, more, "REP 2", cnrs), objects)
+		require.Zero(t, res.PlacementErrors)
+		require.NotZero(t, res.ObjectsMoved)
+		require.Equal(t, res.DataCopied, res.DataRemoved)
+		require.Equal(t, res.DataBefore, res.DataAfter)
+		require.Len(t, res.Nodes, len(more))
+
+		for _, n := range res.Nodes[len(nodes):] {
+			require.Zero(t, n.DataBefore)
+		}
+	})
+
+	t.Run("new policy", func(t *testing.T) {
+		res := simulate(testNetwork(t, nodes, "REP 2", cnrs), testNetwork(t, nodes, "REP 3", cnrs), objects)
+		require.Zero(t, res.PlacementErrors)
+		require.EqualValues(t, 2*3*objects, res.DataAfter)
+		require.Equal(t, res.DataAfter-res.DataBefore, res.DataCopied-res.DataRemoved)
+		require.EqualValues(t, 2*objects, res.ObjectsMoved)
+	})
+
+	t.Run("insufficient nodes", func(t *testing.T) {
+		res := simulate(testNetwork(t, nodes, "REP 2", cnrs), testNetwork(t, nodes[:1], "REP 2 CBF 1 SELECT 2 FROM *", cnrs), objects)
+		require.EqualValues(t, 2*objects, res.PlacementErrors)
+		require.Len(t, res.ErrorSamples, maxReportedErrors)
+		require.Zero(t, res.DataAfter)
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		weighted := []simContainer{{id: cnrs[0].id, weight: 1000}, {id: cnrs[1].id, weight: 0}}
+		more := testNodes(10)
+
+		res := simulate(testNetwork(t, nodes, "REP 2", weighted), testNetwork(t, more, "REP 2", weighted), objects)
+		require.Zero(t, res.PlacementErrors)
+		require.InDelta(t, 2*1000, res.DataBefore, 1e-6)
+		require.InDelta(t, res.DataBefore, res.DataAfter, 1e-6)
+
+		// empty container does not contribute to the data movement
+		require.NotZero(t, res.Containers[1].ObjectsMoved)
+		require.Zero(t, res.Containers[1].DataCopied)
+		require.Zero(t, res.Containers[1].DataRemoved)
+
+		unweighted := simulate(testNetwork(t, nodes, "REP 2", cnrs), testNetwork(t, more, "REP 2", cnrs), objects)
+		require.InDelta(t, 1000/float64(objects)*unweighted.Containers[0].DataCopied, res.DataCopied, 1e-6)
+	})
+}
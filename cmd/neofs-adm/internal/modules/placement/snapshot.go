@@ -0,0 +1,101 @@
+package placement
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+)
+
+// NetmapSnapshot is a network map dumped to the file by the
+// 'morph dump-netmap' command.
+type NetmapSnapshot struct {
+	Epoch uint64            `json:"epoch"`
+	Nodes []netmap.NodeInfo `json:"nodes"`
+}
+
+func readNetmapSnapshot(path string) (NetmapSnapshot, error) {
+	var res NetmapSnapshot
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return res, fmt.Errorf("read network map snapshot: %w", err)
+	}
+
+	if err = json.Unmarshal(data, &res); err != nil {
+		return res, fmt.Errorf("decode network map snapshot: %w", err)
+	}
+
+	return res, nil
+}
+
+func readNodes(path string) ([]netmap.NodeInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read nodes: %w", err)
+	}
+
+	var res []netmap.NodeInfo
+
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("decode nodes: %w", err)
+	}
+
+	return res, nil
+}
+
+// readWeights reads JSON object mapping container IDs to their weights.
+func readWeights(path string) (map[cid.ID]float64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read container weights: %w", err)
+	}
+
+	var m map[string]float64
+
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("decode container weights: %w", err)
+	}
+
+	res := make(map[cid.ID]float64, len(m))
+
+	for s, w := range m {
+		var id cid.ID
+
+		if err = id.DecodeString(s); err != nil {
+			return nil, fmt.Errorf("can't parse CID %s in container weights: %w", s, err)
+		}
+
+		if w < 0 {
+			return nil, fmt.Errorf("negative weight of container %s", s)
+		}
+
+		res[id] = w
+	}
+
+	return res, nil
+}
+
+// dumpedContainer is a container dumped to the file by the
+// 'morph dump-containers' command, only the fields used for
+// simulation are decoded.
+type dumpedContainer struct {
+	Value []byte `json:"value"`
+}
+
+func readContainers(path string) ([]dumpedContainer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read containers: %w", err)
+	}
+
+	var res []dumpedContainer
+
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("decode containers: %w", err)
+	}
+
+	return res, nil
+}
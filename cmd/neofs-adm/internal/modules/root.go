@@ -3,8 +3,10 @@ package modules
 import (
 	"os"
 
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/audit"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/config"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/morph"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/placement"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/storagecfg"
 	"github.com/nspcc-dev/neofs-node/misc"
 	"github.com/nspcc-dev/neofs-node/pkg/util/autocomplete"
@@ -37,8 +39,10 @@ func init() {
 	rootCmd.PersistentFlags().StringP(configFlag, "c", "", "Config file")
 	rootCmd.Flags().Bool("version", false, "Application version")
 
+	rootCmd.AddCommand(audit.RootCmd)
 	rootCmd.AddCommand(config.RootCmd)
 	rootCmd.AddCommand(morph.RootCmd)
+	rootCmd.AddCommand(placement.RootCmd)
 	rootCmd.AddCommand(storagecfg.RootCmd)
 
 	rootCmd.AddCommand(autocomplete.Command("neofs-adm"))
@@ -31,7 +31,11 @@ Filter consists of <typ>:<key><match><value>
     Well-known system object headers start with '$Object:' prefix.
     User defined headers start without prefix.
     Read more about filter keys at github.com/nspcc-dev/neofs-api/blob/master/proto-docs/acl.md#message-eaclrecordfilter
-  Match is '=' for matching and '!=' for non-matching filter.
+  Match is '=' for matching and '!=' for non-matching filter,
+    '>', '>=', '<' and '<=' for integer comparison and '^=' for prefix matching.
+    Extended matches are encoded into the '__NEOFS__MATCH_' prefixed filter values, so
+    the storage nodes not supporting them always apply the deny records with such
+    filters and never apply the allow ones. Plain values with this prefix are rejected.
   Value is a valid unicode string corresponding to object or request header value.
 
 Target is 
@@ -43,7 +47,8 @@ Target is
 When both '--rule' and '--file' arguments are used, '--rule' records will be placed higher in resulting extended ACL table.
 `,
 	Example: `neofs-cli acl extended create --cid EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk -f rules.txt --out table.json
-neofs-cli acl extended create --cid EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk -r 'allow get obj:Key=Value others' -r 'deny put others'`,
+neofs-cli acl extended create --cid EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk -r 'allow get obj:Key=Value others' -r 'deny put others'
+neofs-cli acl extended create --cid EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk -r 'deny get obj:Classification>=3 others' -r 'allow get obj:FilePath^=/public/ others'`,
 	Args: cobra.NoArgs,
 	Run:  createEACL,
 }
@@ -29,6 +29,32 @@ func TestParseTable(t *testing.T) {
 			rule:       "deny getrange pubkey:036410abb260bbbda89f61c0cad65a4fa15ac5cb83b3c3abf8aee403856fcf65ed",
 			jsonRecord: `{"operation":"GETRANGE","action":"DENY","filters":[],"targets":[{"role":"ROLE_UNSPECIFIED","keys":["A2QQq7Jgu72on2HAytZaT6FaxcuDs8Or+K7kA4Vvz2Xt"]}]}`,
 		},
+		{
+			name:       "valid rule with numeric filters",
+			rule:       "deny get obj:a>=3 obj:b<-1 req:c>18446744073709551616 req:d<=0 others",
+			jsonRecord: `{"operation":"GET","action":"DENY","filters":[{"headerType":"OBJECT","matchType":"STRING_NOT_EQUAL","key":"a","value":"__NEOFS__MATCH_NUM_GE:3"},{"headerType":"OBJECT","matchType":"STRING_NOT_EQUAL","key":"b","value":"__NEOFS__MATCH_NUM_LT:-1"},{"headerType":"REQUEST","matchType":"STRING_NOT_EQUAL","key":"c","value":"__NEOFS__MATCH_NUM_GT:18446744073709551616"},{"headerType":"REQUEST","matchType":"STRING_NOT_EQUAL","key":"d","value":"__NEOFS__MATCH_NUM_LE:0"}],"targets":[{"role":"OTHERS","keys":[]}]}`,
+		},
+		{
+			name:       "valid rule with prefix and inequality filters",
+			rule:       "allow get obj:FilePath^=/public/ obj:a!=b=c others",
+			jsonRecord: `{"operation":"GET","action":"ALLOW","filters":[{"headerType":"OBJECT","matchType":"STRING_EQUAL","key":"FilePath","value":"__NEOFS__MATCH_COMMON_PREFIX:/public/"},{"headerType":"OBJECT","matchType":"STRING_NOT_EQUAL","key":"a","value":"b=c"}],"targets":[{"role":"OTHERS","keys":[]}]}`,
+		},
+		{
+			name: "invalid numeric filter",
+			rule: "deny get obj:a>=b others",
+		},
+		{
+			name: "missing filter operator",
+			rule: "deny get obj:a others",
+		},
+		{
+			name: "reserved filter value",
+			rule: "allow get obj:a=__NEOFS__MATCH_NUM_GE:3 others",
+		},
+		{
+			name: "reserved constraint value",
+			rule: "deny get obj:$Object:containerID!=__NEOFS__CONSTRAINT_MAX_OPERATIONS:1 others",
+		},
 		{
 			name: "missing action",
 			rule: "get obj:a=b others",
@@ -0,0 +1,182 @@
+package extended
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/util"
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+)
+
+const (
+	evalTableFlag  = "table"
+	evalBearerFlag = "bearer"
+	evalOpFlag     = "op"
+	evalRoleFlag   = "role"
+	evalKeyFlag    = "key"
+	evalObjectFlag = "object"
+)
+
+var evalCmd = &cobra.Command{
+	Use:   "eval",
+	Short: "Evaluate extended ACL table against the request offline",
+	Long: `Evaluate extended ACL table against the request offline.
+
+The table is evaluated by the same engine the storage nodes use. The table is
+read from the file or taken from the bearer token. Request is described by the
+operation, eACL role of the sender ('user' for container owner, 'system' for
+container and Inner Ring nodes, 'others' for the rest), optional sender public
+key, X-headers and the object header. Filters by the object headers are not
+applied if the object header is not specified, like the storage nodes do for
+the objects they do not have. Records are numbered from 0 like in the
+detailed denial reasons of the storage nodes.`,
+	Example: `neofs-cli acl extended eval --table table.json --op get --role others --object header.json
+neofs-cli acl extended eval --bearer bearer.json --op put --role others --key 031a6c6fbbdf02ca351745fa86b9ba5a9452d785ac4f7fc2b7548ca2a46c4fcf4a -x Key=Value`,
+	Args: cobra.NoArgs,
+	Run:  evalEACL,
+}
+
+func init() {
+	flags := evalCmd.Flags()
+
+	flags.String(evalTableFlag, "", "Path to file with JSON or binary encoded extended ACL table")
+	flags.String(evalBearerFlag, "", "Path to file with JSON or binary encoded bearer token, its table is evaluated")
+	flags.String(evalOpFlag, "", "Object operation: 'get', 'head', 'put', 'search', 'delete', 'getrange' or 'getrangehash'")
+	flags.String(evalRoleFlag, "", "eACL role of the request sender: 'user', 'system' or 'others'")
+	flags.String(evalKeyFlag, "", "Hex-encoded public key of the request sender")
+	flags.StringSliceP(commonflags.XHeadersKey, commonflags.XHeadersShorthand, nil, commonflags.XHeadersUsage)
+	flags.String(evalObjectFlag, "", "Path to file with JSON or binary encoded header of the requested object")
+	flags.String(commonflags.CIDFlag, "", "Container ID of the requested object, overrides the one from the header")
+	flags.String(commonflags.OIDFlag, "", "ID of the requested object, overrides the one from the header")
+
+	_ = evalCmd.MarkFlagRequired(evalOpFlag)
+	_ = evalCmd.MarkFlagRequired(evalRoleFlag)
+	_ = cobra.MarkFlagFilename(flags, evalTableFlag)
+	_ = cobra.MarkFlagFilename(flags, evalBearerFlag)
+	_ = cobra.MarkFlagFilename(flags, evalObjectFlag)
+	evalCmd.MarkFlagsMutuallyExclusive(evalTableFlag, evalBearerFlag)
+}
+
+type evalHeader struct {
+	key, value string
+}
+
+func (h evalHeader) Key() string {
+	return h.key
+}
+
+func (h evalHeader) Value() string {
+	return h.value
+}
+
+func evalEACL(cmd *cobra.Command, _ []string) {
+	table := readEvalTable(cmd)
+
+	var op eacl.Operation
+	opArg, _ := cmd.Flags().GetString(evalOpFlag)
+	if !op.DecodeString(strings.ToUpper(opArg)) {
+		common.ExitOnErr(cmd, "", fmt.Errorf("invalid operation: %s", opArg))
+	}
+
+	var role eacl.Role
+	roleArg, _ := cmd.Flags().GetString(evalRoleFlag)
+	if !role.DecodeString(strings.ToUpper(roleArg)) {
+		common.ExitOnErr(cmd, "", fmt.Errorf("invalid role: %s", roleArg))
+	}
+
+	keyArg, _ := cmd.Flags().GetString(evalKeyFlag)
+	key, err := hex.DecodeString(strings.TrimPrefix(keyArg, "0x"))
+	common.ExitOnErr(cmd, "invalid sender key: %w", err)
+
+	var src eaclMatcher.StaticHeaderSource
+
+	xHeaders, _ := cmd.Flags().GetStringSlice(commonflags.XHeadersKey)
+	for i := range xHeaders {
+		k, v, found := strings.Cut(xHeaders[i], "=")
+		if !found {
+			common.ExitOnErr(cmd, "", fmt.Errorf("invalid X-Header format: %s", xHeaders[i]))
+		}
+
+		src.Request = append(src.Request, evalHeader{key: k, value: v})
+	}
+
+	src.Object = readEvalObjectHeaders(cmd)
+
+	action, record, err := eaclMatcher.Compile(*table).Match(op, role, key, func() (eacl.TypedHeaderSource, error) {
+		return src, nil
+	})
+	common.ExitOnErr(cmd, "evaluate table: %w", err)
+
+	cmd.Printf("Action: %s\n", action)
+
+	if record < 0 {
+		cmd.Println("No record matched, the request is allowed by default")
+		return
+	}
+
+	cmd.Printf("Matched record: #%d\n", record)
+
+	var matched eacl.Table
+	r := table.Records()[record]
+	matched.AddRecord(&r)
+
+	util.PrettyPrintTableEACL(cmd, &matched)
+}
+
+func readEvalTable(cmd *cobra.Command) *eacl.Table {
+	if tablePath, _ := cmd.Flags().GetString(evalTableFlag); tablePath != "" {
+		return common.ReadEACL(cmd, tablePath)
+	}
+
+	tok := common.ReadBearerToken(cmd, evalBearerFlag)
+	if tok == nil {
+		common.ExitOnErr(cmd, "", errors.New("either table or bearer token must be specified"))
+	}
+
+	table := tok.EACLTable()
+
+	return &table
+}
+
+// readEvalObjectHeaders returns the eACL headers of the requested object, nil
+// if the object header is not specified.
+func readEvalObjectHeaders(cmd *cobra.Command) []eacl.Header {
+	objPath, _ := cmd.Flags().GetString(evalObjectFlag)
+	if objPath == "" {
+		return nil
+	}
+
+	obj := object.New()
+	common.ExitOnErr(cmd, "invalid object header: %w", common.ReadBinaryOrJSON(cmd, obj, objPath))
+
+	cnr, _ := obj.ContainerID()
+	if cnrArg, _ := cmd.Flags().GetString(commonflags.CIDFlag); cnrArg != "" {
+		var id cid.ID
+		common.ExitOnErr(cmd, "invalid container ID: %w", id.DecodeString(cnrArg))
+
+		cnr = id
+	}
+
+	var id *oid.ID
+	if v, ok := obj.ID(); ok {
+		id = &v
+	}
+
+	if oidArg, _ := cmd.Flags().GetString(commonflags.OIDFlag); oidArg != "" {
+		var v oid.ID
+		common.ExitOnErr(cmd, "invalid object ID: %w", v.DecodeString(oidArg))
+
+		id = &v
+	}
+
+	return eaclMatcher.ObjectHeaders(obj, cnr, id)
+}
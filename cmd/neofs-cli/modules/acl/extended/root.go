@@ -12,4 +12,5 @@ var Cmd = &cobra.Command{
 func init() {
 	Cmd.AddCommand(createCmd)
 	Cmd.AddCommand(printEACLCmd)
+	Cmd.AddCommand(evalCmd)
 }
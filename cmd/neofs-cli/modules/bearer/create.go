@@ -11,6 +11,8 @@ import (
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/util"
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
@@ -34,6 +36,12 @@ var createCmd = &cobra.Command{
 All epoch flags can be specified relative to the current epoch with the +n syntax.
 In this case --` + commonflags.RPC + ` flag should be specified and the epoch in bearer token
 is set to current epoch + n.
+
+Token may be additionally limited by the number of operations, payload bytes,
+source networks of the requests and wall-clock validity window. These limits are
+added to the extended ACL table of the token and enforced by the storage nodes
+supporting them, each node tracks the consumption independently. Other nodes
+deny all requests with the limited token.
 `,
 	Args: cobra.NoArgs,
 	Run:  createToken,
@@ -50,6 +58,8 @@ func init() {
 	createCmd.Flags().StringP(commonflags.RPC, commonflags.RPCShorthand, commonflags.RPCDefault, commonflags.RPCUsage)
 	createCmd.Flags().Uint64P(commonflags.Lifetime, "l", 0, "Number of epochs for token to stay valid")
 
+	util.AddTokenConstraintsFlags(createCmd)
+
 	_ = cobra.MarkFlagFilename(createCmd.Flags(), eaclFlag)
 
 	_ = cobra.MarkFlagRequired(createCmd.Flags(), issuedAtFlag)
@@ -110,12 +120,18 @@ func createToken(cmd *cobra.Command, _ []string) {
 	b.SetIat(iat)
 	b.ForUser(ownerID)
 
+	table := eaclSDK.NewTable()
+
 	eaclPath, _ := cmd.Flags().GetString(eaclFlag)
 	if eaclPath != "" {
-		table := eaclSDK.NewTable()
 		raw, err := os.ReadFile(eaclPath)
 		common.ExitOnErr(cmd, "can't read extended ACL file: %w", err)
 		common.ExitOnErr(cmd, "can't parse extended ACL: %w", json.Unmarshal(raw, table))
+	}
+
+	eaclMatcher.AddTokenConstraints(table, util.ParseTokenConstraints(cmd))
+
+	if eaclPath != "" || len(table.Records()) > 0 {
 		b.SetEACLTable(*table)
 	}
 
@@ -0,0 +1,71 @@
+package bearer
+
+import (
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	sessionCli "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/session"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+const tokenFlag = "token"
+
+var revokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke bearer token",
+	Long: `Revoke bearer token.
+
+Revocation is stored in the container as an object with the ` + objectcore.AttributeRevokedToken + `
+attribute. Storage nodes reject the revoked token only if the revocation object
+is owned and signed by the token issuer (directly or within the issuer's
+session), so the command must be executed with the issuer's wallet. Nodes cache
+revocations, so they may take some time to be applied.
+`,
+	Args: cobra.NoArgs,
+	Run:  revokeToken,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		commonflags.Bind(cmd)
+	},
+}
+
+func init() {
+	ff := revokeCmd.Flags()
+
+	ff.StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, commonflags.WalletPathDefault, commonflags.WalletPathUsage)
+	ff.StringP(commonflags.Account, commonflags.AccountShorthand, commonflags.AccountDefault, commonflags.AccountUsage)
+	ff.StringP(commonflags.RPC, commonflags.RPCShorthand, commonflags.RPCDefault, commonflags.RPCUsage)
+	ff.DurationP(commonflags.Timeout, commonflags.TimeoutShorthand, commonflags.TimeoutDefault, commonflags.TimeoutUsage)
+	ff.String(tokenFlag, "", "Path to the file with JSON or binary encoded bearer token")
+	ff.String(commonflags.CIDFlag, "", "Container to store the revocation in (default: container of the token's eACL table)")
+
+	_ = cobra.MarkFlagFilename(ff, tokenFlag)
+	_ = cobra.MarkFlagRequired(ff, tokenFlag)
+	_ = cobra.MarkFlagRequired(ff, commonflags.WalletPath)
+	_ = cobra.MarkFlagRequired(ff, commonflags.RPC)
+}
+
+func revokeToken(cmd *cobra.Command, _ []string) {
+	tok := common.ReadBearerToken(cmd, tokenFlag)
+
+	var cnr cid.ID
+	if cnrArg, _ := cmd.Flags().GetString(commonflags.CIDFlag); cnrArg != "" {
+		common.ExitOnErr(cmd, "invalid container ID: %w", cnr.DecodeString(cnrArg))
+	} else {
+		var ok bool
+		if cnr, ok = tok.EACLTable().CID(); !ok {
+			common.ExitOnErr(cmd, "", errors.New("token's eACL table is not bound to the container, --"+commonflags.CIDFlag+" flag is required"))
+		}
+	}
+
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	id := objectcore.BearerTokenRevocationID(*tok)
+	obj := sessionCli.PutRevocation(ctx, cmd, key.Get(cmd), cnr, id)
+
+	cmd.Printf("Bearer token %s revoked, revocation object ID: %s\n", id, obj)
+}
@@ -0,0 +1,106 @@
+package object
+
+import (
+	"fmt"
+	"strings"
+
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/spf13/cobra"
+)
+
+const (
+	copySourceCIDFlag = "source-cid"
+	copySourceOIDFlag = "source-oid"
+)
+
+var objectCopyCmd = &cobra.Command{
+	Use:   "copy",
+	Short: "Copy object inside NeoFS",
+	Long: `Copy object inside NeoFS.
+Payload of the source object is read and stored by the storage node, it is not
+transmitted by the client. Large objects copied inside the container share the
+children of the source object instead of storing them again. Attributes of the source object are kept unless
+overridden by the specified ones. Sender must have access to read the source
+object and to put the objects into the destination container.`,
+	Args: cobra.NoArgs,
+	Run:  copyObject,
+}
+
+func initObjectCopyCmd() {
+	commonflags.Init(objectCopyCmd)
+	initFlagSession(objectCopyCmd, "PUT")
+
+	flags := objectCopyCmd.Flags()
+
+	flags.String(commonflags.CIDFlag, "", "Destination container ID")
+	_ = objectCopyCmd.MarkFlagRequired(commonflags.CIDFlag)
+
+	flags.String(copySourceCIDFlag, "", "Container ID of the source object")
+	_ = objectCopyCmd.MarkFlagRequired(copySourceCIDFlag)
+
+	flags.String(copySourceOIDFlag, "", "ID of the source object")
+	_ = objectCopyCmd.MarkFlagRequired(copySourceOIDFlag)
+
+	flags.StringSlice("attributes", []string{}, "User attributes in form of Key1=Value1,Key2=Value2")
+}
+
+func copyObject(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	var cnr, srcCnr cid.ID
+	var srcObj oid.ID
+
+	readCID(cmd, &cnr)
+
+	err := srcCnr.DecodeString(cmd.Flag(copySourceCIDFlag).Value.String())
+	common.ExitOnErr(cmd, "decode source container ID string: %w", err)
+
+	err = srcObj.DecodeString(cmd.Flag(copySourceOIDFlag).Value.String())
+	common.ExitOnErr(cmd, "decode source object ID string: %w", err)
+
+	var src oid.Address
+	src.SetContainer(srcCnr)
+	src.SetObject(srcObj)
+
+	rawAttrs, _ := cmd.Flags().GetStringSlice("attributes")
+
+	attrs := make([]object.Attribute, len(rawAttrs))
+	for i := range rawAttrs {
+		kv := strings.SplitN(rawAttrs[i], "=", 2)
+		if len(kv) != 2 {
+			common.ExitOnErr(cmd, "", fmt.Errorf("invalid attribute format: %s", rawAttrs[i]))
+		}
+		attrs[i].SetKey(kv[0])
+		attrs[i].SetValue(kv[1])
+	}
+
+	pk := key.GetOrGenerate(cmd)
+	ownerID := user.ResolveFromECDSAPublicKey(pk.PublicKey)
+
+	obj := object.New()
+	obj.SetContainerID(cnr)
+	obj.SetOwnerID(&ownerID)
+	obj.SetAttributes(attrs...)
+
+	var prm internalclient.PutObjectPrm
+	prm.SetPrivateKey(*pk)
+	ReadOrOpenSession(ctx, cmd, &prm, pk, cnr, nil)
+	Prepare(cmd, &prm)
+	prm.SetXHeaders(append(parseXHeaders(cmd), util.XHeaderCopyFrom, src.EncodeToString()))
+	prm.SetHeader(obj)
+
+	res, err := internalclient.PutObject(ctx, prm)
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	cmd.Printf("[%s] Object successfully copied\n", src)
+	cmd.Printf("  OID: %s\n  CID: %s\n", res.ID(), cnr)
+}
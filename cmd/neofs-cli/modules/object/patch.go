@@ -0,0 +1,122 @@
+package object
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/spf13/cobra"
+)
+
+var objectPatchCmd = &cobra.Command{
+	Use:   "patch",
+	Short: "Patch object in NeoFS",
+	Long: `Patch object in NeoFS.
+New version of the object is stored in the same container. Its payload is the
+payload of the patched object with the specified range replaced by the file
+contents, the file is appended to the payload if the range is omitted. Only the
+file is transmitted by the client, the rest payload is read by the storage node.
+Unchanged leading children of the big objects are shared by both versions, the
+patched object can not be deleted while such versions exist. Attributes of the
+patched object are kept unless overridden by the specified ones. Sender must
+have access to read the patched object and to put the objects into the
+container.`,
+	Args: cobra.NoArgs,
+	Run:  patchObject,
+}
+
+func initObjectPatchCmd() {
+	commonflags.Init(objectPatchCmd)
+	initFlagSession(objectPatchCmd, "PUT")
+
+	flags := objectPatchCmd.Flags()
+
+	flags.String(commonflags.CIDFlag, "", commonflags.CIDFlagUsage)
+	_ = objectPatchCmd.MarkFlagRequired(commonflags.CIDFlag)
+
+	flags.String(commonflags.OIDFlag, "", "ID of the patched object")
+	_ = objectPatchCmd.MarkFlagRequired(commonflags.OIDFlag)
+
+	flags.String(fileFlag, "", "File with the new payload of the range")
+	_ = objectPatchCmd.MarkFlagFilename(fileFlag)
+	_ = objectPatchCmd.MarkFlagRequired(fileFlag)
+
+	flags.String("range", "", "Replaced payload range in the form offset:length, appends the payload if omitted")
+	flags.StringSlice("attributes", []string{}, "User attributes in form of Key1=Value1,Key2=Value2")
+}
+
+func patchObject(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	var cnr cid.ID
+	var obj oid.ID
+
+	src := readObjectAddress(cmd, &cnr, &obj)
+
+	xHeaders := append(parseXHeaders(cmd), util.XHeaderPatchFrom, src.EncodeToString())
+
+	if rng := cmd.Flag("range").Value.String(); rng != "" {
+		off, ln, found := strings.Cut(rng, rangeSep)
+		if !found {
+			common.ExitOnErr(cmd, "", fmt.Errorf("invalid range specifier: %s", rng))
+		}
+
+		_, err := strconv.ParseUint(off, 10, 64)
+		common.ExitOnErr(cmd, "invalid range offset specifier: %w", err)
+
+		_, err = strconv.ParseUint(ln, 10, 64)
+		common.ExitOnErr(cmd, "invalid range length specifier: %w", err)
+
+		xHeaders = append(xHeaders, util.XHeaderPatchRange, rng)
+	}
+
+	rawAttrs, _ := cmd.Flags().GetStringSlice("attributes")
+
+	attrs := make([]object.Attribute, len(rawAttrs))
+	for i := range rawAttrs {
+		kv := strings.SplitN(rawAttrs[i], "=", 2)
+		if len(kv) != 2 {
+			common.ExitOnErr(cmd, "", fmt.Errorf("invalid attribute format: %s", rawAttrs[i]))
+		}
+		attrs[i].SetKey(kv[0])
+		attrs[i].SetValue(kv[1])
+	}
+
+	filename, _ := cmd.Flags().GetString(fileFlag)
+	f, err := os.Open(filename)
+	common.ExitOnErr(cmd, "can't open file: %w", err)
+	defer f.Close()
+
+	pk := key.GetOrGenerate(cmd)
+	ownerID := user.ResolveFromECDSAPublicKey(pk.PublicKey)
+
+	hdr := object.New()
+	hdr.SetContainerID(cnr)
+	hdr.SetOwnerID(&ownerID)
+	hdr.SetAttributes(attrs...)
+
+	var prm internalclient.PutObjectPrm
+	prm.SetPrivateKey(*pk)
+	ReadOrOpenSession(ctx, cmd, &prm, pk, cnr, nil)
+	Prepare(cmd, &prm)
+	prm.SetXHeaders(xHeaders)
+	prm.SetHeader(hdr)
+	prm.SetPayloadReader(f)
+
+	res, err := internalclient.PutObject(ctx, prm)
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	cmd.Printf("[%s] Object successfully patched\n", src)
+	cmd.Printf("  OID: %s\n  CID: %s\n", res.ID(), cnr)
+}
@@ -27,7 +27,9 @@ func init() {
 		objectHeadCmd,
 		objectHashCmd,
 		objectRangeCmd,
-		objectLockCmd}
+		objectLockCmd,
+		objectCopyCmd,
+		objectPatchCmd}
 
 	Cmd.AddCommand(objectNodesCmd)
 	Cmd.AddCommand(objectRPCs...)
@@ -46,4 +48,6 @@ func init() {
 	initObjectRangeCmd()
 	initCommandObjectLock()
 	initObjectNodesCmd()
+	initObjectCopyCmd()
+	initObjectPatchCmd()
 }
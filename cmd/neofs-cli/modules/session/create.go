@@ -13,7 +13,9 @@ import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/util"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
@@ -35,6 +37,10 @@ var createCmd = &cobra.Command{
 
 Default lifetime of session token is ` + strconv.Itoa(defaultLifetime) + ` epochs 
 if none of --` + commonflags.ExpireAt + ` or --` + commonflags.Lifetime + ` flags is specified. 
+
+Session may be additionally limited by the number of operations, payload bytes,
+source networks of the requests and wall-clock validity window. These limits are
+enforced by the storage node creating the session if it supports them.
 `,
 	Args: cobra.NoArgs,
 	Run:  createSession,
@@ -52,6 +58,7 @@ func init() {
 	createCmd.Flags().Bool(jsonFlag, false, "Output token in JSON")
 	createCmd.Flags().StringP(commonflags.RPC, commonflags.RPCShorthand, commonflags.RPCDefault, commonflags.RPCUsage)
 	createCmd.Flags().Uint64P(commonflags.ExpireAt, "e", 0, "The last active epoch for token to stay valid")
+	util.AddTokenConstraintsFlags(createCmd)
 
 	_ = cobra.MarkFlagRequired(createCmd.Flags(), commonflags.WalletPath)
 	_ = cobra.MarkFlagRequired(createCmd.Flags(), outFlag)
@@ -84,7 +91,7 @@ func createSession(cmd *cobra.Command, _ []string) {
 		common.ExitOnErr(cmd, "", errors.New("expiration epoch must be greater than current epoch"))
 	}
 	var tok session.Object
-	err = CreateSession(ctx, &tok, c, *privKey, exp, currEpoch)
+	err = createLimitedSession(ctx, &tok, c, *privKey, exp, currEpoch, util.ParseTokenConstraints(cmd))
 	common.ExitOnErr(cmd, "can't create session: %w", err)
 
 	var data []byte
@@ -107,11 +114,26 @@ func createSession(cmd *cobra.Command, _ []string) {
 //
 // Fills ID, lifetime and session key.
 func CreateSession(ctx context.Context, dst *session.Object, c *client.Client, key ecdsa.PrivateKey, expireAt uint64, currEpoch uint64) error {
+	return createLimitedSession(ctx, dst, c, key, expireAt, currEpoch, eaclMatcher.TokenConstraints{})
+}
+
+// createLimitedSession is CreateSession with the session limited by the
+// given constraints.
+func createLimitedSession(ctx context.Context, dst *session.Object, c *client.Client, key ecdsa.PrivateKey, expireAt uint64, currEpoch uint64, constraints eaclMatcher.TokenConstraints) error {
 	var sessionPrm internalclient.CreateSessionPrm
 	sessionPrm.SetClient(c)
 	sessionPrm.SetExp(expireAt)
 	sessionPrm.SetPrivateKey(key)
 
+	if kv := constraints.Encode(); len(kv) > 0 {
+		xs := make([]string, 0, 2*len(kv))
+		for k, v := range kv {
+			xs = append(xs, k, v)
+		}
+
+		sessionPrm.WithXHeaders(xs...)
+	}
+
 	sessionRes, err := internalclient.CreateSession(ctx, sessionPrm)
 	if err != nil {
 		return fmt.Errorf("can't open session: %w", err)
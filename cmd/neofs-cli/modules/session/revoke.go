@@ -0,0 +1,124 @@
+package session
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const tokenFlag = "token"
+
+var revokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke object session token",
+	Long: `Revoke object session token.
+
+Revocation is stored in the container as an object with the ` + objectcore.AttributeRevokedToken + `
+attribute. Storage nodes reject the revoked token only if the revocation object
+is owned and signed by the token issuer (directly or within the issuer's
+session), so the command must be executed with the issuer's wallet. Nodes cache
+revocations, so they may take some time to be applied.
+`,
+	Args: cobra.NoArgs,
+	Run:  revokeSession,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		commonflags.Bind(cmd)
+	},
+}
+
+func init() {
+	initRevokeFlags(revokeCmd)
+
+	revokeCmd.Flags().String(tokenFlag, "", "Path to the file with JSON or binary encoded session token")
+	_ = cobra.MarkFlagFilename(revokeCmd.Flags(), tokenFlag)
+	_ = cobra.MarkFlagRequired(revokeCmd.Flags(), tokenFlag)
+
+	revokeCmd.Flags().String(commonflags.CIDFlag, "", "Container the token is bound to, the revocation is stored in it")
+	_ = cobra.MarkFlagRequired(revokeCmd.Flags(), commonflags.CIDFlag)
+}
+
+// initRevokeFlags initializes flags of the commands storing the revocations.
+func initRevokeFlags(cmd *cobra.Command) {
+	ff := cmd.Flags()
+
+	ff.StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, commonflags.WalletPathDefault, commonflags.WalletPathUsage)
+	ff.StringP(commonflags.Account, commonflags.AccountShorthand, commonflags.AccountDefault, commonflags.AccountUsage)
+	ff.StringP(commonflags.RPC, commonflags.RPCShorthand, commonflags.RPCDefault, commonflags.RPCUsage)
+	ff.DurationP(commonflags.Timeout, commonflags.TimeoutShorthand, commonflags.TimeoutDefault, commonflags.TimeoutUsage)
+
+	_ = cobra.MarkFlagRequired(ff, commonflags.WalletPath)
+	_ = cobra.MarkFlagRequired(ff, commonflags.RPC)
+}
+
+func revokeSession(cmd *cobra.Command, _ []string) {
+	var tok session.Object
+
+	tokPath, _ := cmd.Flags().GetString(tokenFlag)
+	common.ExitOnErr(cmd, "read session token: %w", common.ReadBinaryOrJSON(cmd, &tok, tokPath))
+
+	var cnr cid.ID
+	cnrArg, _ := cmd.Flags().GetString(commonflags.CIDFlag)
+	common.ExitOnErr(cmd, "invalid container ID: %w", cnr.DecodeString(cnrArg))
+
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	id := objectcore.SessionTokenRevocationID(tok)
+	obj := PutRevocation(ctx, cmd, key.Get(cmd), cnr, id)
+
+	cmd.Printf("Session token %s revoked, revocation object ID: %s\n", id, obj)
+}
+
+// PutRevocation stores the revocation of the token with the given ID in the
+// container on behalf of the key owner. Returns ID of the revocation object.
+func PutRevocation(ctx context.Context, cmd *cobra.Command, key *ecdsa.PrivateKey, cnr cid.ID, id string) oid.ID {
+	cli := internalclient.GetSDKClientByFlag(ctx, cmd, commonflags.RPC)
+
+	currEpoch, err := internalclient.GetCurrentEpoch(ctx, viper.GetString(commonflags.RPC))
+	common.ExitOnErr(cmd, "can't get current epoch: %w", err)
+
+	const sessionLifetime = 10 // in NeoFS epochs
+
+	var tok session.Object
+	err = CreateSession(ctx, &tok, cli, *key, currEpoch+sessionLifetime, currEpoch)
+	common.ExitOnErr(cmd, "open remote session: %w", err)
+
+	tok.ForVerb(session.VerbObjectPut)
+	tok.BindContainer(cnr)
+	common.ExitOnErr(cmd, "sign session: %w", tok.Sign(user.NewAutoIDSigner(*key)))
+
+	owner := user.ResolveFromECDSAPublicKey(key.PublicKey)
+
+	var attr objectSDK.Attribute
+	attr.SetKey(objectcore.AttributeRevokedToken)
+	attr.SetValue(id)
+
+	obj := objectSDK.New()
+	obj.SetContainerID(cnr)
+	obj.SetOwnerID(&owner)
+	obj.SetAttributes(attr)
+	obj.SetPayload([]byte{})
+
+	var prm internalclient.PutObjectPrm
+	prm.SetClient(cli)
+	prm.SetPrivateKey(*key)
+	prm.SetSessionToken(&tok)
+	prm.SetHeader(obj)
+
+	res, err := internalclient.PutObject(ctx, prm)
+	common.ExitOnErr(cmd, "store revocation object in NeoFS: %w", err)
+
+	return res.ID()
+}
@@ -0,0 +1,104 @@
+package tree
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/services/tree"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export tree operation log to a file",
+	Long: `Export tree operation log to a file in a portable versioned format.
+The file can be imported with 'neofs-cli tree import' or 'neofs-lens pilorama import'.`,
+	Args: cobra.NoArgs,
+	Run:  export,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		commonflags.Bind(cmd)
+	},
+}
+
+func initExportCmd() {
+	commonflags.Init(exportCmd)
+	initCTID(exportCmd)
+
+	ff := exportCmd.Flags()
+	ff.String(fileFlagKey, "", "File to save the tree to")
+	_ = exportCmd.MarkFlagFilename(fileFlagKey)
+	_ = exportCmd.MarkFlagRequired(fileFlagKey)
+
+	_ = cobra.MarkFlagRequired(ff, commonflags.RPC)
+}
+
+func export(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	cidString, _ := cmd.Flags().GetString(commonflags.CIDFlag)
+
+	var cnr cid.ID
+	err := cnr.DecodeString(cidString)
+	common.ExitOnErr(cmd, "decode container ID string: %w", err)
+
+	tid, _ := cmd.Flags().GetString(treeIDFlagKey)
+	path, _ := cmd.Flags().GetString(fileFlagKey)
+
+	cli, err := _client(ctx)
+	common.ExitOnErr(cmd, "client: %w", err)
+
+	rawCID := make([]byte, sha256.Size)
+	cnr.Encode(rawCID)
+
+	req := &tree.GetOpLogRequest{
+		Body: &tree.GetOpLogRequest_Body{
+			ContainerId: rawCID,
+			TreeId:      tid,
+		},
+	}
+
+	// nodes with mutual TLS authentication enabled require requests
+	// from the clients without node certificate to be signed
+	common.ExitOnErr(cmd, "message signing: %w", tree.SignMessage(req, key.Get(cmd)))
+
+	stream, err := cli.GetOpLog(ctx, req)
+	common.ExitOnErr(cmd, "rpc call: %w", err)
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	common.ExitOnErr(cmd, "create file: %w", err)
+	defer f.Close()
+
+	w, err := pilorama.NewExportWriter(f, cnr, tid)
+	common.ExitOnErr(cmd, "write header: %w", err)
+
+	var n int
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		common.ExitOnErr(cmd, "receive operation: %w", err)
+
+		lm := resp.GetBody().GetOperation()
+
+		var m pilorama.Move
+		m.Parent = lm.GetParentId()
+		m.Child = lm.GetChildId()
+		common.ExitOnErr(cmd, "decode operation meta: %w", m.Meta.FromBytes(lm.GetMeta()))
+
+		common.ExitOnErr(cmd, "write operation: %w", w.WriteOp(&m))
+		n++
+	}
+
+	common.ExitOnErr(cmd, "finish export: %w", w.Close())
+
+	cmd.Printf("Exported %d operations.\n", n)
+}
@@ -0,0 +1,103 @@
+package tree
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/services/tree"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import tree operation log from a file",
+	Long: `Import tree operation log made by 'neofs-cli tree export' or 'neofs-lens pilorama export'.
+Operations are replayed into the tree with the given container and tree IDs
+through the replication API, so the requests must be signed with the key of
+one of the container nodes. Other container nodes receive the tree with the
+background synchronization.`,
+	Args: cobra.NoArgs,
+	Run:  importTree,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		commonflags.Bind(cmd)
+	},
+}
+
+func initImportCmd() {
+	commonflags.Init(importCmd)
+	initCTID(importCmd)
+
+	ff := importCmd.Flags()
+	ff.String(fileFlagKey, "", "File to read the tree from")
+	_ = importCmd.MarkFlagFilename(fileFlagKey)
+	_ = importCmd.MarkFlagRequired(fileFlagKey)
+
+	_ = cobra.MarkFlagRequired(ff, commonflags.RPC)
+}
+
+func importTree(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	pk := key.Get(cmd)
+
+	cidString, _ := cmd.Flags().GetString(commonflags.CIDFlag)
+
+	var cnr cid.ID
+	err := cnr.DecodeString(cidString)
+	common.ExitOnErr(cmd, "decode container ID string: %w", err)
+
+	tid, _ := cmd.Flags().GetString(treeIDFlagKey)
+	path, _ := cmd.Flags().GetString(fileFlagKey)
+
+	f, err := os.Open(path)
+	common.ExitOnErr(cmd, "open file: %w", err)
+	defer f.Close()
+
+	r, err := pilorama.NewExportReader(f)
+	common.ExitOnErr(cmd, "read header: %w", err)
+
+	cli, err := _client(ctx)
+	common.ExitOnErr(cmd, "client: %w", err)
+
+	rawCID := make([]byte, sha256.Size)
+	cnr.Encode(rawCID)
+
+	var n int
+	for {
+		var m pilorama.Move
+
+		err := r.ReadOp(&m)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		common.ExitOnErr(cmd, "read operation: %w", err)
+
+		req := &tree.ApplyRequest{
+			Body: &tree.ApplyRequest_Body{
+				ContainerId: rawCID,
+				TreeId:      tid,
+				Operation: &tree.LogMove{
+					ParentId: m.Parent,
+					Meta:     m.Meta.Bytes(),
+					ChildId:  m.Child,
+				},
+			},
+		}
+
+		common.ExitOnErr(cmd, "message signing: %w", tree.SignMessage(req, pk))
+
+		_, err = cli.Apply(ctx, req)
+		common.ExitOnErr(cmd, "rpc call: %w", err)
+		n++
+	}
+
+	cmd.Printf("Imported %d operations.\n", n)
+}
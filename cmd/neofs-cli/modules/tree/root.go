@@ -15,11 +15,15 @@ func init() {
 	Cmd.AddCommand(getByPathCmd)
 	Cmd.AddCommand(addByPathCmd)
 	Cmd.AddCommand(listCmd)
+	Cmd.AddCommand(exportCmd)
+	Cmd.AddCommand(importCmd)
 
 	initAddCmd()
 	initGetByPathCmd()
 	initAddByPathCmd()
 	initListCmd()
+	initExportCmd()
+	initImportCmd()
 }
 
 const (
@@ -32,6 +36,8 @@ const (
 	pathAttributeFlagKey = "pattr"
 
 	latestOnlyFlagKey = "latest"
+
+	fileFlagKey = "file"
 )
 
 func initCTID(cmd *cobra.Command) {
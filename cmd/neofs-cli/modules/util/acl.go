@@ -11,6 +11,7 @@ import (
 
 	"github.com/flynn-archive/go-shlex"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
 	"github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/olekukonko/tablewriter"
@@ -135,15 +136,22 @@ func eaclFiltersToString(fs []eacl.Filter) string {
 
 		_, _ = tw.Write([]byte(f.Key()))
 
-		switch f.Matcher() {
-		case eacl.MatchStringEqual:
-			_, _ = tw.Write([]byte("\t==\t"))
-		case eacl.MatchStringNotEqual:
-			_, _ = tw.Write([]byte("\t!=\t"))
-		case eacl.MatchUnknown:
+		value := f.Value()
+
+		if m, operand, ok := eaclMatcher.DecodeFilter(f); ok {
+			_, _ = tw.Write([]byte("\t" + extendedMatchSymbols[m] + "\t"))
+			value = operand
+		} else {
+			switch f.Matcher() {
+			case eacl.MatchStringEqual:
+				_, _ = tw.Write([]byte("\t==\t"))
+			case eacl.MatchStringNotEqual:
+				_, _ = tw.Write([]byte("\t!=\t"))
+			case eacl.MatchUnknown:
+			}
 		}
 
-		_, _ = tw.Write([]byte(f.Value() + "\t"))
+		_, _ = tw.Write([]byte(value + "\t"))
 		_, _ = tw.Write([]byte("\n"))
 	}
 
@@ -208,7 +216,7 @@ func parseEACLTable(tb *eacl.Table, args []string) error {
 		return err
 	}
 
-	r, err := parseEACLRecord(args[2:])
+	r, err := parseEACLRecord(action, args[2:])
 	if err != nil {
 		return err
 	}
@@ -224,7 +232,7 @@ func parseEACLTable(tb *eacl.Table, args []string) error {
 	return nil
 }
 
-func parseEACLRecord(args []string) (*eacl.Record, error) {
+func parseEACLRecord(action eacl.Action, args []string) (*eacl.Record, error) {
 	r := new(eacl.Record)
 	for i := range args {
 		ss := strings.SplitN(args[i], ":", 2)
@@ -235,24 +243,11 @@ func parseEACLRecord(args []string) (*eacl.Record, error) {
 				return nil, fmt.Errorf("invalid filter or target: %s", args[i])
 			}
 
-			i := strings.Index(ss[1], "=")
-			if i < 0 {
-				return nil, fmt.Errorf("invalid filter key-value pair: %s", ss[1])
-			}
-
-			var key, value string
-			var op eacl.Match
-
-			if 0 < i && ss[1][i-1] == '!' {
-				key = ss[1][:i-1]
-				op = eacl.MatchStringNotEqual
-			} else {
-				key = ss[1][:i]
-				op = eacl.MatchStringEqual
+			op, key, value, err := parseEACLFilter(action, ss[1])
+			if err != nil {
+				return nil, err
 			}
 
-			value = ss[1][i+1:]
-
 			typ := eacl.HeaderFromRequest
 			if ss[0] == "obj" {
 				typ = eacl.HeaderFromObject
@@ -288,6 +283,83 @@ func parseEACLRecord(args []string) (*eacl.Record, error) {
 	return r, nil
 }
 
+// extendedMatchSymbols are the filter operators of the extended eACL
+// matches.
+var extendedMatchSymbols = map[eaclMatcher.Match]string{
+	eaclMatcher.MatchNumGT:        ">",
+	eaclMatcher.MatchNumGE:        ">=",
+	eaclMatcher.MatchNumLT:        "<",
+	eaclMatcher.MatchNumLE:        "<=",
+	eaclMatcher.MatchCommonPrefix: "^=",
+}
+
+// parseEACLFilter parses the filter in <key><operator><value> form of the
+// record with the given action. Extended operators are encoded into the
+// filter values, see eaclMatcher.Match.
+func parseEACLFilter(action eacl.Action, s string) (eacl.Match, string, string, error) {
+	i := strings.IndexAny(s, "=<>")
+	if i < 0 {
+		return 0, "", "", fmt.Errorf("invalid filter key-value pair: %s", s)
+	}
+
+	key, opEnd := s[:i], i+1
+
+	var ext eaclMatcher.Match
+
+	switch s[i] {
+	case '=':
+		match := eacl.MatchStringEqual
+
+		if i > 0 {
+			switch s[i-1] {
+			case '!':
+				key, match = s[:i-1], eacl.MatchStringNotEqual
+			case '^':
+				key, ext = s[:i-1], eaclMatcher.MatchCommonPrefix
+			}
+		}
+
+		if ext == 0 {
+			value := s[i+1:]
+			for _, prefix := range []string{eaclMatcher.ExtendedMatchPrefix, eaclMatcher.ConstraintPrefix} {
+				if strings.HasPrefix(value, prefix) {
+					return 0, "", "", fmt.Errorf("filter value prefix %s is reserved: %s", prefix, s)
+				}
+			}
+
+			return match, key, value, nil
+		}
+	case '>', '<':
+		orEqual := i+1 < len(s) && s[i+1] == '='
+		if orEqual {
+			opEnd++
+		}
+
+		switch {
+		case s[i] == '>' && orEqual:
+			ext = eaclMatcher.MatchNumGE
+		case s[i] == '>':
+			ext = eaclMatcher.MatchNumGT
+		case orEqual:
+			ext = eaclMatcher.MatchNumLE
+		default:
+			ext = eaclMatcher.MatchNumLT
+		}
+	}
+
+	value := s[opEnd:]
+
+	if ext.IsNumeric() {
+		if _, ok := eaclMatcher.ParseNumber(value); !ok {
+			return 0, "", "", fmt.Errorf("invalid integer in the numeric filter: %s", s)
+		}
+	}
+
+	match, value := eaclMatcher.EncodeFilter(action, ext, value)
+
+	return match, key, value, nil
+}
+
 // eaclRoleFromString parses eacl.Role from string.
 func eaclRoleFromString(s string) (eacl.Role, error) {
 	var r eacl.Role
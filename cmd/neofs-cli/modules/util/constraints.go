@@ -0,0 +1,66 @@
+package util
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
+	"github.com/spf13/cobra"
+)
+
+const (
+	maxOperationsFlag  = "max-operations"
+	maxBytesFlag       = "max-bytes"
+	sourceNetworksFlag = "source-networks"
+	notBeforeTimeFlag  = "not-before-time"
+	notAfterTimeFlag   = "not-after-time"
+)
+
+// AddTokenConstraintsFlags adds the flags of the token constraints to the
+// command, see ParseTokenConstraints.
+func AddTokenConstraintsFlags(cmd *cobra.Command) {
+	ff := cmd.Flags()
+
+	ff.Uint64(maxOperationsFlag, 0, "Maximum number of operations with the token per node (default: unlimited)")
+	ff.Uint64(maxBytesFlag, 0, "Maximum number of payload bytes read and written with the token per node (default: unlimited)")
+	ff.StringSlice(sourceNetworksFlag, nil, "Networks in CIDR notation the token can be used from (default: any)")
+	ff.String(notBeforeTimeFlag, "", "Time in RFC3339 format the token is valid from")
+	ff.String(notAfterTimeFlag, "", "Time in RFC3339 format the token is valid until")
+}
+
+// ParseTokenConstraints parses the token constraints from the flags added by
+// AddTokenConstraintsFlags.
+func ParseTokenConstraints(cmd *cobra.Command) eaclMatcher.TokenConstraints {
+	var c eaclMatcher.TokenConstraints
+
+	c.Limit.Operations, _ = cmd.Flags().GetUint64(maxOperationsFlag)
+	c.Limit.Bytes, _ = cmd.Flags().GetUint64(maxBytesFlag)
+
+	nets, _ := cmd.Flags().GetStringSlice(sourceNetworksFlag)
+	for i := range nets {
+		_, n, err := net.ParseCIDR(nets[i])
+		common.ExitOnErr(cmd, "can't parse --"+sourceNetworksFlag+" flag: %w", err)
+
+		c.SourceNetworks = append(c.SourceNetworks, n)
+	}
+
+	for flag, dst := range map[string]*time.Time{
+		notBeforeTimeFlag: &c.NotBefore,
+		notAfterTimeFlag:  &c.NotAfter,
+	} {
+		if v, _ := cmd.Flags().GetString(flag); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			common.ExitOnErr(cmd, "can't parse --"+flag+" flag: %w", err)
+
+			*dst = t
+		}
+	}
+
+	if !c.NotBefore.IsZero() && !c.NotAfter.IsZero() && c.NotAfter.Before(c.NotBefore) {
+		common.ExitOnErr(cmd, "", fmt.Errorf("--%s is before --%s", notAfterTimeFlag, notBeforeTimeFlag))
+	}
+
+	return c
+}
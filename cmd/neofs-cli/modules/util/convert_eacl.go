@@ -11,8 +11,17 @@ import (
 var convertEACLCmd = &cobra.Command{
 	Use:   "eacl",
 	Short: "Convert representation of extended ACL table",
-	Args:  cobra.NoArgs,
-	Run:   convertEACLTable,
+	Long: `Convert representation of extended ACL table.
+
+Filters with the extended matches ('>', '>=', '<', '<=' and '^=' in the rules of
+'acl extended create') are kept with the values encoded as
+'__NEOFS__MATCH_<operation>:<operand>', where operation is NUM_GT, NUM_GE,
+NUM_LT, NUM_LE or COMMON_PREFIX. Such values may be written in JSON manually:
+the match type must be STRING_NOT_EQUAL in the DENY records and STRING_EQUAL in
+the other ones, otherwise the filter always matches in the DENY records and
+never matches in the other ones.`,
+	Args: cobra.NoArgs,
+	Run:  convertEACLTable,
 }
 
 func initConvertEACLCmd() {
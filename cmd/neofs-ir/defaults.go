@@ -60,6 +60,9 @@ func defaultConfiguration(cfg *viper.Viper) {
 	cfg.SetDefault("wallet.address", "")  // account address
 	cfg.SetDefault("wallet.password", "") // password
 
+	cfg.SetDefault("signer.agent", "")     // external signing agent address
+	cfg.SetDefault("signer.timeout", "5s") // signing agent request timeout
+
 	cfg.SetDefault("timers.emit", "0")
 	cfg.SetDefault("timers.stop_estimation.mul", 1)
 	cfg.SetDefault("timers.stop_estimation.div", 4)
@@ -77,6 +80,15 @@ func defaultConfiguration(cfg *viper.Viper) {
 
 	cfg.SetDefault("netmap_cleaner.enabled", true)
 	cfg.SetDefault("netmap_cleaner.threshold", 3)
+	cfg.SetDefault("netmap_cleaner.probe.enabled", false)
+	cfg.SetDefault("netmap_cleaner.probe.interval", "1m")
+	cfg.SetDefault("netmap_cleaner.probe.timeout", "5s")
+	cfg.SetDefault("netmap_cleaner.probe.max_latency", "0s")
+	cfg.SetDefault("netmap_cleaner.probe.pool_size", 10)
+	cfg.SetDefault("netmap_cleaner.probe.objects", []string{})
+	cfg.SetDefault("netmap_cleaner.probe.window", 30)
+	cfg.SetDefault("netmap_cleaner.probe.maintenance_threshold", 0.5)
+	cfg.SetDefault("netmap_cleaner.probe.offline_threshold", 0.2)
 
 	cfg.SetDefault("emit.storage.amount", 0)
 	cfg.SetDefault("emit.mint.cache_size", 1000)
@@ -93,6 +105,7 @@ func defaultConfiguration(cfg *viper.Viper) {
 	cfg.SetDefault("audit.pdp.max_sleep_interval", "5s")
 	cfg.SetDefault("audit.pdp.pairs_pool_size", "10")
 	cfg.SetDefault("audit.por.pool_size", "10")
+	cfg.SetDefault("audit.results.epochs", 100)
 
 	cfg.SetDefault("settlement.basic_income_rate", 0)
 	cfg.SetDefault("settlement.audit_fee", 0)
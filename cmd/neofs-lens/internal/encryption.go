@@ -0,0 +1,48 @@
+package common
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+)
+
+// Cipher returns the cipher decrypting objects of the encrypted shard using
+// the data key stored in the keyPath file and wrapped by the master key.
+// Returns nil if keyPath is empty.
+func Cipher(masterKey []byte, keyPath string) (*encryption.Cipher, error) {
+	if keyPath == "" {
+		return nil, nil
+	}
+
+	if masterKey == nil {
+		return nil, errors.New("blobstor encryption master key is required for the encrypted shard")
+	}
+
+	key, err := encryption.LoadDataKey(keyPath, masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("load data key: %w", err)
+	}
+
+	return encryption.NewCipher(key)
+}
+
+// DecodeMasterKey decodes hex-encoded blobstor encryption master key. Returns
+// nil if s is empty.
+func DecodeMasterKey(s string) ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	key, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decode master key from hex: %w", err)
+	}
+
+	if len(key) != encryption.KeySize {
+		return nil, fmt.Errorf("invalid master key size %d, expected %d", len(key), encryption.KeySize)
+	}
+
+	return key, nil
+}
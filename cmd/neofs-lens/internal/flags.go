@@ -10,6 +10,8 @@ const (
 	flagOutFile    = "out"
 	flagConfigFile = "config"
 	flagInFile     = "obj"
+	flagMasterKey  = "master-key"
+	flagDataKey    = "data-key"
 )
 
 // AddAddressFlag adds the address flag to the passed cobra command.
@@ -55,3 +57,18 @@ func AddInputPathFile(cmd *cobra.Command, v *string) {
 	_ = cmd.MarkFlagFilename(flagInFile)
 	_ = cmd.MarkFlagRequired(flagInFile)
 }
+
+// AddMasterKeyFlag adds the blobstor encryption master key flag to the passed
+// cobra command.
+func AddMasterKeyFlag(cmd *cobra.Command, v *string) {
+	cmd.Flags().StringVar(v, flagMasterKey, "",
+		"Hex-encoded master key of the blobstor encryption")
+}
+
+// AddDataKeyFlag adds the path to the blobstor encryption data key flag to the
+// passed cobra command.
+func AddDataKeyFlag(cmd *cobra.Command, v *string) {
+	cmd.Flags().StringVar(v, flagDataKey, "",
+		"Path to file with blobstor encryption data key of the shard")
+	_ = cmd.MarkFlagFilename(flagDataKey)
+}
@@ -18,6 +18,8 @@ func init() {
 	common.AddComponentPathFlag(getCMD, &vPath)
 	common.AddOutputFileFlag(getCMD, &vOut)
 	common.AddPayloadOnlyFlag(getCMD, &vPayloadOnly)
+	common.AddMasterKeyFlag(getCMD, &vMasterKey)
+	common.AddDataKeyFlag(getCMD, &vDataKey)
 }
 
 func getFunc(cmd *cobra.Command, _ []string) {
@@ -12,6 +12,8 @@ var (
 	vPath        string
 	vOut         string
 	vPayloadOnly bool
+	vMasterKey   string
+	vDataKey     string
 )
 
 // Root defines root command for operations with Peapod.
@@ -34,6 +36,12 @@ func openPeapod(cmd *cobra.Command) *peapod.Peapod {
 	err := compressCfg.Init()
 	common.ExitOnErr(cmd, common.Errf("failed to init compression config: %w", err))
 
+	masterKey, err := common.DecodeMasterKey(vMasterKey)
+	common.ExitOnErr(cmd, err)
+
+	compressCfg.Cipher, err = common.Cipher(masterKey, vDataKey)
+	common.ExitOnErr(cmd, common.Errf("failed to init encryption: %w", err))
+
 	ppd.SetCompressor(&compressCfg)
 
 	err = ppd.Open(true)
@@ -0,0 +1,41 @@
+package pilorama
+
+import (
+	"os"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/spf13/cobra"
+)
+
+var exportCMD = &cobra.Command{
+	Use:   "export",
+	Short: "Tree export",
+	Long: `Export operation log of the tree stored in a pilorama to a file.
+The file can be imported with 'neofs-lens pilorama import' or 'neofs-cli tree import'.`,
+	Args: cobra.NoArgs,
+	Run:  exportFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(exportCMD, &vPath)
+	addCIDFlag(exportCMD)
+	addTreeIDFlag(exportCMD)
+	addFileFlag(exportCMD, "File to save the tree to")
+}
+
+func exportFunc(cmd *cobra.Command, _ []string) {
+	cnr := decodeCID(cmd)
+
+	f := openPilorama(cmd, true)
+	defer f.Close()
+
+	out, err := os.OpenFile(vFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	common.ExitOnErr(cmd, common.Errf("could not create output file: %w", err))
+	defer out.Close()
+
+	n, err := pilorama.ExportTree(f, cnr, vTreeID, out)
+	common.ExitOnErr(cmd, common.Errf("could not export tree: %w", err))
+
+	cmd.Printf("Exported %d operations.\n", n)
+}
@@ -0,0 +1,46 @@
+package pilorama
+
+import (
+	"os"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/spf13/cobra"
+)
+
+var importCMD = &cobra.Command{
+	Use:   "import",
+	Short: "Tree import",
+	Long: `Import tree from the file made by 'neofs-lens pilorama export' or
+'neofs-cli tree export'. Operations are replayed into the tree with the given
+container and tree IDs, so the tree can be moved to another container.
+The storage node must be stopped.`,
+	Args: cobra.NoArgs,
+	Run:  importFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(importCMD, &vPath)
+	addCIDFlag(importCMD)
+	addTreeIDFlag(importCMD)
+	addFileFlag(importCMD, "File to read the tree from")
+}
+
+func importFunc(cmd *cobra.Command, _ []string) {
+	cnr := decodeCID(cmd)
+
+	in, err := os.Open(vFile)
+	common.ExitOnErr(cmd, common.Errf("could not open input file: %w", err))
+	defer in.Close()
+
+	f := openPilorama(cmd, false)
+	defer f.Close()
+
+	// container position does not matter for the replicated operations
+	d := pilorama.CIDDescriptor{CID: cnr, Position: 0, Size: 1}
+
+	n, err := pilorama.ImportTree(f, d, vTreeID, in)
+	common.ExitOnErr(cmd, common.Errf("could not import tree: %w", err))
+
+	cmd.Printf("Imported %d operations.\n", n)
+}
@@ -0,0 +1,33 @@
+package pilorama
+
+import (
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/spf13/cobra"
+)
+
+var listCMD = &cobra.Command{
+	Use:   "list",
+	Short: "Tree listing",
+	Long:  `List all trees of the container stored in a pilorama.`,
+	Args:  cobra.NoArgs,
+	Run:   listFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(listCMD, &vPath)
+	addCIDFlag(listCMD)
+}
+
+func listFunc(cmd *cobra.Command, _ []string) {
+	cnr := decodeCID(cmd)
+
+	f := openPilorama(cmd, true)
+	defer f.Close()
+
+	ids, err := f.TreeList(cnr)
+	common.ExitOnErr(cmd, common.Errf("could not list trees: %w", err))
+
+	for i := range ids {
+		cmd.Println(ids[i])
+	}
+}
@@ -0,0 +1,71 @@
+package pilorama
+
+import (
+	"os"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+var (
+	vPath   string
+	vCID    string
+	vTreeID string
+	vFile   string
+)
+
+const (
+	flagCID    = "cid"
+	flagTreeID = "tree"
+	flagFile   = "file"
+)
+
+// Root contains `pilorama` command definition.
+var Root = &cobra.Command{
+	Use:   "pilorama",
+	Short: "Operations with a pilorama",
+}
+
+func init() {
+	Root.AddCommand(
+		listCMD,
+		exportCMD,
+		importCMD,
+	)
+}
+
+func addCIDFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&vCID, flagCID, "", "Container ID")
+	_ = cmd.MarkFlagRequired(flagCID)
+}
+
+func addTreeIDFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&vTreeID, flagTreeID, "", "Tree ID")
+	_ = cmd.MarkFlagRequired(flagTreeID)
+}
+
+func addFileFlag(cmd *cobra.Command, usage string) {
+	cmd.Flags().StringVar(&vFile, flagFile, "", usage)
+	_ = cmd.MarkFlagFilename(flagFile)
+	_ = cmd.MarkFlagRequired(flagFile)
+}
+
+func decodeCID(cmd *cobra.Command) cid.ID {
+	var cnr cid.ID
+	common.ExitOnErr(cmd, common.Errf("invalid container ID: %w", cnr.DecodeString(vCID)))
+
+	return cnr
+}
+
+func openPilorama(cmd *cobra.Command, readOnly bool) pilorama.ForestStorage {
+	_, err := os.Stat(vPath)
+	common.ExitOnErr(cmd, err)
+
+	f := pilorama.NewBoltForest(pilorama.WithPath(vPath))
+	common.ExitOnErr(cmd, common.Errf("could not open pilorama: %w", f.Open(readOnly)))
+	common.ExitOnErr(cmd, common.Errf("could not init pilorama: %w", f.Init()))
+
+	return f
+}
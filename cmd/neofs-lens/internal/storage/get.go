@@ -19,6 +19,7 @@ func init() {
 	common.AddAddressFlag(storageGetObjCMD, &vAddress)
 	common.AddOutputFileFlag(storageGetObjCMD, &vOut)
 	common.AddConfigFileFlag(storageGetObjCMD, &vConfig)
+	common.AddMasterKeyFlag(storageGetObjCMD, &vMasterKey)
 	common.AddPayloadOnlyFlag(storageGetObjCMD, &vPayloadOnly)
 }
 
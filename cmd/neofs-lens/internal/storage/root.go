@@ -32,6 +32,7 @@ var (
 	vOut         string
 	vConfig      string
 	vPayloadOnly bool
+	vMasterKey   string
 )
 
 var Root = &cobra.Command{
@@ -62,10 +63,17 @@ func (e epochState) CurrentEpoch() uint64 {
 func openEngine(cmd *cobra.Command) *engine.StorageEngine {
 	appCfg := config.New(config.Prm{}, config.WithConfigFile(vConfig))
 
+	masterKey, err := common.DecodeMasterKey(vMasterKey)
+	common.ExitOnErr(cmd, err)
+
+	if masterKey == nil {
+		masterKey = engineconfig.EncryptionMasterKey(appCfg)
+	}
+
 	ls := engine.New()
 
 	var shards []storage.ShardCfg
-	err := engineconfig.IterateShards(appCfg, false, func(sc *shardconfig.Config) error {
+	err = engineconfig.IterateShards(appCfg, false, func(sc *shardconfig.Config) error {
 		var sh storage.ShardCfg
 
 		sh.RefillMetabase = sc.RefillMetabase()
@@ -149,6 +157,17 @@ func openEngine(cmd *cobra.Command) *engine.StorageEngine {
 		sh.GcCfg.RemoverBatchSize = gcCfg.RemoverBatchSize()
 		sh.GcCfg.RemoverSleepInterval = gcCfg.RemoverSleepInterval()
 
+		// encryption
+
+		if encCfg := sc.Encryption(); encCfg.Enabled() {
+			sh.EncryptionCfg.Enabled = true
+			sh.EncryptionCfg.KeyPath = encCfg.KeyPath()
+
+			if sh.EncryptionCfg.KeyPath == "" {
+				return fmt.Errorf("missing encryption key path for the shard with metabase %s", m.Path)
+			}
+		}
+
 		shards = append(shards, sh)
 
 		return nil
@@ -157,6 +176,9 @@ func openEngine(cmd *cobra.Command) *engine.StorageEngine {
 
 	var shardsWithMeta []shardOptsWithID
 	for _, shCfg := range shards {
+		cph, err := common.Cipher(masterKey, shCfg.EncryptionCfg.KeyPath)
+		common.ExitOnErr(cmd, common.Errf("failed to init encryption: %w", err))
+
 		var writeCacheOpts []writecache.Option
 		if wcRead := shCfg.WritecacheCfg; wcRead.Enabled {
 			writeCacheOpts = append(writeCacheOpts,
@@ -168,6 +190,7 @@ func openEngine(cmd *cobra.Command) *engine.StorageEngine {
 				writecache.WithFlushWorkersCount(wcRead.FlushWorkerCount),
 				writecache.WithMaxCacheSize(wcRead.SizeLimit),
 				writecache.WithNoSync(wcRead.NoSync),
+				writecache.WithCipher(cph),
 			)
 		}
 
@@ -218,6 +241,7 @@ func openEngine(cmd *cobra.Command) *engine.StorageEngine {
 				blobstor.WithCompressObjects(shCfg.Compress),
 				blobstor.WithUncompressableContentTypes(shCfg.UncompressableContentType),
 				blobstor.WithStorages(ss),
+				blobstor.WithCipher(cph),
 			),
 			shard.WithMetaBaseOptions(
 				meta.WithPath(shCfg.MetaCfg.Path),
@@ -17,6 +17,7 @@ var storageStatusObjCMD = &cobra.Command{
 func init() {
 	common.AddAddressFlag(storageStatusObjCMD, &vAddress)
 	common.AddConfigFileFlag(storageStatusObjCMD, &vConfig)
+	common.AddMasterKeyFlag(storageStatusObjCMD, &vMasterKey)
 }
 
 func statusObject(cmd *cobra.Command, _ []string) {
@@ -2,8 +2,10 @@ package writecache
 
 import (
 	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/spf13/cobra"
 )
 
@@ -19,15 +21,30 @@ func init() {
 	common.AddComponentPathFlag(getCMD, &vPath)
 	common.AddOutputFileFlag(getCMD, &vOut)
 	common.AddPayloadOnlyFlag(getCMD, &vPayloadOnly)
+	common.AddMasterKeyFlag(getCMD, &vMasterKey)
+	common.AddDataKeyFlag(getCMD, &vDataKey)
 }
 
 func getFunc(cmd *cobra.Command, _ []string) {
 	db := openWC(cmd)
 	defer db.Close()
 
+	var addr oid.Address
+	common.ExitOnErr(cmd, common.Errf("could not decode object address: %w", addr.DecodeString(vAddress)))
+
 	data, err := writecache.Get(db, []byte(vAddress))
 	common.ExitOnErr(cmd, common.Errf("could not fetch object: %w", err))
 
+	masterKey, err := common.DecodeMasterKey(vMasterKey)
+	common.ExitOnErr(cmd, err)
+
+	var encryptionCfg compression.Config
+	encryptionCfg.Cipher, err = common.Cipher(masterKey, vDataKey)
+	common.ExitOnErr(cmd, common.Errf("failed to init encryption: %w", err))
+
+	data, err = encryptionCfg.Decrypt(addr, data)
+	common.ExitOnErr(cmd, common.Errf("could not decrypt object: %w", err))
+
 	var o object.Object
 	common.ExitOnErr(cmd, common.Errf("could not unmarshal object: %w", o.Unmarshal(data)))
 
@@ -12,6 +12,8 @@ var (
 	vPath        string
 	vOut         string
 	vPayloadOnly bool
+	vMasterKey   string
+	vDataKey     string
 )
 
 // Root contains `write-cache` command definition.
@@ -5,6 +5,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/meta"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/peapod"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/pilorama"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/storage"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/writecache"
 	"github.com/nspcc-dev/neofs-node/misc"
@@ -37,6 +38,7 @@ func init() {
 	command.Flags().Bool("version", false, "Application version")
 	command.AddCommand(
 		peapod.Root,
+		pilorama.Root,
 		meta.Root,
 		writecache.Root,
 		storage.Root,
@@ -18,7 +18,7 @@ func initAccountingService(c *cfg) {
 
 	server := accountingTransportGRPC.New(
 		accountingService.NewSignService(
-			&c.key.PrivateKey,
+			c.signer,
 			accountingService.NewResponseService(
 				accountingService.NewExecutionService(
 					accounting.NewExecutor(balanceMorphWrapper),
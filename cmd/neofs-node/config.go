@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -55,6 +56,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/tree"
 	"github.com/nspcc-dev/neofs-node/pkg/services/util/response"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	"github.com/nspcc-dev/neofs-node/pkg/util/state"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
@@ -94,6 +96,8 @@ type applicationConfiguration struct {
 		errorThreshold uint32
 		shardPoolSize  uint32
 		shards         []storage.ShardCfg
+
+		encryptionMasterKey []byte
 	}
 
 	policer struct {
@@ -155,6 +159,7 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 
 	a.engine.errorThreshold = engineconfig.ShardErrorThreshold(c)
 	a.engine.shardPoolSize = engineconfig.ShardPoolSize(c)
+	a.engine.encryptionMasterKey = engineconfig.EncryptionMasterKey(c)
 
 	// Morph
 
@@ -256,6 +261,17 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 		sh.GcCfg.RemoverBatchSize = gcCfg.RemoverBatchSize()
 		sh.GcCfg.RemoverSleepInterval = gcCfg.RemoverSleepInterval()
 
+		// encryption
+
+		if encCfg := sc.Encryption(); encCfg.Enabled() {
+			sh.EncryptionCfg.Enabled = true
+			sh.EncryptionCfg.KeyPath = encCfg.KeyPath()
+
+			if sh.EncryptionCfg.KeyPath == "" {
+				return fmt.Errorf("missing encryption key path for the shard with metabase %s", m.Path)
+			}
+		}
+
 		a.engine.shards = append(a.engine.shards, sh)
 
 		return nil
@@ -312,6 +328,7 @@ type basics struct {
 	netMapSource netmapCore.Source
 
 	key          *keys.PrivateKey
+	signer       signer.Signer
 	binPublicKey []byte
 
 	cli  *client.Client
@@ -524,7 +541,11 @@ func initCfg(appCfg *config.Config) *cfg {
 	err = writeSystemAttributes(c)
 	fatalOnErr(err)
 
-	key := nodeconfig.Key(appCfg)
+	// the key is optional if the signing agent holds it
+	var key *keys.PrivateKey
+	if nodeconfig.Signer(appCfg).Agent() == "" || nodeconfig.KeyConfigured(appCfg) {
+		key = nodeconfig.Key(appCfg)
+	}
 
 	var netAddr network.AddressGroup
 
@@ -619,7 +640,7 @@ func initCfg(appCfg *config.Config) *cfg {
 
 	c.cfgNetmap.reBoostrapTurnedOff.Store(nodeconfig.Relay(appCfg))
 
-	c.ownerIDFromKey = user.ResolveFromECDSAPublicKey(key.PrivateKey.PublicKey)
+	c.ownerIDFromKey = user.ResolveFromECDSAPublicKey(ecdsa.PublicKey(*c.signer.PublicKey()))
 
 	if metricsconfig.Enabled(c.cfgReader) {
 		c.metricsCollector = metrics.NewNodeMetrics(misc.Version)
@@ -634,6 +655,30 @@ func initCfg(appCfg *config.Config) *cfg {
 	return c
 }
 
+// initSigner returns the signer of the node responses, messages and
+// transactions: the external signing agent if configured, the node key
+// otherwise. The node key is optional with the agent, if it is set anyway, the
+// agent must hold it since the node is identified by it in the network.
+func initSigner(c *cfg, key *keys.PrivateKey) signer.Signer {
+	signerCfg := nodeconfig.Signer(c.cfgReader)
+
+	addr := signerCfg.Agent()
+	if addr == "" {
+		return signer.FromPrivateKey(key)
+	}
+
+	agent, err := signer.NewAgent(addr, signerCfg.Timeout())
+	fatalOnErrDetails("connect to signing agent", err)
+
+	if key != nil && !agent.PublicKey().Equal(key.PublicKey()) {
+		fatalOnErr(errors.New("public key of the signing agent does not match the node key"))
+	}
+
+	c.onShutdown(func() { _ = agent.Close() })
+
+	return agent
+}
+
 func initBasics(c *cfg, key *keys.PrivateKey, stateStorage *state.PersistentStorage) basics {
 	b := basics{}
 
@@ -645,7 +690,11 @@ func initBasics(c *cfg, key *keys.PrivateKey, stateStorage *state.PersistentStor
 		c.log.Warn("can't get last processed side chain block number", zap.String("error", err.Error()))
 	}
 
-	cli, err := client.New(key,
+	b.key = key
+	b.signer = initSigner(c, key)
+	b.binPublicKey = b.signer.PublicKey().Bytes()
+
+	morphOpts := []client.Option{
 		client.WithDialTimeout(c.applicationConfiguration.morph.dialTimeout),
 		client.WithLogger(c.log),
 		client.WithAutoSidechainScope(),
@@ -662,7 +711,13 @@ func initBasics(c *cfg, key *keys.PrivateKey, stateStorage *state.PersistentStor
 			c.internalErr <- errors.New("morph connection has been lost")
 		}),
 		client.WithMinRequiredBlockHeight(fromSideChainBlock),
-	)
+	}
+	if nodeconfig.Signer(c.cfgReader).Agent() != "" {
+		// transactions and notary requests are signed by the agent
+		morphOpts = append(morphOpts, client.WithSigner(b.signer))
+	}
+
+	cli, err := client.New(key, morphOpts...)
 	if err != nil {
 		c.log.Info("failed to create neo RPC client",
 			zap.Any("endpoints", addresses),
@@ -711,8 +766,6 @@ func initBasics(c *cfg, key *keys.PrivateKey, stateStorage *state.PersistentStor
 
 	b.netMapSource = netmapSource
 	b.networkState = nState
-	b.key = key
-	b.binPublicKey = key.PublicKey().Bytes()
 	b.cli = cli
 	b.nCli = nmWrap
 	b.cCli = cnrWrap
@@ -1,11 +1,14 @@
 package engineconfig
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 )
 
@@ -83,3 +86,26 @@ func ShardPoolSize(c *config.Config) uint32 {
 func ShardErrorThreshold(c *config.Config) uint32 {
 	return config.Uint32Safe(c.Sub(subsection), "shard_ro_error_threshold")
 }
+
+// EncryptionMasterKey returns the value of "master_key" config parameter from
+// "storage.encryption" section decoded from hex.
+//
+// Returns nil if the value is missing. Panics if the value is not a valid
+// hex-encoded key of encryption.KeySize bytes.
+func EncryptionMasterKey(c *config.Config) []byte {
+	s := config.StringSafe(c.Sub(subsection).Sub("encryption"), "master_key")
+	if s == "" {
+		return nil
+	}
+
+	key, err := hex.DecodeString(s)
+	if err != nil {
+		panic(fmt.Errorf("invalid blobstor encryption master key: %w", err))
+	}
+
+	if len(key) != encryption.KeySize {
+		panic(fmt.Errorf("invalid blobstor encryption master key size %d, expected %d", len(key), encryption.KeySize))
+	}
+
+	return key
+}
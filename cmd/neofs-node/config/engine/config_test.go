@@ -1,6 +1,7 @@
 package engineconfig_test
 
 import (
+	"bytes"
 	"io/fs"
 	"testing"
 	"time"
@@ -38,6 +39,8 @@ func TestEngineSection(t *testing.T) {
 		require.EqualValues(t, 0, engineconfig.ShardErrorThreshold(empty))
 		require.EqualValues(t, engineconfig.ShardPoolSizeDefault, engineconfig.ShardPoolSize(empty))
 		require.EqualValues(t, mode.ReadWrite, shardconfig.From(empty).Mode())
+		require.Nil(t, engineconfig.EncryptionMasterKey(empty))
+		require.False(t, shardconfig.From(empty).Encryption().Enabled())
 	})
 
 	const path = "../../../../config/example/node"
@@ -47,6 +50,7 @@ func TestEngineSection(t *testing.T) {
 
 		require.EqualValues(t, 100, engineconfig.ShardErrorThreshold(c))
 		require.EqualValues(t, 15, engineconfig.ShardPoolSize(c))
+		require.Equal(t, bytes.Repeat([]byte{0x0f}, 32), engineconfig.EncryptionMasterKey(c))
 
 		err := engineconfig.IterateShards(c, true, func(sc *shardconfig.Config) error {
 			defer func() {
@@ -59,6 +63,7 @@ func TestEngineSection(t *testing.T) {
 			ss := blob.Storages()
 			pl := sc.Pilorama()
 			gc := sc.GC()
+			enc := sc.Encryption()
 
 			switch num {
 			case 0:
@@ -105,6 +110,9 @@ func TestEngineSection(t *testing.T) {
 
 				require.Equal(t, false, sc.RefillMetabase())
 				require.Equal(t, mode.ReadOnly, sc.Mode())
+
+				require.False(t, enc.Enabled())
+				require.Empty(t, enc.KeyPath())
 			case 1:
 				require.Equal(t, "tmp/1/blob/pilorama.db", pl.Path())
 				require.Equal(t, fs.FileMode(0644), pl.Perm())
@@ -149,6 +157,9 @@ func TestEngineSection(t *testing.T) {
 
 				require.Equal(t, true, sc.RefillMetabase())
 				require.Equal(t, mode.ReadWrite, sc.Mode())
+
+				require.True(t, enc.Enabled())
+				require.Equal(t, "tmp/1/data.key", enc.KeyPath())
 			}
 			return nil
 		})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	blobstorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor"
+	encryptionconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/encryption"
 	gcconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/gc"
 	metabaseconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/metabase"
 	piloramaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/pilorama"
@@ -91,6 +92,14 @@ func (x *Config) Pilorama() *piloramaconfig.Config {
 	)
 }
 
+// Encryption returns "encryption" subsection as an encryptionconfig.Config.
+func (x *Config) Encryption() *encryptionconfig.Config {
+	return encryptionconfig.From(
+		(*config.Config)(x).
+			Sub("encryption"),
+	)
+}
+
 // GC returns "gc" subsection as a gcconfig.Config.
 func (x *Config) GC() *gcconfig.Config {
 	return gcconfig.From(
@@ -0,0 +1,28 @@
+package encryptionconfig
+
+import (
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to the blobstor encryption configurations.
+type Config config.Config
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Enabled returns the value of "enabled" config parameter.
+//
+// Returns false if the value is not a valid bool.
+func (x *Config) Enabled() bool {
+	return config.BoolSafe((*config.Config)(x), "enabled")
+}
+
+// KeyPath returns the value of "key_path" config parameter.
+//
+// Returns empty string if the value is missing.
+func (x *Config) KeyPath() string {
+	return config.StringSafe((*config.Config)(x), "key_path")
+}
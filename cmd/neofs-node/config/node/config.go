@@ -18,6 +18,26 @@ type PersistentSessionsConfig struct {
 	cfg *config.Config
 }
 
+// PersistentBearerUsageConfig is a wrapper over "persistent_bearer_usage"
+// config section which provides access to persistent bearer token consumption
+// storage configuration of node.
+type PersistentBearerUsageConfig struct {
+	cfg *config.Config
+}
+
+// PersistentUploadsConfig is a wrapper over "persistent_uploads" config
+// section which provides access to persistent resumable upload sessions
+// storage configuration of node.
+type PersistentUploadsConfig struct {
+	cfg *config.Config
+}
+
+// SignerConfig is a wrapper over "signer" config section which provides
+// access to the external signer configuration of node.
+type SignerConfig struct {
+	cfg *config.Config
+}
+
 // PersistentStateConfig is a wrapper over "persistent_state" config section
 // which provides access to persistent state storage configuration of node.
 type PersistentStateConfig struct {
@@ -33,7 +53,10 @@ type NotificationConfig struct {
 const (
 	subsection                   = "node"
 	persistentSessionsSubsection = "persistent_sessions"
+	persistentUsageSubsection    = "persistent_bearer_usage"
+	persistentUploadsSubsection  = "persistent_uploads"
 	persistentStateSubsection    = "persistent_state"
+	signerSubsection             = "signer"
 	notificationSubsection       = "notification"
 
 	attributePrefix = "attribute"
@@ -43,6 +66,9 @@ const (
 
 	// NotificationTimeoutDefault is a default timeout for object notification operation.
 	NotificationTimeoutDefault = 5 * time.Second
+
+	// SignerTimeoutDefault is a default timeout of the signing agent requests.
+	SignerTimeoutDefault = 5 * time.Second
 )
 
 // Key returns the  value of "key" config parameter
@@ -73,6 +99,14 @@ func Key(c *config.Config) *keys.PrivateKey {
 	return key
 }
 
+// KeyConfigured checks whether the node private key is configured in "node"
+// section either directly or via the wallet.
+func KeyConfigured(c *config.Config) bool {
+	sub := c.Sub(subsection)
+
+	return config.StringSafe(sub, "key") != "" || config.StringSafe(sub.Sub("wallet"), "path") != ""
+}
+
 // Wallet returns the value of a node private key from "node" section.
 //
 // Panics if section contains invalid values.
@@ -157,6 +191,60 @@ func (p PersistentSessionsConfig) Path() string {
 	return config.String(p.cfg, "path")
 }
 
+// PersistentBearerUsage returns structure that provides access to
+// "persistent_bearer_usage" subsection of "node" section.
+func PersistentBearerUsage(c *config.Config) PersistentBearerUsageConfig {
+	return PersistentBearerUsageConfig{
+		c.Sub(subsection).Sub(persistentUsageSubsection),
+	}
+}
+
+// Path returns the value of "path" config parameter.
+func (p PersistentBearerUsageConfig) Path() string {
+	return config.String(p.cfg, "path")
+}
+
+// PersistentUploads returns structure that provides access to
+// "persistent_uploads" subsection of "node" section.
+func PersistentUploads(c *config.Config) PersistentUploadsConfig {
+	return PersistentUploadsConfig{
+		c.Sub(subsection).Sub(persistentUploadsSubsection),
+	}
+}
+
+// Path returns the value of "path" config parameter.
+func (p PersistentUploadsConfig) Path() string {
+	return config.String(p.cfg, "path")
+}
+
+// Signer returns structure that provides access to "signer" subsection of
+// "node" section.
+func Signer(c *config.Config) SignerConfig {
+	return SignerConfig{
+		c.Sub(subsection).Sub(signerSubsection),
+	}
+}
+
+// Agent returns the value of "agent" config parameter.
+//
+// Returns empty string if the value is not a non-empty string, the node key
+// is used for signing in this case.
+func (s SignerConfig) Agent() string {
+	return config.String(s.cfg, "agent")
+}
+
+// Timeout returns the value of "timeout" config parameter.
+//
+// Returns SignerTimeoutDefault if the value is not positive duration.
+func (s SignerConfig) Timeout() time.Duration {
+	v := config.DurationSafe(s.cfg, "timeout")
+	if v > 0 {
+		return v
+	}
+
+	return SignerTimeoutDefault
+}
+
 // PersistentState returns structure that provides access to "persistent_state"
 // subsection of "node" section.
 func PersistentState(c *config.Config) PersistentStateConfig {
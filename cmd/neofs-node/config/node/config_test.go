@@ -29,9 +29,15 @@ func TestNodeSection(t *testing.T) {
 			},
 		)
 
+		require.False(t, KeyConfigured(empty))
+
 		attribute := Attributes(empty)
 		relay := Relay(empty)
 		persisessionsPath := PersistentSessions(empty).Path()
+		persiusagePath := PersistentBearerUsage(empty).Path()
+		persiuploadsPath := PersistentUploads(empty).Path()
+		signerAgent := Signer(empty).Agent()
+		signerTimeout := Signer(empty).Timeout()
 		persistatePath := PersistentState(empty).Path()
 		notificationDefaultEnabled := Notification(empty).Enabled()
 		notificationDefaultEndpoint := Notification(empty).Endpoint()
@@ -44,6 +50,10 @@ func TestNodeSection(t *testing.T) {
 		require.Empty(t, attribute)
 		require.Equal(t, false, relay)
 		require.Equal(t, "", persisessionsPath)
+		require.Equal(t, "", persiusagePath)
+		require.Equal(t, "", persiuploadsPath)
+		require.Equal(t, "", signerAgent)
+		require.Equal(t, SignerTimeoutDefault, signerTimeout)
 		require.Equal(t, PersistentStatePathDefault, persistatePath)
 		require.Equal(t, false, notificationDefaultEnabled)
 		require.Equal(t, "", notificationDefaultEndpoint)
@@ -63,6 +73,10 @@ func TestNodeSection(t *testing.T) {
 		relay := Relay(c)
 		wKey := Wallet(c)
 		persisessionsPath := PersistentSessions(c).Path()
+		persiusagePath := PersistentBearerUsage(c).Path()
+		persiuploadsPath := PersistentUploads(c).Path()
+		signerAgent := Signer(c).Agent()
+		signerTimeout := Signer(c).Timeout()
 		persistatePath := PersistentState(c).Path()
 		notificationEnabled := Notification(c).Enabled()
 		notificationEndpoint := Notification(c).Endpoint()
@@ -94,6 +108,7 @@ func TestNodeSection(t *testing.T) {
 			},
 		}
 
+		require.True(t, KeyConfigured(c))
 		require.Equal(t, "NbUgTSFvPmsRxmGeWpuuGeJUoRoi6PErcM", key.Address())
 
 		require.EqualValues(t, len(expectedAddr), addrs.Len())
@@ -122,6 +137,10 @@ func TestNodeSection(t *testing.T) {
 			address.Uint160ToString(wKey.GetScriptHash()))
 
 		require.Equal(t, "/sessions", persisessionsPath)
+		require.Equal(t, "/bearer_usage", persiusagePath)
+		require.Equal(t, "/uploads", persiuploadsPath)
+		require.Equal(t, "/run/neofs/signer.sock", signerAgent)
+		require.Equal(t, 3*time.Second, signerTimeout)
 		require.Equal(t, "/state", persistatePath)
 		require.Equal(t, true, notificationEnabled)
 		require.Equal(t, "tls://localhost:4222", notificationEndpoint)
@@ -1,7 +1,10 @@
 package objectconfig
 
 import (
+	"time"
+
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 )
 
 // PutConfig is a wrapper over "put" config section which provides access
@@ -15,9 +18,38 @@ const (
 
 	putSubsection = "put"
 
+	getSubsection = "get"
+
+	headSubsection = "head"
+
+	aclSubsection = "acl"
+
 	// PutPoolSizeDefault is a default value of routine pool size to
 	// process object.Put requests in object service.
 	PutPoolSizeDefault = 10
+
+	// UploadSessionLifetimeDefault is a default time the resumable upload
+	// session is kept after the last stored part.
+	UploadSessionLifetimeDefault = time.Hour
+
+	// AssemblyConcurrencyDefault is a default number of child objects
+	// fetched concurrently while assembling the large object.
+	AssemblyConcurrencyDefault = getsvc.DefaultAssemblyConcurrency
+
+	// CacheMaxObjectSizeDefault is a default maximum payload size of the
+	// object kept in the read cache.
+	CacheMaxObjectSizeDefault = 1 << 20
+
+	// CacheTTLDefault is a default time the object is kept in the read cache.
+	CacheTTLDefault = time.Minute
+
+	// HeadBatchSizeDefault is a default maximum number of objects in the
+	// batched HEAD request.
+	HeadBatchSizeDefault = 1000
+
+	// RevocationCacheTTLDefault is a default interval of refreshing the token
+	// revocations in the containers.
+	RevocationCacheTTLDefault = 30 * time.Second
 )
 
 // Put returns structure that provides access to "put" subsection of
@@ -39,3 +71,136 @@ func (g PutConfig) PoolSizeRemote() int {
 
 	return PutPoolSizeDefault
 }
+
+// UploadSessionLifetime returns the value of "upload_session_lifetime" config
+// parameter.
+//
+// Returns UploadSessionLifetimeDefault if the value is not a positive duration.
+func (g PutConfig) UploadSessionLifetime() time.Duration {
+	v := config.DurationSafe(g.cfg, "upload_session_lifetime")
+	if v > 0 {
+		return v
+	}
+
+	return UploadSessionLifetimeDefault
+}
+
+// GetConfig is a wrapper over "get" config section which provides access
+// to object get pipeline configuration of object service.
+type GetConfig struct {
+	cfg *config.Config
+}
+
+// Get returns structure that provides access to "get" subsection of
+// "object" section.
+func Get(c *config.Config) GetConfig {
+	return GetConfig{
+		c.Sub(subsection).Sub(getSubsection),
+	}
+}
+
+// AssemblyConcurrency returns the value of "assembly_concurrency" config
+// parameter.
+//
+// Returns AssemblyConcurrencyDefault if the value is not a positive number.
+func (g GetConfig) AssemblyConcurrency() int {
+	v := config.Int(g.cfg, "assembly_concurrency")
+	if v > 0 {
+		return int(v)
+	}
+
+	return AssemblyConcurrencyDefault
+}
+
+// VerifyPayload returns the value of "verify_payload" config parameter.
+//
+// Returns false if the value is not a boolean.
+func (g GetConfig) VerifyPayload() bool {
+	return config.BoolSafe(g.cfg, "verify_payload")
+}
+
+// CacheSize returns the value of "cache.size" config parameter. The value is
+// a total payload size of the cached objects in bytes.
+//
+// Returns 0 (cache is disabled) if the value is not set.
+func (g GetConfig) CacheSize() uint64 {
+	return config.SizeInBytesSafe(g.cfg.Sub("cache"), "size")
+}
+
+// CacheMaxObjectSize returns the value of "cache.max_object_size" config
+// parameter.
+//
+// Returns CacheMaxObjectSizeDefault if the value is not a positive number.
+func (g GetConfig) CacheMaxObjectSize() uint64 {
+	v := config.SizeInBytesSafe(g.cfg.Sub("cache"), "max_object_size")
+	if v > 0 {
+		return v
+	}
+
+	return CacheMaxObjectSizeDefault
+}
+
+// CacheTTL returns the value of "cache.ttl" config parameter.
+//
+// Returns CacheTTLDefault if the value is not a positive duration.
+func (g GetConfig) CacheTTL() time.Duration {
+	v := config.DurationSafe(g.cfg.Sub("cache"), "ttl")
+	if v > 0 {
+		return v
+	}
+
+	return CacheTTLDefault
+}
+
+// HeadConfig is a wrapper over "head" config section which provides access
+// to object head pipeline configuration of object service.
+type HeadConfig struct {
+	cfg *config.Config
+}
+
+// Head returns structure that provides access to "head" subsection of
+// "object" section.
+func Head(c *config.Config) HeadConfig {
+	return HeadConfig{
+		c.Sub(subsection).Sub(headSubsection),
+	}
+}
+
+// BatchSize returns the value of "batch_size" config parameter.
+//
+// Returns HeadBatchSizeDefault if the value is not a positive number.
+func (g HeadConfig) BatchSize() int {
+	v := config.Int(g.cfg, "batch_size")
+	if v > 0 {
+		return int(v)
+	}
+
+	return HeadBatchSizeDefault
+}
+
+// ACLConfig is a wrapper over "acl" config section which provides access
+// to access control configuration of object service.
+type ACLConfig struct {
+	cfg *config.Config
+}
+
+// ACL returns structure that provides access to "acl" subsection of
+// "object" section.
+func ACL(c *config.Config) ACLConfig {
+	return ACLConfig{
+		c.Sub(subsection).Sub(aclSubsection),
+	}
+}
+
+// RevocationCacheTTL returns the value of "revocation_cache_ttl" config
+// parameter.
+//
+// Returns RevocationCacheTTLDefault if the value is not a positive duration.
+func (g ACLConfig) RevocationCacheTTL() time.Duration {
+	v := config.DurationSafe(g.cfg, "revocation_cache_ttl")
+	if v > 0 {
+		return v
+	}
+
+	return RevocationCacheTTLDefault
+}
@@ -2,6 +2,7 @@ package objectconfig_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
@@ -14,6 +15,14 @@ func TestObjectSection(t *testing.T) {
 		empty := configtest.EmptyConfig()
 
 		require.Equal(t, objectconfig.PutPoolSizeDefault, objectconfig.Put(empty).PoolSizeRemote())
+		require.Equal(t, objectconfig.UploadSessionLifetimeDefault, objectconfig.Put(empty).UploadSessionLifetime())
+		require.Equal(t, objectconfig.AssemblyConcurrencyDefault, objectconfig.Get(empty).AssemblyConcurrency())
+		require.False(t, objectconfig.Get(empty).VerifyPayload())
+		require.Zero(t, objectconfig.Get(empty).CacheSize())
+		require.EqualValues(t, objectconfig.CacheMaxObjectSizeDefault, objectconfig.Get(empty).CacheMaxObjectSize())
+		require.Equal(t, objectconfig.CacheTTLDefault, objectconfig.Get(empty).CacheTTL())
+		require.Equal(t, objectconfig.HeadBatchSizeDefault, objectconfig.Head(empty).BatchSize())
+		require.Equal(t, objectconfig.RevocationCacheTTLDefault, objectconfig.ACL(empty).RevocationCacheTTL())
 		require.EqualValues(t, objectconfig.DefaultTombstoneLifetime, objectconfig.TombstoneLifetime(empty))
 	})
 
@@ -21,6 +30,14 @@ func TestObjectSection(t *testing.T) {
 
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, 100, objectconfig.Put(c).PoolSizeRemote())
+		require.Equal(t, 30*time.Minute, objectconfig.Put(c).UploadSessionLifetime())
+		require.Equal(t, 8, objectconfig.Get(c).AssemblyConcurrency())
+		require.True(t, objectconfig.Get(c).VerifyPayload())
+		require.EqualValues(t, 256<<20, objectconfig.Get(c).CacheSize())
+		require.EqualValues(t, 512<<10, objectconfig.Get(c).CacheMaxObjectSize())
+		require.Equal(t, 30*time.Second, objectconfig.Get(c).CacheTTL())
+		require.Equal(t, 500, objectconfig.Head(c).BatchSize())
+		require.Equal(t, time.Minute, objectconfig.ACL(c).RevocationCacheTTL())
 		require.EqualValues(t, 10, objectconfig.TombstoneLifetime(c))
 	}
 
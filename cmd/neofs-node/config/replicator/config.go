@@ -31,3 +31,11 @@ func PutTimeout(c *config.Config) time.Duration {
 func PoolSize(c *config.Config) int {
 	return int(config.IntSafe(c.Sub(subsection), "pool_size"))
 }
+
+// BandwidthLimit returns the value of "bandwidth_limit" config parameter
+// from "replicator" section. The value is a number of bytes per second.
+//
+// Returns 0 (no limit) if the value is not set.
+func BandwidthLimit(c *config.Config) uint64 {
+	return config.SizeInBytesSafe(c.Sub(subsection), "bandwidth_limit")
+}
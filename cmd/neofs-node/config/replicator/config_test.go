@@ -16,6 +16,7 @@ func TestReplicatorSection(t *testing.T) {
 
 		require.Equal(t, replicatorconfig.PutTimeoutDefault, replicatorconfig.PutTimeout(empty))
 		require.Equal(t, 0, replicatorconfig.PoolSize(empty))
+		require.Zero(t, replicatorconfig.BandwidthLimit(empty))
 	})
 
 	const path = "../../../../config/example/node"
@@ -23,6 +24,7 @@ func TestReplicatorSection(t *testing.T) {
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, 15*time.Second, replicatorconfig.PutTimeout(c))
 		require.Equal(t, 10, replicatorconfig.PoolSize(c))
+		require.EqualValues(t, 100*1024*1024, replicatorconfig.BandwidthLimit(c))
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
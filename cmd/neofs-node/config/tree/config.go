@@ -71,3 +71,19 @@ func (c TreeConfig) ReplicationWorkerCount() int {
 func (c TreeConfig) SyncInterval() time.Duration {
 	return config.DurationSafe(c.cfg, "sync_interval")
 }
+
+// MutualTLS returns the value of "mutual_tls"
+// config parameter from the "tree" section.
+//
+// Returns `false` if config value is not specified.
+func (c TreeConfig) MutualTLS() bool {
+	return config.BoolSafe(c.cfg, "mutual_tls")
+}
+
+// MutualTLSEndpoints returns the value of "mutual_tls_endpoints"
+// config parameter from the "tree" section.
+//
+// Returns nil if config value is not specified.
+func (c TreeConfig) MutualTLSEndpoints() []string {
+	return config.StringSliceSafe(c.cfg, "mutual_tls_endpoints")
+}
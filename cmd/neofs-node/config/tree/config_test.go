@@ -21,6 +21,8 @@ func TestTreeSection(t *testing.T) {
 		require.Equal(t, 0, treeSec.ReplicationChannelCapacity())
 		require.Equal(t, 0, treeSec.ReplicationWorkerCount())
 		require.Equal(t, time.Duration(0), treeSec.ReplicationTimeout())
+		require.False(t, treeSec.MutualTLS())
+		require.Empty(t, treeSec.MutualTLSEndpoints())
 	})
 
 	const path = "../../../../config/example/node"
@@ -34,6 +36,8 @@ func TestTreeSection(t *testing.T) {
 		require.Equal(t, 32, treeSec.ReplicationWorkerCount())
 		require.Equal(t, 5*time.Second, treeSec.ReplicationTimeout())
 		require.Equal(t, time.Hour, treeSec.SyncInterval())
+		require.True(t, treeSec.MutualTLS())
+		require.Equal(t, []string{"s01.neofs.devenv:8080"}, treeSec.MutualTLSEndpoints())
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
@@ -3,7 +3,6 @@ package main
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
 	"crypto/sha256"
 	"encoding/binary"
 	"errors"
@@ -107,6 +106,16 @@ func initContainerService(c *cfg) {
 			)
 		})
 
+		subscribeToEACLChange(c, func(e event.Event) {
+			ev := e.(containerEvent.SetEACLSuccess)
+
+			eaclCache.InvalidateEACL(ev.ID)
+
+			c.log.Debug("eACL change event's receipt",
+				zap.Stringer("id", ev.ID),
+			)
+		})
+
 		c.cfgObject.eaclSource = eaclCache
 		c.cfgObject.cnrSource = cnrCache
 
@@ -125,7 +134,7 @@ func initContainerService(c *cfg) {
 		engine: c.cfgObject.cfgLocalStorage.localStorage,
 	}
 
-	pubKey := c.key.PublicKey().Bytes()
+	pubKey := c.binPublicKey
 
 	resultWriter := &morphLoadWriter{
 		log:            estimationsLogger,
@@ -154,7 +163,6 @@ func initContainerService(c *cfg) {
 		loadroute.Prm{
 			LocalServerInfo: c,
 			RemoteWriterProvider: &remoteLoadAnnounceProvider{
-				key:             &c.key.PrivateKey,
 				netmapKeys:      c,
 				clientCache:     c.bgClientCache,
 				deadEndProvider: loadcontroller.SimpleWriterProvider(loadAccumulator),
@@ -190,7 +198,7 @@ func initContainerService(c *cfg) {
 
 	server := containerTransportGRPC.New(
 		containerService.NewSignService(
-			&c.key.PrivateKey,
+			c.signer,
 			containerService.NewResponseService(
 				&usedSpaceService{
 					Server:               containerService.NewExecutionService(containerMorph.NewExecutor(cnrRdr, cnrWrt)),
@@ -261,6 +269,13 @@ func subscribeToContainerRemoval(c *cfg, h event.Handler) {
 	addContainerAsyncNotificationHandler(c, eventNameContainerRemoved, h)
 }
 
+// like subscribeToContainerCreation but for eACL table changes.
+func subscribeToEACLChange(c *cfg, h event.Handler) {
+	const eventNameEACLChanged = "SetEACLSuccess"
+	registerEventParserOnceContainer(c, eventNameEACLChanged, containerEvent.ParseSetEACLSuccess)
+	addContainerAsyncNotificationHandler(c, eventNameEACLChanged, h)
+}
+
 func setContainerNotificationParser(c *cfg, sTyp string, p event.NotificationParser) {
 	typ := event.TypeFromString(sTyp)
 
@@ -309,8 +324,6 @@ func (nopLoadWriter) Close() error {
 }
 
 type remoteLoadAnnounceProvider struct {
-	key *ecdsa.PrivateKey
-
 	netmapKeys netmapCore.AnnouncedKeys
 
 	clientCache interface {
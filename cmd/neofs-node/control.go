@@ -30,13 +30,13 @@ func initControlService(c *cfg) {
 	pubs := controlconfig.AuthorizedKeys(c.cfgReader)
 	rawPubs := make([][]byte, 0, len(pubs)+1) // +1 for node key
 
-	rawPubs = append(rawPubs, c.key.PublicKey().Bytes())
+	rawPubs = append(rawPubs, c.binPublicKey)
 
 	for i := range pubs {
 		rawPubs = append(rawPubs, pubs[i].Bytes())
 	}
 
-	c.shared.control = controlSvc.New(&c.key.PrivateKey, rawPubs, c)
+	c.shared.control = controlSvc.New(c.signer, rawPubs, c)
 
 	lis, err := net.Listen("tcp", endpoint)
 	if err != nil {
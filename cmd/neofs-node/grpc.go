@@ -8,6 +8,7 @@ import (
 	"time"
 
 	grpcconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/grpc"
+	treeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tree"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -44,11 +45,19 @@ func initGRPC(c *cfg) {
 					tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
 				}
 			}
-			creds := credentials.NewTLS(&tls.Config{
+			tlsConfig := &tls.Config{
 				MinVersion:   tls.VersionTLS12,
 				CipherSuites: cipherSuites,
 				Certificates: []tls.Certificate{cert},
-			})
+			}
+
+			if requestTreeCertificates(c, sc.Endpoint()) {
+				// Tree services present self-signed certificates generated
+				// from the node keys, they are checked by the service itself.
+				tlsConfig.ClientAuth = tls.RequestClientCert
+			}
+
+			creds := credentials.NewTLS(tlsConfig)
 
 			serverOpts = append(serverOpts, grpc.Creds(creds))
 		}
@@ -103,6 +112,26 @@ func serveGRPC(c *cfg) {
 	}
 }
 
+// requestTreeCertificates checks whether the gRPC server listening to the
+// given endpoint should request client certificates for the tree service
+// mutual TLS authentication. Certificates are requested only on the endpoints
+// explicitly configured for this, so the clients of the other services are
+// not affected.
+func requestTreeCertificates(c *cfg, endpoint string) bool {
+	treeConfig := treeconfig.Tree(c.cfgReader)
+	if !treeConfig.MutualTLS() {
+		return false
+	}
+
+	for _, e := range treeConfig.MutualTLSEndpoints() {
+		if e == endpoint {
+			return true
+		}
+	}
+
+	return false
+}
+
 func stopGRPC(name string, s *grpc.Server, l *zap.Logger) {
 	l = l.With(zap.String("name", name))
 
@@ -127,7 +127,7 @@ func (c *cfg) addressNum() int {
 
 func initNetmapService(c *cfg) {
 	network.WriteToNodeInfo(c.localAddr, &c.cfgNodeInfo.localInfo)
-	c.cfgNodeInfo.localInfo.SetPublicKey(c.key.PublicKey().Bytes())
+	c.cfgNodeInfo.localInfo.SetPublicKey(c.binPublicKey)
 	parseAttributes(c)
 	c.cfgNodeInfo.localInfo.SetOffline()
 
@@ -139,7 +139,7 @@ func initNetmapService(c *cfg) {
 
 	server := netmapTransportGRPC.New(
 		netmapService.NewSignService(
-			&c.key.PrivateKey,
+			c.signer,
 			netmapService.NewResponseService(
 				netmapService.NewExecutionService(
 					c,
@@ -376,7 +376,7 @@ func (c *cfg) setMaintenanceStatus(force bool) error {
 // State setter is used to specify node state to switch to.
 func (c *cfg) updateNetMapState(stateSetter func(*nmClient.UpdatePeerPrm)) error {
 	var prm nmClient.UpdatePeerPrm
-	prm.SetKey(c.key.PublicKey().Bytes())
+	prm.SetKey(c.binPublicKey)
 	stateSetter(&prm)
 
 	return c.cfgNetmap.wrapper.UpdatePeerState(prm)
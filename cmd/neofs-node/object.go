@@ -5,9 +5,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
 	objectGRPC "github.com/nspcc-dev/neofs-api-go/v2/object/grpc"
+	nodeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/node"
+	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
 	replicatorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/replicator"
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	containercore "github.com/nspcc-dev/neofs-node/pkg/core/container"
@@ -16,12 +19,17 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	morphClient "github.com/nspcc-dev/neofs-node/pkg/morph/client"
 	cntClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	containerEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/container"
+	netmapEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/netmap"
 	objectTransportGRPC "github.com/nspcc-dev/neofs-node/pkg/network/transport/object/grpc"
 	objectService "github.com/nspcc-dev/neofs-node/pkg/services/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/acl"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/acl/usage"
 	v2 "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/v2"
 	deletesvc "github.com/nspcc-dev/neofs-node/pkg/services/object/delete"
 	deletesvcV2 "github.com/nspcc-dev/neofs-node/pkg/services/object/delete/v2"
+	ecsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/ec"
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 	getsvcV2 "github.com/nspcc-dev/neofs-node/pkg/services/object/get/v2"
 	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
@@ -34,9 +42,12 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 	truststorage "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/storage"
+	"github.com/nspcc-dev/neofs-node/pkg/util/rate"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
-	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	apireputation "github.com/nspcc-dev/neofs-sdk-go/reputation"
@@ -148,7 +159,7 @@ func (c *cfg) IsLocalNodeInNetmap() bool {
 
 func initObjectService(c *cfg) {
 	ls := c.cfgObject.cfgLocalStorage.localStorage
-	keyStorage := util.NewKeyStorage(&c.key.PrivateKey, c.privateTokenStore, c.cfgNetmap.state)
+	keyStorage := util.NewKeyStorage(c.signer, c.privateTokenStore, c.cfgNetmap.state)
 
 	clientConstructor := &reputationClientConstructor{
 		log:              c.log,
@@ -178,7 +189,10 @@ func initObjectService(c *cfg) {
 		sidechain: c.cfgMorph.client,
 	}
 
-	c.shared.replicator = replicator.New(
+	// pushed and pulled replicas share the bandwidth
+	replicationLimiter := rate.NewLimiter(replicatorconfig.BandwidthLimit(c.cfgReader))
+
+	replicatorOpts := []replicator.Option{
 		replicator.WithLogger(c.log),
 		replicator.WithPutTimeout(
 			replicatorconfig.PutTimeout(c.cfgReader),
@@ -187,7 +201,16 @@ func initObjectService(c *cfg) {
 		replicator.WithRemoteSender(
 			putsvc.NewRemoteSender(keyStorage, (*coreClientConstructor)(clientConstructor)),
 		),
-	)
+		replicator.WithBandwidthLimiter(replicationLimiter),
+	}
+
+	if c.metricsCollector != nil {
+		replicatorOpts = append(replicatorOpts, replicator.WithMetrics(c.metricsCollector))
+	}
+
+	c.shared.replicator = replicator.New(replicatorOpts...)
+
+	ecPartSource := ecsvc.NewRemotePartSource(keyStorage, clientConstructor)
 
 	c.shared.policer = policer.New(
 		policer.WithLogger(c.log),
@@ -199,6 +222,8 @@ func initObjectService(c *cfg) {
 		policer.WithRemoteHeader(
 			headsvc.NewRemoteHeader(keyStorage, clientConstructor),
 		),
+		policer.WithECPartSource(ecPartSource),
+		policer.WithSigner(signer.SDK(c.signer, neofscrypto.ECDSA_DETERMINISTIC_SHA256)),
 		policer.WithNetmapKeys(c),
 		policer.WithHeadTimeout(c.applicationConfiguration.policer.headTimeout),
 		policer.WithReplicator(c.replicator),
@@ -221,6 +246,29 @@ func initObjectService(c *cfg) {
 		policer.WithObjectBatchSize(c.applicationConfiguration.policer.objectBatchSize),
 	)
 
+	addNewEpochAsyncNotificationHandler(c, func(ev event.Event) {
+		e := ev.(netmapEvent.NewEpoch).EpochNumber()
+		if e == 0 {
+			return
+		}
+
+		prev, err := c.netMapSource.GetNetMapByEpoch(e - 1)
+		if err != nil {
+			c.log.Debug("could not get previous network map to prioritize policer checks",
+				zap.Uint64("epoch", e-1), zap.Error(err))
+			return
+		}
+
+		cur, err := c.netMapSource.GetNetMapByEpoch(e)
+		if err != nil {
+			c.log.Debug("could not get current network map to prioritize policer checks",
+				zap.Uint64("epoch", e), zap.Error(err))
+			return
+		}
+
+		c.shared.policer.NetmapChanged(prev, cur)
+	})
+
 	traverseGen := util.NewTraverserGenerator(c.netMapSource, c.cfgObject.cnrSource, c)
 
 	c.workers = append(c.workers, c.shared.policer)
@@ -248,12 +296,28 @@ func initObjectService(c *cfg) {
 		putsvc.WithNetmapKeys(c),
 		putsvc.WithNetworkState(c.cfgNetmap.state),
 		putsvc.WithWorkerPools(c.cfgObject.pool.putRemote, c.cfgObject.pool.putLocal),
+		putsvc.WithUploadSessionLifetime(
+			objectconfig.Put(c.cfgReader).UploadSessionLifetime(),
+		),
+		putsvc.WithUploadSessionStore(initUploadSessionStore(c)),
+		putsvc.WithTombstoneLifetime(c.cfgObject.tombstoneLifetime),
+		putsvc.WithObjectSource(copySource{svc: c.cfgObject.getSvc}),
+		putsvc.WithObjectPuller(&replicaPuller{
+			cfg:     c,
+			getter:  getsvc.NewRemoteGetter(keyStorage, (*coreClientConstructor)(clientConstructor)),
+			limiter: replicationLimiter,
+		}),
+		putsvc.WithRemovalCallback(c.cfgObject.getSvc.InvalidateObjects),
 		putsvc.WithLogger(c.log),
 	)
 
+	addNewEpochNotificationHandler(c, func(event.Event) {
+		sPut.CollectExpiredUploads()
+	})
+
 	sPutV2 := putsvcV2.NewService(
 		putsvcV2.WithInternalService(sPut),
-		putsvcV2.WithKey(&c.key.PrivateKey),
+		putsvcV2.WithSigner(c.signer),
 	)
 
 	sSearch := searchsvc.New(
@@ -274,7 +338,7 @@ func initObjectService(c *cfg) {
 		searchsvcV2.WithKeyStorage(keyStorage),
 	)
 
-	sGet := getsvc.New(
+	getOpts := []getsvc.Option{
 		getsvc.WithLogger(c.log),
 		getsvc.WithLocalStorageEngine(ls),
 		getsvc.WithClientConstructor(coreConstructor),
@@ -285,7 +349,27 @@ func initObjectService(c *cfg) {
 		),
 		getsvc.WithNetMapSource(c.netMapSource),
 		getsvc.WithKeyStorage(keyStorage),
-	)
+		getsvc.WithNetmapKeys(c),
+		getsvc.WithECPartSource(ecPartSource),
+		getsvc.WithAssemblyConcurrency(
+			objectconfig.Get(c.cfgReader).AssemblyConcurrency(),
+		),
+		getsvc.WithPayloadVerification(objectconfig.Get(c.cfgReader).VerifyPayload()),
+		getsvc.WithCorruptionHandler(func(addr objectCore.AddressWithType) {
+			c.shared.policer.Prioritize(addr, policer.PriorityScrub)
+		}),
+		getsvc.WithObjectCache(
+			objectconfig.Get(c.cfgReader).CacheSize(),
+			objectconfig.Get(c.cfgReader).CacheMaxObjectSize(),
+			objectconfig.Get(c.cfgReader).CacheTTL(),
+		),
+	}
+
+	if c.metricsCollector != nil {
+		getOpts = append(getOpts, getsvc.WithCacheMetrics(c.metricsCollector))
+	}
+
+	sGet := getsvc.New(getOpts...)
 
 	*c.cfgObject.getSvc = *sGet // need smth better
 
@@ -313,7 +397,7 @@ func initObjectService(c *cfg) {
 	)
 
 	// build service pipeline
-	// grpc | <metrics> | signature | response | acl | split
+	// grpc | <metrics> | signature | head batch | response | acl | split
 
 	splitSvc := objectService.NewTransportSplitter(
 		c.cfgGRPC.maxChunkSize,
@@ -326,22 +410,36 @@ func initObjectService(c *cfg) {
 		},
 	)
 
+	aclPrm := new(acl.CheckerPrm).
+		SetNetmapState(c.cfgNetmap.state).
+		SetEACLSource(c.cfgObject.eaclSource).
+		SetLocalStorage(ls)
+
+	if c.metricsCollector != nil {
+		aclPrm.SetMetrics(c.metricsCollector)
+	}
+
+	aclChecker := acl.NewChecker(aclPrm)
+
+	subscribeToEACLChange(c, func(e event.Event) {
+		aclChecker.InvalidateEACL(e.(containerEvent.SetEACLSuccess).ID)
+	})
+
+	revocations := newRevocationCache(c.log, sSearch, sGet, objectconfig.ACL(c.cfgReader).RevocationCacheTTL())
+	c.workers = append(c.workers, newWorkerFromFunc(revocations.run))
+
 	aclSvc := v2.New(
 		v2.WithLogger(c.log),
+		v2.WithTokenUsageStore(initBearerUsageStore(c)),
+		v2.WithSessionSource(c.privateTokenStore),
+		v2.WithRevocationSource(revocations),
 		v2.WithIRFetcher(newCachedIRFetcher(irFetcher)),
 		v2.WithNetmapSource(c.netMapSource),
 		v2.WithContainerSource(
 			c.cfgObject.cnrSource,
 		),
 		v2.WithNextService(splitSvc),
-		v2.WithEACLChecker(
-			acl.NewChecker(new(acl.CheckerPrm).
-				SetNetmapState(c.cfgNetmap.state).
-				SetEACLSource(c.cfgObject.eaclSource).
-				SetValidator(eaclSDK.NewValidator()).
-				SetLocalStorage(ls),
-			),
-		),
+		v2.WithEACLChecker(aclChecker),
 	)
 
 	var commonSvc objectService.Common
@@ -352,9 +450,15 @@ func initObjectService(c *cfg) {
 		c.respSvc,
 	)
 
+	batchSvc := objectService.NewHeadBatcher(
+		objectService.NewOverlayMerger(respSvc, c.signer),
+		c.signer,
+		objectconfig.Head(c.cfgReader).BatchSize(),
+	)
+
 	signSvc := objectService.NewSignService(
-		&c.key.PrivateKey,
-		respSvc,
+		c.signer,
+		batchSvc,
 	)
 
 	var firstSvc objectService.ServiceServer = signSvc
@@ -560,6 +664,104 @@ func (e engineWithNotifications) Put(o *objectSDK.Object) error {
 	return nil
 }
 
+// copySource reads the objects copied and patched by the PUT requests through
+// the GET service.
+type copySource struct {
+	svc *getsvc.Service
+}
+
+func (s copySource) ReadObject(ctx context.Context, common *util.CommonPrm, addr oid.Address, w putsvc.ObjectWriter) error {
+	var prm getsvc.Prm
+	prm.SetCommonParameters(common)
+	prm.WithAddress(addr)
+	prm.SetObjectWriter(w)
+
+	return s.svc.Get(ctx, prm)
+}
+
+func (s copySource) HeadObject(ctx context.Context, common *util.CommonPrm, addr oid.Address, raw bool) (*objectSDK.Object, error) {
+	var w headerWriter
+
+	var prm getsvc.HeadPrm
+	prm.SetCommonParameters(common)
+	prm.WithAddress(addr)
+	prm.WithRawFlag(raw)
+	prm.SetHeaderWriter(&w)
+
+	if err := s.svc.Head(ctx, prm); err != nil {
+		return nil, err
+	}
+
+	return w.hdr, nil
+}
+
+func (s copySource) ReadPayloadRange(ctx context.Context, common *util.CommonPrm, addr oid.Address, off, ln uint64, w putsvc.ChunkWriter) error {
+	var rng objectSDK.Range
+	rng.SetOffset(off)
+	rng.SetLength(ln)
+
+	var prm getsvc.RangePrm
+	prm.SetCommonParameters(common)
+	prm.WithAddress(addr)
+	prm.SetRange(&rng)
+	prm.SetChunkWriter(w)
+
+	return s.svc.GetRange(ctx, prm)
+}
+
+// replicaPuller reads the replicated objects from their holders in the
+// current network map.
+type replicaPuller struct {
+	cfg *cfg
+
+	getter *getsvc.RemoteGetter
+
+	limiter *rate.Limiter
+}
+
+func (p *replicaPuller) PullObject(ctx context.Context, addr oid.Address, holders [][]byte, w putsvc.ObjectWriter) error {
+	nm, err := netmap.GetLatestNetworkMap(p.cfg.netMapSource)
+	if err != nil {
+		return fmt.Errorf("could not get network map: %w", err)
+	}
+
+	// holders are resolved in the network map, so the node never connects to
+	// the addresses specified by the request sender
+	nodes := make([]netmapSDK.NodeInfo, 0, len(holders))
+
+	for _, node := range nm.Nodes() {
+		if p.cfg.IsLocalKey(node.PublicKey()) {
+			continue
+		}
+
+		for i := range holders {
+			if bytes.Equal(node.PublicKey(), holders[i]) {
+				nodes = append(nodes, node)
+				break
+			}
+		}
+	}
+
+	if len(nodes) == 0 {
+		return errors.New("no object holders in the network map")
+	}
+
+	prm := new(getsvc.RemoteGetPrm).
+		WithObjectAddress(addr).
+		WithPayloadLimiter(p.limiter)
+
+	return p.getter.ReadNearest(ctx, nodes, prm, w)
+}
+
+type headerWriter struct {
+	hdr *objectSDK.Object
+}
+
+func (w *headerWriter) WriteHeader(hdr *objectSDK.Object) error {
+	w.hdr = hdr
+	return nil
+}
+
 type engineWithoutNotifications struct {
 	engine *engine.StorageEngine
 }
@@ -590,3 +792,57 @@ func (e engineWithoutNotifications) Lock(locker oid.Address, toLock []oid.ID) er
 func (e engineWithoutNotifications) Put(o *objectSDK.Object) error {
 	return engine.Put(e.engine, o)
 }
+
+type bearerUsageStore interface {
+	v2.TokenUsageStore
+	RemoveOld(epoch uint64)
+	Close() error
+}
+
+func initBearerUsageStore(c *cfg) bearerUsageStore {
+	var store bearerUsageStore
+
+	if path := nodeconfig.PersistentBearerUsage(c.cfgReader).Path(); path != "" {
+		persistentStore, err := usage.NewPersistentStore(path,
+			usage.WithLogger(c.log),
+			usage.WithTimeout(time.Second),
+		)
+		if err != nil {
+			panic(fmt.Errorf("could not create persistent bearer token usage storage: %w", err))
+		}
+
+		store = persistentStore
+	} else {
+		store = usage.NewTemporaryStore()
+	}
+
+	c.onShutdown(func() {
+		_ = store.Close()
+	})
+
+	addNewEpochNotificationHandler(c, func(ev event.Event) {
+		store.RemoveOld(ev.(netmapEvent.NewEpoch).EpochNumber())
+	})
+
+	return store
+}
+
+// initUploadSessionStore returns the persistent store of the resumable upload
+// sessions if it is configured, nil otherwise.
+func initUploadSessionStore(c *cfg) putsvc.UploadSessionStore {
+	path := nodeconfig.PersistentUploads(c.cfgReader).Path()
+	if path == "" {
+		return nil
+	}
+
+	store, err := putsvc.NewBoltUploadSessionStore(path, time.Second)
+	if err != nil {
+		panic(fmt.Errorf("could not create persistent upload session storage: %w", err))
+	}
+
+	c.onShutdown(func() {
+		_ = store.Close()
+	})
+
+	return store
+}
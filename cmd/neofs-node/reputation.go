@@ -36,7 +36,7 @@ func initReputationService(c *cfg) {
 	wrap, err := repClient.NewFromMorph(c.cfgMorph.client, c.shared.basics.reputationSH, 0)
 	fatalOnErr(err)
 
-	localKey := c.key.PublicKey().Bytes()
+	localKey := c.binPublicKey
 
 	nmSrc := c.netMapSource
 
@@ -97,8 +97,8 @@ func initReputationService(c *cfg) {
 			ClientCache:     c.bgClientCache,
 			WriterProvider: localreputation.NewRemoteProvider(
 				localreputation.RemoteProviderPrm{
-					Key: &c.key.PrivateKey,
-					Log: localTrustLogger,
+					Signer: c.signer,
+					Log:    localTrustLogger,
 				},
 			),
 			Log: localTrustLogger,
@@ -112,8 +112,8 @@ func initReputationService(c *cfg) {
 			ClientCache:     c.bgClientCache,
 			WriterProvider: intermediatereputation.NewRemoteProvider(
 				intermediatereputation.RemoteProviderPrm{
-					Key: &c.key.PrivateKey,
-					Log: intermediateTrustLogger,
+					Signer: c.signer,
+					Log:    intermediateTrustLogger,
 				},
 			),
 			Log: intermediateTrustLogger,
@@ -147,9 +147,9 @@ func initReputationService(c *cfg) {
 			WorkerPool:              c.cfgReputation.workerPool,
 			FinalResultTarget: intermediatereputation.NewFinalWriterProvider(
 				intermediatereputation.FinalWriterProviderPrm{
-					PrivatKey: &c.key.PrivateKey,
-					PubKey:    localKey,
-					Client:    wrap,
+					Signer: c.signer,
+					PubKey: localKey,
+					Client: wrap,
 				},
 				intermediatereputation.FinalWriterWithLogger(c.log),
 			),
@@ -196,7 +196,7 @@ func initReputationService(c *cfg) {
 
 	server := grpcreputation.New(
 		reputationrpc.NewSignService(
-			&c.key.PrivateKey,
+			c.signer,
 			reputationrpc.NewResponseService(
 				&reputationServer{
 					cfg:                c,
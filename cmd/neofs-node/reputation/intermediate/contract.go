@@ -1,13 +1,13 @@
 package intermediate
 
 import (
-	"crypto/ecdsa"
 	"fmt"
 
 	repClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/reputation"
 	"github.com/nspcc-dev/neofs-node/pkg/services/reputation/eigentrust"
 	eigentrustcalc "github.com/nspcc-dev/neofs-node/pkg/services/reputation/eigentrust/calculator"
-	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	apireputation "github.com/nspcc-dev/neofs-sdk-go/reputation"
 	"go.uber.org/zap"
 )
@@ -18,9 +18,9 @@ import (
 // Passing incorrect parameter values will result in constructor
 // failure (error or panic depending on the implementation).
 type FinalWriterProviderPrm struct {
-	PrivatKey *ecdsa.PrivateKey
-	PubKey    []byte
-	Client    *repClient.Client
+	Signer signer.Signer
+	PubKey []byte
+	Client *repClient.Client
 }
 
 // NewFinalWriterProvider creates a new instance of the FinalWriterProvider.
@@ -52,19 +52,19 @@ type FinalWriterProvider struct {
 func (fwp FinalWriterProvider) InitIntermediateWriter(
 	_ eigentrustcalc.Context) (eigentrustcalc.IntermediateWriter, error) {
 	return &FinalWriter{
-		privatKey: fwp.prm.PrivatKey,
-		pubKey:    fwp.prm.PubKey,
-		client:    fwp.prm.Client,
-		l:         fwp.opts.log,
+		signer: fwp.prm.Signer,
+		pubKey: fwp.prm.PubKey,
+		client: fwp.prm.Client,
+		l:      fwp.opts.log,
 	}, nil
 }
 
 // FinalWriter is an implementation of the reputation.eigentrust.calculator IntermediateWriter
 // interface that writes GlobalTrust to contract directly.
 type FinalWriter struct {
-	privatKey *ecdsa.PrivateKey
-	pubKey    []byte
-	client    *repClient.Client
+	signer signer.Signer
+	pubKey []byte
+	client *repClient.Client
 
 	l *zap.Logger
 }
@@ -90,7 +90,7 @@ func (fw FinalWriter) WriteIntermediateTrust(t eigentrust.IterationTrust) error
 	gTrust.SetTrust(apiTrust)
 	gTrust.SetManager(apiMangerPeerID)
 
-	err := gTrust.Sign(neofsecdsa.Signer(*fw.privatKey))
+	err := gTrust.Sign(signer.SDK(fw.signer, neofscrypto.ECDSA_SHA512))
 	if err != nil {
 		fw.l.Debug(
 			"failed to sign global trust",
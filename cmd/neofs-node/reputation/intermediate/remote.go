@@ -1,14 +1,13 @@
 package intermediate
 
 import (
-	"crypto/ecdsa"
-
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/reputation/common"
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-node/reputation/internal/client"
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/reputation"
 	reputationcommon "github.com/nspcc-dev/neofs-node/pkg/services/reputation/common"
 	eigentrustcalc "github.com/nspcc-dev/neofs-node/pkg/services/reputation/eigentrust/calculator"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	reputationapi "github.com/nspcc-dev/neofs-sdk-go/reputation"
 	"go.uber.org/zap"
 )
@@ -19,8 +18,8 @@ import (
 // Passing incorrect parameter values will result in constructor
 // failure (error or panic depending on the implementation).
 type RemoteProviderPrm struct {
-	Key *ecdsa.PrivateKey
-	Log *zap.Logger
+	Signer signer.Signer
+	Log    *zap.Logger
 }
 
 // NewRemoteProvider creates a new instance of the RemoteProvider.
@@ -31,35 +30,35 @@ type RemoteProviderPrm struct {
 // initialization and is completely ready for work.
 func NewRemoteProvider(prm RemoteProviderPrm) *RemoteProvider {
 	switch {
-	case prm.Key == nil:
-		common.PanicOnPrmValue("NetMapSource", prm.Key)
+	case prm.Signer == nil:
+		common.PanicOnPrmValue("Signer", prm.Signer)
 	case prm.Log == nil:
 		common.PanicOnPrmValue("Logger", prm.Log)
 	}
 
 	return &RemoteProvider{
-		key: prm.Key,
-		log: prm.Log,
+		signer: prm.Signer,
+		log:    prm.Log,
 	}
 }
 
 // RemoteProvider is an implementation of the clientKeyRemoteProvider interface.
 type RemoteProvider struct {
-	key *ecdsa.PrivateKey
-	log *zap.Logger
+	signer signer.Signer
+	log    *zap.Logger
 }
 
 func (rp RemoteProvider) WithClient(c coreclient.Client) reputationcommon.WriterProvider {
 	return &TrustWriterProvider{
 		client: c,
-		key:    rp.key,
+		signer: rp.signer,
 		log:    rp.log,
 	}
 }
 
 type TrustWriterProvider struct {
 	client coreclient.Client
-	key    *ecdsa.PrivateKey
+	signer signer.Signer
 	log    *zap.Logger
 }
 
@@ -73,7 +72,7 @@ func (twp *TrustWriterProvider) InitWriter(ctx reputationcommon.Context) (reputa
 	return &RemoteTrustWriter{
 		eiCtx:  eiContext,
 		client: twp.client,
-		key:    twp.key,
+		signer: twp.signer,
 		log:    twp.log,
 	}, nil
 }
@@ -81,7 +80,7 @@ func (twp *TrustWriterProvider) InitWriter(ctx reputationcommon.Context) (reputa
 type RemoteTrustWriter struct {
 	eiCtx  eigentrustcalc.Context
 	client coreclient.Client
-	key    *ecdsa.PrivateKey
+	signer signer.Signer
 	log    *zap.Logger
 }
 
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
+	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.uber.org/zap"
+)
+
+const (
+	// maximum number of the containers the revocations are tracked in
+	revocationCacheSize = 1000
+
+	// time limit of loading the revocations of the container
+	revocationLoadTimeout = 10 * time.Second
+
+	// number of the refresh periods the revocations of the unused
+	// container are tracked for
+	revocationIdleRefreshes = 10
+)
+
+// revocation is a token revocation object.
+type revocation struct {
+	id    string
+	owner user.ID
+}
+
+// containerRevocations are the token revocations in the container.
+type containerRevocations struct {
+	// closed when the revocations are loaded for the first time
+	ready chan struct{}
+	// error of the first load
+	err error
+
+	// revocation objects by their IDs, they never change, so only the new
+	// ones are requested on refresh
+	objs map[oid.ID]revocation
+	// owners of the revocations by the revocation IDs
+	owners map[string][]user.ID
+
+	lastUsed time.Time
+}
+
+// revocationCache is a v2.RevocationSource tracking the token revocations in
+// the containers. Revocations of the container are loaded on the first check
+// and refreshed in the background then, so the requests are not blocked by
+// the network operations except the first one. Load errors are never cached:
+// failed first load is retried by the next check, failed refresh keeps the
+// previous revocations.
+type revocationCache struct {
+	log *zap.Logger
+
+	search *searchsvc.Service
+	head   *getsvc.Service
+
+	ttl time.Duration
+
+	mtx  sync.Mutex
+	cnrs map[cid.ID]*containerRevocations
+}
+
+func newRevocationCache(l *zap.Logger, search *searchsvc.Service, head *getsvc.Service, ttl time.Duration) *revocationCache {
+	return &revocationCache{
+		log:    l,
+		search: search,
+		head:   head,
+		ttl:    ttl,
+		cnrs:   make(map[cid.ID]*containerRevocations),
+	}
+}
+
+func (c *revocationCache) IsRevoked(cnr cid.ID, issuer user.ID, id string) (bool, error) {
+	c.mtx.Lock()
+
+	r, ok := c.cnrs[cnr]
+	if !ok {
+		r = c.add(cnr)
+		c.mtx.Unlock()
+
+		objs, err := c.load(cnr, nil)
+
+		c.mtx.Lock()
+		if err != nil {
+			r.err = err
+			delete(c.cnrs, cnr)
+		} else {
+			r.set(objs)
+		}
+		close(r.ready)
+	}
+
+	r.lastUsed = time.Now()
+	c.mtx.Unlock()
+
+	<-r.ready
+
+	if r.err != nil {
+		return false, r.err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	owners := r.owners[id]
+	for i := range owners {
+		if owners[i].Equals(issuer) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// add starts tracking the revocations of the container evicting the least
+// recently used one if there are too many. Must be called under the lock.
+func (c *revocationCache) add(cnr cid.ID) *containerRevocations {
+	if len(c.cnrs) >= revocationCacheSize {
+		var (
+			oldest cid.ID
+			used   time.Time
+		)
+
+		for id, r := range c.cnrs {
+			if used.IsZero() || r.lastUsed.Before(used) {
+				oldest, used = id, r.lastUsed
+			}
+		}
+
+		delete(c.cnrs, oldest)
+	}
+
+	r := &containerRevocations{ready: make(chan struct{})}
+	c.cnrs[cnr] = r
+
+	return r
+}
+
+func (r *containerRevocations) set(objs map[oid.ID]revocation) {
+	r.objs = objs
+	r.owners = make(map[string][]user.ID, len(objs))
+
+	for _, rev := range objs {
+		r.owners[rev.id] = append(r.owners[rev.id], rev.owner)
+	}
+}
+
+// run refreshes the revocations of the tracked containers until the context
+// is done.
+func (c *revocationCache) run(ctx context.Context) {
+	t := time.NewTicker(c.ttl)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			c.refresh(ctx)
+		}
+	}
+}
+
+func (c *revocationCache) refresh(ctx context.Context) {
+	type loaded struct {
+		cnr  cid.ID
+		objs map[oid.ID]revocation
+	}
+
+	var list []loaded
+
+	c.mtx.Lock()
+	idleSince := time.Now().Add(-revocationIdleRefreshes * c.ttl)
+
+	for cnr, r := range c.cnrs {
+		select {
+		case <-r.ready:
+		default:
+			// first load is in progress
+			continue
+		}
+
+		if r.lastUsed.Before(idleSince) {
+			delete(c.cnrs, cnr)
+			continue
+		}
+
+		list = append(list, loaded{cnr: cnr, objs: r.objs})
+	}
+	c.mtx.Unlock()
+
+	for i := range list {
+		if ctx.Err() != nil {
+			return
+		}
+
+		objs, err := c.load(list[i].cnr, list[i].objs)
+		if err != nil {
+			c.log.Warn("could not refresh token revocations, previous ones are used",
+				zap.Stringer("container", list[i].cnr),
+				zap.String("error", err.Error()))
+
+			continue
+		}
+
+		c.mtx.Lock()
+		if r, ok := c.cnrs[list[i].cnr]; ok {
+			r.set(objs)
+		}
+		c.mtx.Unlock()
+	}
+}
+
+type idCollector struct {
+	ids []oid.ID
+}
+
+func (w *idCollector) WriteIDs(ids []oid.ID) error {
+	w.ids = append(w.ids, ids...)
+	return nil
+}
+
+// load returns the revocations in the container. Revocations from the known
+// ones are not requested again.
+func (c *revocationCache) load(cnr cid.ID, known map[oid.ID]revocation) (map[oid.ID]revocation, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), revocationLoadTimeout)
+	defer cancel()
+
+	var fs objectSDK.SearchFilters
+	fs.AddFilter(objectcore.AttributeRevokedToken, "", objectSDK.MatchCommonPrefix)
+
+	ids := new(idCollector)
+
+	var searchPrm searchsvc.Prm
+	searchPrm.SetWriter(ids)
+	searchPrm.WithContainerID(cnr)
+	searchPrm.WithSearchFilters(fs)
+
+	if err := c.search.Search(ctx, searchPrm); err != nil {
+		return nil, fmt.Errorf("search revocations: %w", err)
+	}
+
+	res := make(map[oid.ID]revocation, len(ids.ids))
+
+	for _, id := range ids.ids {
+		if rev, ok := known[id]; ok {
+			res[id] = rev
+			continue
+		}
+
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(id)
+
+		hdr := getsvc.NewSimpleObjectWriter()
+
+		var headPrm getsvc.HeadPrm
+		headPrm.SetHeaderWriter(hdr)
+		headPrm.WithAddress(addr)
+
+		err := c.head.Head(ctx, headPrm)
+		switch {
+		case err == nil:
+		case errors.Is(err, apistatus.ErrObjectNotFound), errors.Is(err, apistatus.ErrObjectAlreadyRemoved):
+			// removed concurrently
+			continue
+		case errors.Is(err, apistatus.ErrObjectAccessDenied):
+			// revocations may be inaccessible to the nodes outside the
+			// container, they are checked by the container nodes the
+			// requests are forwarded to
+			continue
+		default:
+			return nil, fmt.Errorf("head revocation %s: %w", id, err)
+		}
+
+		obj := hdr.Object()
+
+		if err := objectcore.VerifyRevocation(obj); err != nil {
+			c.log.Debug("ignore invalid token revocation",
+				zap.Stringer("address", addr),
+				zap.String("error", err.Error()))
+
+			continue
+		}
+
+		owner := obj.OwnerID()
+
+		for _, a := range obj.Attributes() {
+			if a.Key() == objectcore.AttributeRevokedToken {
+				res[id] = revocation{id: a.Value(), owner: *owner}
+				break
+			}
+		}
+	}
+
+	return res, nil
+}
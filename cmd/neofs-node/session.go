@@ -19,20 +19,33 @@ import (
 )
 
 type sessionStorage interface {
-	Create(ctx context.Context, body *session.CreateRequestBody) (*session.CreateResponseBody, error)
+	Create(ctx context.Context, body *session.CreateRequestBody, constraints map[string]string) (*session.CreateResponseBody, error)
 	Get(ownerID user.ID, tokenID []byte) *storage.PrivateToken
 	RemoveOld(epoch uint64)
 
 	Close() error
 }
 
+// sessionEncryptionLabel is a label of the key derived from the node signer
+// to encrypt persistent session keys if the node key is not available.
+const sessionEncryptionLabel = "neofs-node session storage"
+
 func initSessionService(c *cfg) {
 	if persistentSessionPath := nodeconfig.PersistentSessions(c.cfgReader).Path(); persistentSessionPath != "" {
-		persisessions, err := persistent.NewTokenStore(persistentSessionPath,
+		opts := []persistent.Option{
 			persistent.WithLogger(c.log),
 			persistent.WithTimeout(time.Second),
-			persistent.WithEncryptionKey(&c.key.PrivateKey),
-		)
+		}
+		if c.key != nil {
+			opts = append(opts, persistent.WithEncryptionKey(&c.key.PrivateKey))
+		} else {
+			secret, err := c.signer.DeriveKey([]byte(sessionEncryptionLabel))
+			fatalOnErrDetails("derive session encryption key", err)
+
+			opts = append(opts, persistent.WithEncryptionSecret(secret))
+		}
+
+		persisessions, err := persistent.NewTokenStore(persistentSessionPath, opts...)
 		if err != nil {
 			panic(fmt.Errorf("could not create persistent session token storage: %w", err))
 		}
@@ -52,7 +65,7 @@ func initSessionService(c *cfg) {
 
 	server := sessionTransportGRPC.New(
 		sessionSvc.NewSignService(
-			&c.key.PrivateKey,
+			c.signer,
 			sessionSvc.NewResponseService(
 				sessionSvc.NewExecutionService(c.privateTokenStore, c.log),
 				c.respSvc,
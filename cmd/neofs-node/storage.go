@@ -4,7 +4,9 @@ import (
 	"time"
 
 	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/storage"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/peapod"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
@@ -20,7 +22,9 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/tombstone"
 	tsourse "github.com/nspcc-dev/neofs-node/pkg/services/object_manager/tombstone/source"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/panjf2000/ants/v2"
 	"go.etcd.io/bbolt"
 	"go.uber.org/zap"
@@ -106,9 +110,47 @@ func (c *cfg) engineOpts() []engine.Option {
 		opts = append(opts, engine.WithMetrics(c.metricsCollector))
 	}
 
+	// objects removed by any reason are dropped from the cache of the read
+	// objects, the service is allocated after the engine
+	opts = append(opts, engine.WithInhumedObjectsCallback(func(cnr cid.ID, ids []oid.ID) {
+		if svc := c.cfgObject.getSvc; svc != nil {
+			svc.InvalidateObjects(cnr, ids)
+		}
+	}))
+
 	return opts
 }
 
+// shardCipher returns cipher encrypting objects stored in the shard or nil if
+// the encryption is disabled for the shard. Data key of the shard is created on
+// the first use.
+func (c *cfg) shardCipher(shCfg *storage.ShardCfg) *encryption.Cipher {
+	if !shCfg.EncryptionCfg.Enabled {
+		return nil
+	}
+
+	key, err := encryption.LoadOrCreateDataKey(shCfg.EncryptionCfg.KeyPath, c.blobstorMasterKey())
+	fatalOnErrDetails("load shard data key", err)
+
+	cph, err := encryption.NewCipher(key)
+	fatalOnErrDetails("init shard cipher", err)
+
+	return cph
+}
+
+// blobstorMasterKey returns the master key wrapping data keys of the shards.
+// If the key is not configured, it is derived from the node signer.
+func (c *cfg) blobstorMasterKey() []byte {
+	if c.engine.encryptionMasterKey == nil {
+		key, err := encryption.MasterKeyFromSigner(c.signer)
+		fatalOnErrDetails("derive blobstor master key from signer", err)
+
+		c.engine.encryptionMasterKey = key
+	}
+
+	return c.engine.encryptionMasterKey
+}
+
 type shardOptsWithID struct {
 	configID string
 	shOpts   []shard.Option
@@ -118,6 +160,8 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 	shards := make([]shardOptsWithID, 0, len(c.engine.shards))
 
 	for _, shCfg := range c.engine.shards {
+		cph := c.shardCipher(&shCfg)
+
 		var writeCacheOpts []writecache.Option
 		if wcRead := shCfg.WritecacheCfg; wcRead.Enabled {
 			writeCacheOpts = append(writeCacheOpts,
@@ -129,6 +173,7 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 				writecache.WithFlushWorkersCount(wcRead.FlushWorkerCount),
 				writecache.WithMaxCacheSize(wcRead.SizeLimit),
 				writecache.WithNoSync(wcRead.NoSync),
+				writecache.WithCipher(cph),
 				writecache.WithLogger(c.log),
 			)
 		}
@@ -181,6 +226,7 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 				blobstor.WithCompressObjects(shCfg.Compress),
 				blobstor.WithUncompressableContentTypes(shCfg.UncompressableContentType),
 				blobstor.WithStorages(ss),
+				blobstor.WithCipher(cph),
 
 				blobstor.WithLogger(c.log),
 			),
@@ -42,6 +42,11 @@ type ShardCfg struct {
 		NoSync           bool
 	}
 
+	EncryptionCfg struct {
+		Enabled bool
+		KeyPath string
+	}
+
 	PiloramaCfg struct {
 		Enabled       bool
 		Path          string
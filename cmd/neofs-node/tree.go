@@ -41,20 +41,27 @@ func initTreeService(c *cfg) {
 		return
 	}
 
-	c.treeService = tree.New(
+	var opts []tree.Option
+	if c.key != nil {
+		// mutual TLS authentication is not possible without the key
+		opts = append(opts, tree.WithPrivateKey(&c.key.PrivateKey))
+	}
+
+	c.treeService = tree.New(append(opts,
 		tree.WithContainerSource(cnrSource{
 			src: c.cfgObject.cnrSource,
 			cli: c.shared.basics.cCli,
 		}),
 		tree.WithEACLSource(c.cfgObject.eaclSource),
 		tree.WithNetmapSource(c.netMapSource),
-		tree.WithPrivateKey(&c.key.PrivateKey),
+		tree.WithSigner(c.signer),
 		tree.WithLogger(c.log),
 		tree.WithStorage(c.cfgObject.cfgLocalStorage.localStorage),
 		tree.WithContainerCacheSize(treeConfig.CacheSize()),
 		tree.WithReplicationTimeout(treeConfig.ReplicationTimeout()),
 		tree.WithReplicationChannelCapacity(treeConfig.ReplicationChannelCapacity()),
-		tree.WithReplicationWorkerCount(treeConfig.ReplicationWorkerCount()))
+		tree.WithReplicationWorkerCount(treeConfig.ReplicationWorkerCount()),
+		tree.WithMutualTLS(treeConfig.MutualTLS()))...)
 
 	for _, srv := range c.cfgGRPC.servers {
 		tree.RegisterTreeServiceServer(srv, c.treeService)
@@ -0,0 +1,594 @@
+// Package ec implements erasure coding of the NeoFS objects.
+//
+// Erasure-coded object (parent) is split into K data and M parity parts with
+// Reed-Solomon codes. Each part is a regular NeoFS object carrying information
+// about its parent in the system attributes, so any K parts are enough to
+// restore the parent object and the missing parts. Part headers are formed
+// deterministically, so the restored parts have the same identifiers as the
+// original ones.
+package ec
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/reedsolomon"
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ContainerAttribute is a container attribute enabling erasure coding of the
+// container objects. Its value has "K/M" format where K is a number of data
+// parts and M is a number of parity parts, e.g. "4/2".
+const ContainerAttribute = "__NEOFS__EC"
+
+// System attributes of the object parts.
+const (
+	// AttributePrefix is a prefix of all system attributes of the parts. Such
+	// attributes are set by the storage nodes only.
+	AttributePrefix = "__NEOFS__EC_"
+	// AttributeParent is an attribute with the parent object ID.
+	AttributeParent = "__NEOFS__EC_PARENT"
+	// AttributeIndex is an attribute with the part index. Indices [0; K) are
+	// used by the data parts and [K; K+M) by the parity ones.
+	AttributeIndex = "__NEOFS__EC_INDEX"
+	// AttributeRule is an attribute with the erasure coding rule in the
+	// ContainerAttribute format.
+	AttributeRule = "__NEOFS__EC_RULE"
+	// AttributeParentHeader is an attribute with the base64-encoded binary
+	// header of the parent object.
+	AttributeParentHeader = "__NEOFS__EC_PARENT_HEADER"
+)
+
+// maxParts is a limit of the total number of parts supported by the codec.
+const maxParts = 256
+
+var (
+	// ErrNotEnoughParts is returned when the number of the available distinct
+	// parts is less than the number of the data parts.
+	ErrNotEnoughParts = errors.New("not enough parts to restore the object")
+	// ErrNotEnoughNodes is returned when the object placement contains less
+	// nodes than the total number of parts.
+	ErrNotEnoughNodes = errors.New("not enough nodes to place the object parts")
+)
+
+// Rule describes how the objects are erasure-coded.
+type Rule struct {
+	// DataParts is a number of the data parts.
+	DataParts int
+	// ParityParts is a number of the parity parts.
+	ParityParts int
+}
+
+// IsZero checks whether the rule is unset, i.e. erasure coding is disabled.
+func (r Rule) IsZero() bool {
+	return r.DataParts == 0
+}
+
+// Total returns total number of the object parts.
+func (r Rule) Total() int {
+	return r.DataParts + r.ParityParts
+}
+
+// String returns rule in the ContainerAttribute format.
+func (r Rule) String() string {
+	return strconv.Itoa(r.DataParts) + "/" + strconv.Itoa(r.ParityParts)
+}
+
+// PartPayloadSize returns payload size of each part of the object with the
+// given payload size.
+func (r Rule) PartPayloadSize(payloadSize uint64) uint64 {
+	return (payloadSize + uint64(r.DataParts) - 1) / uint64(r.DataParts)
+}
+
+// PartRange is a range of the data part payload.
+type PartRange struct {
+	// Index is an index of the data part.
+	Index int
+	// Offset is an offset of the range in the part payload.
+	Offset uint64
+	// Length is a length of the range.
+	Length uint64
+}
+
+// DataRanges returns ranges of the data parts payloads which form the given
+// range of the parent payload being concatenated in order. The range must be
+// within the payload of the given size.
+func (r Rule) DataRanges(payloadSize, off, ln uint64) []PartRange {
+	partSize := r.PartPayloadSize(payloadSize)
+	if partSize == 0 || ln == 0 {
+		return nil
+	}
+
+	var res []PartRange
+
+	for end := off + ln; off < end; {
+		idx := off / partSize
+		partOff := off % partSize
+
+		n := partSize - partOff
+		if n > end-off {
+			n = end - off
+		}
+
+		res = append(res, PartRange{Index: int(idx), Offset: partOff, Length: n})
+		off += n
+	}
+
+	return res
+}
+
+// ParseRule parses erasure coding rule in the ContainerAttribute format.
+func ParseRule(s string) (Rule, error) {
+	k, m, found := strings.Cut(s, "/")
+	if !found {
+		return Rule{}, fmt.Errorf("invalid rule %q: missing separator", s)
+	}
+
+	var (
+		r   Rule
+		err error
+	)
+
+	r.DataParts, err = strconv.Atoi(k)
+	if err != nil {
+		return Rule{}, fmt.Errorf("invalid number of data parts in rule %q: %w", s, err)
+	}
+
+	r.ParityParts, err = strconv.Atoi(m)
+	if err != nil {
+		return Rule{}, fmt.Errorf("invalid number of parity parts in rule %q: %w", s, err)
+	}
+
+	switch {
+	case r.DataParts <= 0:
+		return Rule{}, fmt.Errorf("invalid rule %q: non-positive number of data parts", s)
+	case r.ParityParts <= 0:
+		return Rule{}, fmt.Errorf("invalid rule %q: non-positive number of parity parts", s)
+	case r.Total() > maxParts:
+		return Rule{}, fmt.Errorf("invalid rule %q: more than %d parts", s, maxParts)
+	}
+
+	return r, nil
+}
+
+// RuleFromContainer returns erasure coding rule of the container. Returns
+// zero rule if erasure coding is disabled in the container.
+func RuleFromContainer(cnr container.Container) (Rule, error) {
+	s := cnr.Attribute(ContainerAttribute)
+	if s == "" {
+		return Rule{}, nil
+	}
+
+	return ParseRule(s)
+}
+
+// Applicable checks whether the object can be erasure-coded. Only regular
+// objects without the parent header and the children list are coded: the
+// small objects and the children of the split objects except the ones
+// carrying the parent header. System objects, linking objects and the children
+// carrying the parent header are stored with the full copies since they are
+// needed to find and assemble the parent object. Parts are not coded twice.
+func Applicable(obj *object.Object) bool {
+	if obj.Type() != object.TypeRegular || IsPart(obj) {
+		return false
+	}
+
+	return obj.Parent() == nil && len(obj.Children()) == 0
+}
+
+// IsPart checks whether the object is a part of the erasure-coded object.
+func IsPart(obj *object.Object) bool {
+	return attribute(obj, AttributeParent) != ""
+}
+
+// PartInfo describes a part of the erasure-coded object.
+type PartInfo struct {
+	// Parent is an ID of the parent object.
+	Parent oid.ID
+	// Index is an index of the part.
+	Index int
+	// Rule is an erasure coding rule the parent was coded with.
+	Rule Rule
+}
+
+// PartInfoOf reads information about the part from its header. Returns
+// false if the object is not a part.
+func PartInfoOf(part *object.Object) (PartInfo, bool, error) {
+	sParent := attribute(part, AttributeParent)
+	if sParent == "" {
+		return PartInfo{}, false, nil
+	}
+
+	var (
+		res PartInfo
+		err error
+	)
+
+	if err = res.Parent.DecodeString(sParent); err != nil {
+		return PartInfo{}, true, fmt.Errorf("invalid parent ID: %w", err)
+	}
+
+	if res.Rule, err = ParseRule(attribute(part, AttributeRule)); err != nil {
+		return PartInfo{}, true, err
+	}
+
+	if res.Index, err = strconv.Atoi(attribute(part, AttributeIndex)); err != nil {
+		return PartInfo{}, true, fmt.Errorf("invalid part index: %w", err)
+	}
+
+	if res.Index < 0 || res.Index >= res.Rule.Total() {
+		return PartInfo{}, true, fmt.Errorf("part index %d is out of rule %s", res.Index, res.Rule)
+	}
+
+	return res, true, nil
+}
+
+// ParentHeader decodes and verifies header of the parent object stored in
+// the part.
+func ParentHeader(part *object.Object) (*object.Object, error) {
+	bHdr, err := base64.StdEncoding.DecodeString(attribute(part, AttributeParentHeader))
+	if err != nil {
+		return nil, fmt.Errorf("decode parent header: %w", err)
+	}
+
+	hdr := object.New()
+	if err := hdr.Unmarshal(bHdr); err != nil {
+		return nil, fmt.Errorf("unmarshal parent header: %w", err)
+	}
+
+	if err := hdr.CheckHeaderVerificationFields(); err != nil {
+		return nil, fmt.Errorf("verify parent header: %w", err)
+	}
+
+	parent, _ := hdr.ID()
+	if info, _, err := PartInfoOf(part); err != nil || info.Parent != parent {
+		return nil, errors.New("parent header does not match parent ID")
+	}
+
+	return hdr, nil
+}
+
+// VerifyPart checks that the part header is correctly signed and matches the
+// header of the parent object stored in it: the part belongs to the same
+// container and owner, and its payload size corresponds to the parent one.
+// Returns information about the part and the verified parent header. Payload
+// of the part must be checked against the part header separately.
+func VerifyPart(part *object.Object) (PartInfo, *object.Object, error) {
+	info, ok, err := PartInfoOf(part)
+	if err != nil {
+		return PartInfo{}, nil, err
+	} else if !ok {
+		return PartInfo{}, nil, errors.New("object is not a part")
+	}
+
+	if err = part.CheckHeaderVerificationFields(); err != nil {
+		return PartInfo{}, nil, fmt.Errorf("verify part header: %w", err)
+	}
+
+	parent, err := ParentHeader(part)
+	if err != nil {
+		return PartInfo{}, nil, err
+	}
+
+	partCnr, _ := part.ContainerID()
+	parentCnr, _ := parent.ContainerID()
+	if partCnr != parentCnr {
+		return PartInfo{}, nil, errors.New("part and parent containers differ")
+	}
+
+	partOwner, parentOwner := part.OwnerID(), parent.OwnerID()
+	if partOwner == nil || parentOwner == nil || !partOwner.Equals(*parentOwner) {
+		return PartInfo{}, nil, errors.New("part and parent owners differ")
+	}
+
+	if sz := info.Rule.PartPayloadSize(parent.PayloadSize()); part.PayloadSize() != sz {
+		return PartInfo{}, nil, fmt.Errorf("part payload size %d differs from the expected %d", part.PayloadSize(), sz)
+	}
+
+	return info, parent, nil
+}
+
+// SearchFilters returns filters selecting all parts of the given parent
+// object.
+func SearchFilters(parent oid.ID) object.SearchFilters {
+	fs := object.NewSearchFilters()
+	fs.AddFilter(AttributeParent, parent.EncodeToString(), object.MatchStringEqual)
+	return fs
+}
+
+// Encode splits the parent object into the parts according to the rule and
+// signs them with the given signer. The parent object must have ID and payload.
+func Encode(r Rule, parent *object.Object, signer neofscrypto.Signer) ([]*object.Object, error) {
+	enc, err := reedsolomon.New(r.DataParts, r.ParityParts)
+	if err != nil {
+		return nil, fmt.Errorf("init encoder: %w", err)
+	}
+
+	payload := parent.Payload()
+
+	var shards [][]byte
+	if len(payload) == 0 {
+		shards = make([][]byte, r.Total())
+	} else {
+		// Split may use the data buffer for the shards, so copy the payload
+		shards, err = enc.Split(slice.Copy(payload))
+		if err != nil {
+			return nil, fmt.Errorf("split payload: %w", err)
+		}
+
+		if err = enc.Encode(shards); err != nil {
+			return nil, fmt.Errorf("encode payload: %w", err)
+		}
+	}
+
+	bHdr, err := parent.CutPayload().Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal parent header: %w", err)
+	}
+
+	tmpl := partTemplate{
+		rule:   r,
+		parent: parent,
+		hdr:    base64.StdEncoding.EncodeToString(bHdr),
+	}
+
+	parts := make([]*object.Object, len(shards))
+	for i := range shards {
+		if parts[i], err = tmpl.form(i, shards[i], signer); err != nil {
+			return nil, fmt.Errorf("form part #%d: %w", i, err)
+		}
+	}
+
+	return parts, nil
+}
+
+// Decode restores the parent object from the parts. At least
+// Rule.DataParts distinct parts of the same parent are required, the rest
+// of the parts are ignored.
+func Decode(parts []*object.Object) (*object.Object, error) {
+	info, shards, err := collectShards(parts)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, err := ParentHeader(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	size := parent.PayloadSize()
+	if size == 0 {
+		parent.SetPayload([]byte{})
+		return parent, nil
+	}
+
+	enc, err := reedsolomon.New(info.Rule.DataParts, info.Rule.ParityParts)
+	if err != nil {
+		return nil, fmt.Errorf("init decoder: %w", err)
+	}
+
+	if err = enc.ReconstructData(shards); err != nil {
+		return nil, fmt.Errorf("reconstruct data parts: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(int(size))
+
+	if err = enc.Join(&buf, shards, int(size)); err != nil {
+		return nil, fmt.Errorf("join data parts: %w", err)
+	}
+
+	parent.SetPayload(buf.Bytes())
+
+	if err = parent.VerifyPayloadChecksum(); err != nil {
+		return nil, fmt.Errorf("verify restored payload: %w", err)
+	}
+
+	return parent, nil
+}
+
+// Reconstruct restores all parts of the parent object from the given ones.
+// Restored parts are signed with the given signer and have the same IDs as
+// the original ones. The result is indexed by the part indices.
+func Reconstruct(parts []*object.Object, signer neofscrypto.Signer) ([]*object.Object, error) {
+	info, shards, err := collectShards(parts)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, err := ParentHeader(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*object.Object, info.Rule.Total())
+	for i := range parts {
+		pi, _, _ := PartInfoOf(parts[i])
+		res[pi.Index] = parts[i]
+	}
+
+	if parent.PayloadSize() == 0 {
+		for i := range shards {
+			shards[i] = []byte{}
+		}
+	} else {
+		enc, err := reedsolomon.New(info.Rule.DataParts, info.Rule.ParityParts)
+		if err != nil {
+			return nil, fmt.Errorf("init decoder: %w", err)
+		}
+
+		if err = enc.Reconstruct(shards); err != nil {
+			return nil, fmt.Errorf("reconstruct parts: %w", err)
+		}
+	}
+
+	tmpl := partTemplate{
+		rule:   info.Rule,
+		parent: parent,
+		hdr:    attribute(parts[0], AttributeParentHeader),
+	}
+
+	for i := range res {
+		if res[i] != nil {
+			continue
+		}
+
+		if res[i], err = tmpl.form(i, shards[i], signer); err != nil {
+			return nil, fmt.Errorf("form part #%d: %w", i, err)
+		}
+	}
+
+	return res, nil
+}
+
+// PartNodes returns storage nodes for the object parts: i-th part is stored on
+// the i-th node. Nodes are taken from the object placement vectors in order,
+// each node is used only once.
+func PartNodes(vectors [][]netmap.NodeInfo, r Rule) ([]netmap.NodeInfo, error) {
+	res := make([]netmap.NodeInfo, 0, r.Total())
+	seen := make(map[string]struct{}, r.Total())
+
+	for i := range vectors {
+		for j := range vectors[i] {
+			if len(res) == r.Total() {
+				return res, nil
+			}
+
+			key := string(vectors[i][j].PublicKey())
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			res = append(res, vectors[i][j])
+		}
+	}
+
+	if len(res) < r.Total() {
+		return nil, fmt.Errorf("%w: %d < %d", ErrNotEnoughNodes, len(res), r.Total())
+	}
+
+	return res, nil
+}
+
+// collectShards checks that the parts belong to the same parent and returns
+// the part payloads indexed by the part indices. Missing parts are nil.
+func collectShards(parts []*object.Object) (PartInfo, [][]byte, error) {
+	if len(parts) == 0 {
+		return PartInfo{}, nil, ErrNotEnoughParts
+	}
+
+	first, ok, err := PartInfoOf(parts[0])
+	if err != nil {
+		return PartInfo{}, nil, fmt.Errorf("invalid part: %w", err)
+	} else if !ok {
+		return PartInfo{}, nil, errors.New("object is not a part")
+	}
+
+	shards := make([][]byte, first.Rule.Total())
+	seen := make([]bool, len(shards))
+
+	var n int
+	for i := range parts {
+		info, ok, err := PartInfoOf(parts[i])
+		if err != nil {
+			return PartInfo{}, nil, fmt.Errorf("invalid part: %w", err)
+		} else if !ok {
+			return PartInfo{}, nil, errors.New("object is not a part")
+		}
+
+		if info.Parent != first.Parent || info.Rule != first.Rule {
+			return PartInfo{}, nil, errors.New("parts of different objects")
+		}
+
+		if err = parts[i].VerifyPayloadChecksum(); err != nil {
+			return PartInfo{}, nil, fmt.Errorf("part #%d: %w", info.Index, err)
+		}
+
+		if !seen[info.Index] {
+			seen[info.Index] = true
+			shards[info.Index] = parts[i].Payload()
+			n++
+		}
+	}
+
+	if n < first.Rule.DataParts {
+		return PartInfo{}, nil, fmt.Errorf("%w: %d < %d", ErrNotEnoughParts, n, first.Rule.DataParts)
+	}
+
+	return first, shards, nil
+}
+
+// partTemplate forms the part headers of the particular parent.
+type partTemplate struct {
+	rule   Rule
+	parent *object.Object
+	// base64-encoded parent header
+	hdr string
+}
+
+func (t partTemplate) form(idx int, payload []byte, signer neofscrypto.Signer) (*object.Object, error) {
+	parentID, _ := t.parent.ID()
+	cnr, _ := t.parent.ContainerID()
+
+	attrs := make([]object.Attribute, 0, 5)
+	attrs = append(attrs,
+		*object.NewAttribute(AttributeParent, parentID.EncodeToString()),
+		*object.NewAttribute(AttributeIndex, strconv.Itoa(idx)),
+		*object.NewAttribute(AttributeRule, t.rule.String()),
+		*object.NewAttribute(AttributeParentHeader, t.hdr),
+	)
+
+	// parts expire along with the parent
+	if exp := attribute(t.parent, object.AttributeExpirationEpoch); exp != "" {
+		attrs = append(attrs, *object.NewAttribute(object.AttributeExpirationEpoch, exp))
+	}
+
+	part := object.New()
+	part.SetVersion(t.parent.Version())
+	part.SetContainerID(cnr)
+	part.SetOwnerID(t.parent.OwnerID())
+	part.SetCreationEpoch(t.parent.CreationEpoch())
+	part.SetType(object.TypeRegular)
+	part.SetAttributes(attrs...)
+	part.SetPayload(payload)
+	part.SetPayloadSize(uint64(len(payload)))
+	part.SetPayloadChecksum(object.CalculatePayloadChecksum(payload))
+
+	if _, ok := t.parent.PayloadHomomorphicHash(); ok {
+		var cs checksum.Checksum
+		checksum.Calculate(&cs, checksum.TZ, payload)
+		part.SetPayloadHomomorphicHash(cs)
+	}
+
+	if err := part.CalculateAndSetID(); err != nil {
+		return nil, fmt.Errorf("calculate ID: %w", err)
+	}
+
+	if err := part.Sign(signer); err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
+	}
+
+	return part, nil
+}
+
+func attribute(obj *object.Object, key string) string {
+	attrs := obj.Attributes()
+	for i := range attrs {
+		if attrs[i].Key() == key {
+			return attrs[i].Value()
+		}
+	}
+
+	return ""
+}
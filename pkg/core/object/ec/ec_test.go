@@ -0,0 +1,286 @@
+package ec
+
+import (
+	"crypto/rand"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newParent(t *testing.T, size int) *object.Object {
+	payload := make([]byte, size)
+	_, _ = rand.Read(payload)
+
+	obj := object.New()
+	obj.SetContainerID(cidtest.ID())
+	owner := usertest.ID(t)
+	obj.SetOwnerID(&owner)
+	obj.SetCreationEpoch(10)
+	obj.SetAttributes(*object.NewAttribute(object.AttributeExpirationEpoch, "100"))
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(size))
+	require.NoError(t, obj.SetVerificationFields(neofscryptotest.RandomSigner(t)))
+
+	return obj
+}
+
+func TestParseRule(t *testing.T) {
+	r, err := ParseRule("4/2")
+	require.NoError(t, err)
+	require.Equal(t, Rule{DataParts: 4, ParityParts: 2}, r)
+	require.Equal(t, 6, r.Total())
+	require.Equal(t, "4/2", r.String())
+
+	for _, s := range []string{"", "4", "4/", "/2", "0/2", "4/0", "-1/2", "a/b", "200/100"} {
+		_, err := ParseRule(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestRuleFromContainer(t *testing.T) {
+	var cnr container.Container
+
+	r, err := RuleFromContainer(cnr)
+	require.NoError(t, err)
+	require.True(t, r.IsZero())
+
+	cnr.SetAttribute(ContainerAttribute, "3/1")
+	r, err = RuleFromContainer(cnr)
+	require.NoError(t, err)
+	require.Equal(t, Rule{DataParts: 3, ParityParts: 1}, r)
+}
+
+func TestApplicable(t *testing.T) {
+	obj := newParent(t, 10)
+	require.True(t, Applicable(obj))
+
+	obj.SetType(object.TypeTombstone)
+	require.False(t, Applicable(obj))
+
+	obj.SetType(object.TypeRegular)
+	obj.SetSplitID(object.NewSplitID())
+	require.True(t, Applicable(obj), "middle child")
+
+	obj.SetParent(newParent(t, 10))
+	require.False(t, Applicable(obj), "child with parent header")
+
+	obj.ResetRelations()
+	obj.SetChildren(oidtest.ID())
+	require.False(t, Applicable(obj), "linking object")
+
+	parts, err := Encode(Rule{DataParts: 2, ParityParts: 1}, newParent(t, 10), neofscryptotest.RandomSigner(t))
+	require.NoError(t, err)
+	require.False(t, Applicable(parts[0]))
+}
+
+func TestEncodeDecode(t *testing.T) {
+	rule := Rule{DataParts: 4, ParityParts: 2}
+	signer := neofscryptotest.RandomSigner(t)
+
+	for _, size := range []int{0, 1, 1000, 1024} {
+		t.Run(strconv.Itoa(size), func(t *testing.T) {
+			parent := newParent(t, size)
+			parentID, _ := parent.ID()
+
+			parts, err := Encode(rule, parent, signer)
+			require.NoError(t, err)
+			require.Len(t, parts, rule.Total())
+
+			for i := range parts {
+				require.NoError(t, parts[i].CheckVerificationFields())
+
+				info, ok, err := PartInfoOf(parts[i])
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(t, PartInfo{Parent: parentID, Index: i, Rule: rule}, info)
+
+				hdr, err := ParentHeader(parts[i])
+				require.NoError(t, err)
+				require.Equal(t, parent.CutPayload(), hdr)
+			}
+
+			// any DataParts parts are enough
+			restored, err := Decode(parts[rule.ParityParts:])
+			require.NoError(t, err)
+			require.Equal(t, parent.Payload(), restored.Payload())
+
+			restored, err = Decode([]*object.Object{parts[5], parts[0], parts[3], parts[1]})
+			require.NoError(t, err)
+			require.Equal(t, parent.Payload(), restored.Payload())
+
+			_, err = Decode(parts[:rule.DataParts-1])
+			require.ErrorIs(t, err, ErrNotEnoughParts)
+
+			// duplicates are not counted
+			_, err = Decode([]*object.Object{parts[0], parts[0], parts[1], parts[2]})
+			require.ErrorIs(t, err, ErrNotEnoughParts)
+		})
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	rule := Rule{DataParts: 3, ParityParts: 2}
+	parent := newParent(t, 1<<10)
+
+	parts, err := Encode(rule, parent, neofscryptotest.RandomSigner(t))
+	require.NoError(t, err)
+
+	restored, err := Reconstruct([]*object.Object{parts[4], parts[1], parts[2]}, neofscryptotest.RandomSigner(t))
+	require.NoError(t, err)
+	require.Len(t, restored, rule.Total())
+
+	for i := range parts {
+		require.NoError(t, restored[i].CheckVerificationFields())
+
+		expected, _ := parts[i].ID()
+		actual, _ := restored[i].ID()
+		require.Equal(t, expected, actual, i)
+		require.Equal(t, parts[i].Payload(), restored[i].Payload(), i)
+	}
+
+	t.Run("corrupted part", func(t *testing.T) {
+		corrupted := object.New()
+		parts[0].CopyTo(corrupted)
+		corrupted.Payload()[0]++
+
+		_, err := Reconstruct([]*object.Object{corrupted, parts[1], parts[2]}, neofscryptotest.RandomSigner(t))
+		require.Error(t, err)
+	})
+	t.Run("different parents", func(t *testing.T) {
+		other, err := Encode(rule, newParent(t, 10), neofscryptotest.RandomSigner(t))
+		require.NoError(t, err)
+
+		_, err = Reconstruct([]*object.Object{other[0], parts[1], parts[2]}, neofscryptotest.RandomSigner(t))
+		require.Error(t, err)
+	})
+}
+
+func TestPartNodes(t *testing.T) {
+	nodes := make([]netmap.NodeInfo, 5)
+	for i := range nodes {
+		nodes[i].SetPublicKey([]byte{byte(i)})
+	}
+
+	rule := Rule{DataParts: 2, ParityParts: 1}
+
+	res, err := PartNodes([][]netmap.NodeInfo{{nodes[0], nodes[1]}, {nodes[1], nodes[2], nodes[3]}}, rule)
+	require.NoError(t, err)
+	require.Equal(t, nodes[:3], res)
+
+	_, err = PartNodes([][]netmap.NodeInfo{{nodes[0], nodes[1]}, {nodes[0]}}, rule)
+	require.ErrorIs(t, err, ErrNotEnoughNodes)
+}
+
+func TestVerifyPart(t *testing.T) {
+	rule := Rule{DataParts: 2, ParityParts: 1}
+	signer := neofscryptotest.RandomSigner(t)
+
+	parent := newParent(t, 100)
+	parentID, _ := parent.ID()
+
+	parts, err := Encode(rule, parent, signer)
+	require.NoError(t, err)
+
+	for i := range parts {
+		info, hdr, err := VerifyPart(parts[i].CutPayload())
+		require.NoError(t, err)
+		require.Equal(t, PartInfo{Parent: parentID, Index: i, Rule: rule}, info)
+		require.Equal(t, parent.CutPayload(), hdr)
+	}
+
+	_, _, err = VerifyPart(parent)
+	require.Error(t, err, "not a part")
+
+	// header copy not sharing the fields with the original part
+	partHeader := func(t *testing.T) *object.Object {
+		b, err := parts[0].CutPayload().Marshal()
+		require.NoError(t, err)
+
+		part := object.New()
+		require.NoError(t, part.Unmarshal(b))
+
+		return part
+	}
+
+	resign := func(t *testing.T, part *object.Object) *object.Object {
+		require.NoError(t, part.SetIDWithSignature(signer))
+		return part
+	}
+
+	t.Run("broken signature", func(t *testing.T) {
+		part := partHeader(t)
+		part.SetCreationEpoch(part.CreationEpoch() + 1)
+
+		_, _, err := VerifyPart(part)
+		require.Error(t, err)
+	})
+
+	t.Run("another owner", func(t *testing.T) {
+		part := partHeader(t)
+		owner := usertest.ID(t)
+		part.SetOwnerID(&owner)
+
+		_, _, err := VerifyPart(resign(t, part))
+		require.Error(t, err)
+	})
+
+	t.Run("another container", func(t *testing.T) {
+		part := partHeader(t)
+		part.SetContainerID(cidtest.ID())
+
+		_, _, err := VerifyPart(resign(t, part))
+		require.Error(t, err)
+	})
+
+	t.Run("wrong payload size", func(t *testing.T) {
+		part := partHeader(t)
+		part.SetPayloadSize(part.PayloadSize() + 1)
+
+		_, _, err := VerifyPart(resign(t, part))
+		require.Error(t, err)
+	})
+}
+
+func TestSearchFilters(t *testing.T) {
+	id := oidtest.ID()
+
+	fs := SearchFilters(id)
+	require.Len(t, fs, 1)
+	require.Equal(t, AttributeParent, fs[0].Header())
+	require.Equal(t, id.EncodeToString(), fs[0].Value())
+}
+
+func TestRule_DataRanges(t *testing.T) {
+	rule := Rule{DataParts: 3, ParityParts: 1}
+	parent := newParent(t, 10)
+
+	parts, err := Encode(rule, parent, neofscryptotest.RandomSigner(t))
+	require.NoError(t, err)
+
+	for i := 0; i < rule.DataParts; i++ {
+		require.EqualValues(t, rule.PartPayloadSize(10), parts[i].PayloadSize())
+	}
+
+	for off := uint64(0); off < 10; off++ {
+		for ln := uint64(1); off+ln <= 10; ln++ {
+			var payload []byte
+
+			for _, r := range rule.DataRanges(10, off, ln) {
+				payload = append(payload, parts[r.Index].Payload()[r.Offset:r.Offset+r.Length]...)
+			}
+
+			require.Equal(t, parent.Payload()[off:off+ln], payload, "range [%d:%d]", off, off+ln)
+		}
+	}
+
+	require.Empty(t, rule.DataRanges(10, 5, 0))
+	require.Empty(t, rule.DataRanges(0, 0, 0))
+}
@@ -4,8 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -107,6 +109,13 @@ func (v *FormatValidator) Validate(obj *object.Object, unprepared bool) error {
 		return fmt.Errorf("invalid attributes: %w", err)
 	}
 
+	if unprepared {
+		// headers of the unprepared objects are formed by the clients
+		if key, ok := NodeAttribute(obj); ok {
+			return fmt.Errorf("%w: %s", errNodeAttribute, key)
+		}
+	}
+
 	if !unprepared {
 		if err := v.validateSignatureKey(obj); err != nil {
 			return fmt.Errorf("(%T) could not validate signature key: %w", v, err)
@@ -366,9 +375,43 @@ func (v *FormatValidator) checkAttributes(obj *object.Object) error {
 		mUnique[key] = struct{}{}
 	}
 
+	if _, ok, err := OverlayTarget(obj); err != nil {
+		return err
+	} else if ok && obj.Type() != object.TypeRegular {
+		return errOverlayType
+	}
+
 	return nil
 }
 
+var errNodeAttribute = errors.New("attribute is set by the storage nodes only")
+
+// IsNodeAttribute checks whether the system attribute is set by the storage
+// nodes only: attributes of the erasure-coded object parts and the references
+// of the copied and patched objects. Such attributes are rejected in the
+// object headers formed by the clients, the signed objects carrying them are
+// accepted from the container nodes only.
+func IsNodeAttribute(key string) bool {
+	return strings.HasPrefix(key, ec.AttributePrefix) ||
+		strings.HasPrefix(key, AttributeSharedPrefix) ||
+		key == AttributePatchOrigin
+}
+
+// NodeAttribute returns the first attribute of the object or its parent
+// header which is set by the storage nodes only (see IsNodeAttribute).
+// Returns false if there are no such attributes.
+func NodeAttribute(obj *object.Object) (string, bool) {
+	for ; obj != nil; obj = obj.Parent() {
+		for _, a := range obj.Attributes() {
+			if IsNodeAttribute(a.Key()) {
+				return a.Key(), true
+			}
+		}
+	}
+
+	return "", false
+}
+
 var errIncorrectOwner = errors.New("incorrect object owner")
 
 func (v *FormatValidator) checkOwner(obj *object.Object) error {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
@@ -342,5 +343,49 @@ func TestFormatValidator_Validate(t *testing.T) {
 			err := v.checkAttributes(obj)
 			require.Equal(t, errEmptyAttrVal, err)
 		})
+
+		t.Run("overlay", func(t *testing.T) {
+			signer := user.NewAutoIDSigner(ownerKey.PrivateKey)
+			obj := blankValidObject(signer)
+
+			var a object.Attribute
+			a.SetKey(AttributeOverlayTarget)
+			a.SetValue(oidtest.ID().EncodeToString())
+
+			obj.SetAttributes(a)
+			require.NoError(t, v.checkAttributes(obj))
+
+			obj.SetType(object.TypeLock)
+			require.ErrorIs(t, v.checkAttributes(obj), errOverlayType)
+
+			a.SetValue("not an ID")
+			obj.SetType(object.TypeRegular)
+			obj.SetAttributes(a)
+			require.Error(t, v.checkAttributes(obj))
+		})
+
+		t.Run("node attributes", func(t *testing.T) {
+			signer := user.NewAutoIDSigner(ownerKey.PrivateKey)
+
+			for _, key := range []string{
+				ec.AttributeParent,
+				SharedAttribute(oidtest.ID()),
+				AttributePatchOrigin,
+			} {
+				obj := blankValidObject(signer)
+
+				var a object.Attribute
+				a.SetKey(key)
+				a.SetValue(oidtest.ID().EncodeToString())
+
+				obj.SetAttributes(a)
+				require.ErrorIs(t, v.Validate(obj, true), errNodeAttribute, key)
+
+				// signed objects are accepted from the container nodes only,
+				// the sender is checked by the access control
+				require.NoError(t, obj.SetIDWithSignature(signer))
+				require.NoError(t, v.Validate(obj, false), key)
+			}
+		})
 	})
 }
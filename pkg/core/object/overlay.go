@@ -0,0 +1,84 @@
+package object
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// AttributeOverlayTarget is an attribute turning the regular object into the
+// metadata overlay of the object with the specified ID from the same
+// container. User attributes of the overlay are indexed for the target object
+// and optionally merged into its header, so the objects are re-labeled without
+// copying. Overlays are removed along with their targets.
+const AttributeOverlayTarget = "__NEOFS__OVERLAY_TARGET"
+
+// systemAttributePrefix is a prefix of the system attributes never applied by
+// the overlays.
+const systemAttributePrefix = "__NEOFS__"
+
+var errOverlayType = errors.New("overlay must be a regular object")
+
+// OverlayTarget returns the ID of the object the overlay relates to. Returns
+// false if the object is not an overlay.
+func OverlayTarget(obj *object.Object) (oid.ID, bool, error) {
+	for _, a := range obj.Attributes() {
+		if a.Key() != AttributeOverlayTarget {
+			continue
+		}
+
+		var id oid.ID
+
+		if err := id.DecodeString(a.Value()); err != nil {
+			return id, false, fmt.Errorf("invalid %s attribute: %w", AttributeOverlayTarget, err)
+		}
+
+		return id, true, nil
+	}
+
+	return oid.ID{}, false, nil
+}
+
+// IsOverlayAttribute checks whether the attribute of the overlay applies to
+// its target. System attributes are not applied.
+func IsOverlayAttribute(key string) bool {
+	return !strings.HasPrefix(key, systemAttributePrefix)
+}
+
+// MergeOverlays applies the attributes of the overlays to the header of their
+// target: the attributes are added or replace the ones with the same key.
+// Overlays are applied in the creation order, so the latest overlay wins.
+func MergeOverlays(hdr *object.Object, overlays []*object.Object) {
+	sort.SliceStable(overlays, func(i, j int) bool {
+		return overlays[i].CreationEpoch() < overlays[j].CreationEpoch()
+	})
+
+	attrs := hdr.Attributes()
+	index := make(map[string]int, len(attrs))
+
+	for i := range attrs {
+		index[attrs[i].Key()] = i
+	}
+
+	for _, o := range overlays {
+		for _, a := range o.Attributes() {
+			if !IsOverlayAttribute(a.Key()) {
+				continue
+			}
+
+			if i, ok := index[a.Key()]; ok {
+				attrs[i] = a
+				continue
+			}
+
+			index[a.Key()] = len(attrs)
+			attrs = append(attrs, a)
+		}
+	}
+
+	hdr.SetAttributes(attrs...)
+}
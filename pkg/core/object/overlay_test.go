@@ -0,0 +1,33 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeOverlays(t *testing.T) {
+	newObject := func(epoch uint64, kvs ...string) *object.Object {
+		attrs := make([]object.Attribute, len(kvs)/2)
+		for i := range attrs {
+			attrs[i].SetKey(kvs[2*i])
+			attrs[i].SetValue(kvs[2*i+1])
+		}
+
+		obj := object.New()
+		obj.SetCreationEpoch(epoch)
+		obj.SetAttributes(attrs...)
+
+		return obj
+	}
+
+	hdr := newObject(1, "FileName", "a.txt", "Tier", "hot")
+
+	MergeOverlays(hdr, []*object.Object{
+		newObject(5, "Tier", "cold", AttributeOverlayTarget, "any"),
+		newObject(3, "Tier", "warm", "Owner", "team"),
+	})
+
+	require.Equal(t, newObject(1, "FileName", "a.txt", "Tier", "cold", "Owner", "team").Attributes(), hdr.Attributes())
+}
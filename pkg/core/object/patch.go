@@ -0,0 +1,6 @@
+package object
+
+// AttributePatchOrigin is an attribute of the objects formed by patching the
+// other ones with the ID of the patched object. Children shared with the
+// patched object are referenced by AttributeSharedPrefix attributes.
+const AttributePatchOrigin = "__NEOFS__PATCH_ORIGIN"
@@ -0,0 +1,92 @@
+package object
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// AttributeRevokedToken is an attribute turning the object into the
+// revocation of the session or bearer token with the specified revocation ID
+// (see SessionTokenRevocationID and BearerTokenRevocationID). Revocation is
+// valid only if the object owner is the token issuer and the object is
+// created by the owner (see VerifyRevocation). Requests with the
+// revoked tokens are rejected by the nodes of the container the revocation is
+// stored in.
+const AttributeRevokedToken = "__NEOFS__REVOKED_TOKEN"
+
+// SessionTokenRevocationID returns revocation ID of the session token which is
+// its ID in the canonical UUID form.
+func SessionTokenRevocationID(tok session.Object) string {
+	return tok.ID().String()
+}
+
+// BearerTokenRevocationID returns revocation ID of the bearer token which is
+// hex-encoded SHA-256 hash of its binary form since bearer tokens have no ID.
+func BearerTokenRevocationID(tok bearer.Token) string {
+	h := sha256.Sum256(tok.Marshal())
+	return hex.EncodeToString(h[:])
+}
+
+// VerifyRevocation checks that the revocation object is created by its owner:
+// the header is signed by the owner key or within the valid object session
+// issued by the owner. Revocations failing the check must be ignored, otherwise
+// anyone allowed to store objects in the container can revoke the tokens of
+// other users.
+func VerifyRevocation(obj *object.Object) error {
+	owner := obj.OwnerID()
+	if owner == nil {
+		return errors.New("missing owner")
+	}
+
+	sig := obj.Signature()
+	if sig == nil {
+		return errors.New("missing signature")
+	}
+
+	if err := obj.CheckHeaderVerificationFields(); err != nil {
+		return fmt.Errorf("invalid header: %w", err)
+	}
+
+	tok := obj.SessionToken()
+	if tok == nil {
+		pubKey, err := keys.NewPublicKeyFromBytes(sig.PublicKeyBytes(), elliptic.P256())
+		if err != nil {
+			return fmt.Errorf("decode signature key: %w", err)
+		}
+
+		if !owner.Equals(user.ResolveFromECDSAPublicKey(ecdsa.PublicKey(*pubKey))) {
+			return errors.New("object is not signed by the owner")
+		}
+
+		return nil
+	}
+
+	cnr, _ := obj.ContainerID()
+
+	switch {
+	case !tok.Issuer().Equals(*owner):
+		return errors.New("session is not issued by the owner")
+	case !tok.VerifySignature():
+		return errors.New("incorrect session token signature")
+	case !tok.AssertAuthKey(sig.PublicKey()):
+		return errors.New("session token is not for object's signer")
+	case !tok.AssertContainer(cnr):
+		return errors.New("session token is not for object's container")
+	case !tok.AssertVerb(session.VerbObjectPut):
+		return errors.New("session token does not allow object creation")
+	case tok.InvalidAt(obj.CreationEpoch()):
+		return errors.New("session token is invalid at object creation epoch")
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyRevocation(t *testing.T) {
+	ownerKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	owner := user.NewAutoIDSignerRFC6979(ownerKey.PrivateKey)
+	other := user.NewAutoIDSignerRFC6979(otherKey.PrivateKey)
+	cnr := cidtest.ID()
+
+	newRevocation := func(t *testing.T, ownerID user.ID, signer user.Signer, tok *session.Object) *object.Object {
+		var a object.Attribute
+		a.SetKey(AttributeRevokedToken)
+		a.SetValue("revoked")
+
+		obj := object.New()
+		obj.SetContainerID(cnr)
+		obj.SetOwnerID(&ownerID)
+		obj.SetCreationEpoch(10)
+		obj.SetAttributes(a)
+		obj.SetSessionToken(tok)
+
+		require.NoError(t, obj.SetIDWithSignature(signer))
+
+		return obj
+	}
+
+	newSession := func(t *testing.T, issuer user.Signer, cnr cid.ID, verb session.ObjectVerb) *session.Object {
+		var tok session.Object
+		tok.SetID(uuid.New())
+		tok.SetAuthKey((*neofsecdsa.PublicKey)(&otherKey.PrivateKey.PublicKey))
+		tok.BindContainer(cnr)
+		tok.ForVerb(verb)
+		tok.SetIat(1)
+		tok.SetNbf(1)
+		tok.SetExp(100)
+		require.NoError(t, tok.Sign(issuer))
+
+		return &tok
+	}
+
+	t.Run("signed by owner", func(t *testing.T) {
+		obj := newRevocation(t, owner.UserID(), owner, nil)
+		require.NoError(t, VerifyRevocation(obj))
+	})
+
+	t.Run("forged owner", func(t *testing.T) {
+		obj := newRevocation(t, owner.UserID(), other, nil)
+		require.Error(t, VerifyRevocation(obj))
+	})
+
+	t.Run("broken signature", func(t *testing.T) {
+		obj := newRevocation(t, owner.UserID(), owner, nil)
+		obj.SetCreationEpoch(11)
+		require.Error(t, VerifyRevocation(obj))
+	})
+
+	t.Run("session", func(t *testing.T) {
+		t.Run("issued by owner", func(t *testing.T) {
+			tok := newSession(t, owner, cnr, session.VerbObjectPut)
+			obj := newRevocation(t, owner.UserID(), other, tok)
+			require.NoError(t, VerifyRevocation(obj))
+		})
+
+		t.Run("issued by another user", func(t *testing.T) {
+			tok := newSession(t, other, cnr, session.VerbObjectPut)
+			obj := newRevocation(t, owner.UserID(), other, tok)
+			require.Error(t, VerifyRevocation(obj))
+		})
+
+		t.Run("another container", func(t *testing.T) {
+			tok := newSession(t, owner, cidtest.ID(), session.VerbObjectPut)
+			obj := newRevocation(t, owner.UserID(), other, tok)
+			require.Error(t, VerifyRevocation(obj))
+		})
+
+		t.Run("wrong verb", func(t *testing.T) {
+			tok := newSession(t, owner, cnr, session.VerbObjectDelete)
+			obj := newRevocation(t, owner.UserID(), other, tok)
+			require.Error(t, VerifyRevocation(obj))
+		})
+
+		t.Run("expired", func(t *testing.T) {
+			tok := newSession(t, owner, cnr, session.VerbObjectPut)
+			tok.SetExp(5)
+			require.NoError(t, tok.Sign(owner))
+			obj := newRevocation(t, owner.UserID(), other, tok)
+			require.Error(t, VerifyRevocation(obj))
+		})
+	})
+}
@@ -0,0 +1,72 @@
+package object
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// AttributeSharedPrefix is a prefix of the system attributes referencing the
+// objects the child objects of which are shared by the object. The suffix is
+// the ID of the referenced object, the value is the split ID of its chain, so
+// only the children of this chain are considered shared. Shared children are
+// stored once and are removed with the last object listing them.
+//
+// Objects sharing the children of the other ones keep the references of the
+// source objects, so any object listing the shared child can be found by the
+// attribute of the object the child was initially stored for.
+const AttributeSharedPrefix = "__NEOFS__SHARED_"
+
+// SharedAttribute returns the key of the attribute referencing the object
+// the children of which are shared.
+func SharedAttribute(id oid.ID) string {
+	return AttributeSharedPrefix + id.EncodeToString()
+}
+
+// SharedOrigins returns IDs of the objects the children of which are shared by
+// the object.
+func SharedOrigins(hdr *object.Object) ([]oid.ID, error) {
+	var res []oid.ID
+
+	for _, a := range hdr.Attributes() {
+		if !strings.HasPrefix(a.Key(), AttributeSharedPrefix) {
+			continue
+		}
+
+		var id oid.ID
+
+		err := id.DecodeString(strings.TrimPrefix(a.Key(), AttributeSharedPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s attribute: %w", a.Key(), err)
+		}
+
+		res = append(res, id)
+	}
+
+	return res, nil
+}
+
+// SharedChains returns split IDs of the chains of the objects the children of
+// which are shared by the object.
+func SharedChains(hdr *object.Object) ([]object.SplitID, error) {
+	var res []object.SplitID
+
+	for _, a := range hdr.Attributes() {
+		if !strings.HasPrefix(a.Key(), AttributeSharedPrefix) {
+			continue
+		}
+
+		var id object.SplitID
+
+		err := id.Parse(a.Value())
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s attribute value: %w", a.Key(), err)
+		}
+
+		res = append(res, id)
+	}
+
+	return res, nil
+}
@@ -47,6 +47,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/timer"
 	"github.com/nspcc-dev/neofs-node/pkg/network/cache"
+	"github.com/nspcc-dev/neofs-node/pkg/services/audit/resultstore"
 	audittask "github.com/nspcc-dev/neofs-node/pkg/services/audit/taskmanager"
 	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
 	controlsrv "github.com/nspcc-dev/neofs-node/pkg/services/control/ir/server"
@@ -54,7 +55,10 @@ import (
 	util2 "github.com/nspcc-dev/neofs-node/pkg/util"
 	utilConfig "github.com/nspcc-dev/neofs-node/pkg/util/config"
 	"github.com/nspcc-dev/neofs-node/pkg/util/precision"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	"github.com/nspcc-dev/neofs-node/pkg/util/state"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/panjf2000/ants/v2"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -90,6 +94,7 @@ type (
 		precision     uint32 // not changeable
 		healthStatus  atomic.Value
 		persistate    *state.PersistentStorage
+		auditResults  *resultstore.Store
 
 		// metrics
 		metrics *metrics.InnerRingServiceMetrics
@@ -99,7 +104,8 @@ type (
 		mainNotaryConfig *notaryConfig
 
 		// internal variables
-		key                   *keys.PrivateKey
+		key                   *keys.PrivateKey // nil if the signing agent holds the key
+		signer                signer.Signer
 		pubKey                []byte
 		contracts             *contracts
 		predefinedValidators  keys.PublicKeys
@@ -135,11 +141,13 @@ type (
 	}
 
 	chainParams struct {
-		log  *zap.Logger
-		cfg  *viper.Viper
-		key  *keys.PrivateKey
-		name string
-		from uint32 // block height
+		log *zap.Logger
+		cfg *viper.Viper
+		key *keys.PrivateKey
+		// signer of the transactions, nil if the key is used
+		signer signer.Signer
+		name   string
+		from   uint32 // block height
 
 		withAutoSidechainScope bool
 	}
@@ -335,6 +343,14 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 	}
 	server.registerCloser(server.persistate.Close)
 
+	server.auditResults, err = initAuditResultStorage(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if server.auditResults != nil {
+		server.registerCloser(server.auditResults.Close)
+	}
+
 	fromSideChainBlock, err := server.persistate.UInt32(persistateSideChainLastBlockKey)
 	if err != nil {
 		fromSideChainBlock = 0
@@ -348,12 +364,24 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		from: fromSideChainBlock,
 	}
 
+	isAutoDeploy, err := isAutoDeploymentMode(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	isLocalConsensus, err := isLocalConsensusMode(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid consensus configuration: %w", err)
+	}
+
+	// the wallet is optional if the signing agent holds the key, but the
+	// local consensus and the auto-deployment require its accounts
 	const walletPathKey = "wallet.path"
-	if !cfg.IsSet(walletPathKey) {
+	walletPath := cfg.GetString(walletPathKey)
+	if walletPath == "" && (cfg.GetString("signer.agent") == "" || isLocalConsensus || isAutoDeploy) {
 		return nil, fmt.Errorf("file path to the node Neo wallet is not configured '%s'", walletPathKey)
 	}
 
-	walletPath := cfg.GetString(walletPathKey)
 	walletPass := cfg.GetString("wallet.password")
 
 	// parse default validators
@@ -362,42 +390,34 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		return nil, fmt.Errorf("can't parse predefined validators list: %w", err)
 	}
 
-	wlt, err := wallet.NewWalletFromFile(walletPath)
-	if err != nil {
-		return nil, fmt.Errorf("read wallet from file '%s': %w", walletPath, err)
-	}
-
 	const singleAccLabel = "single"
 	const consensusAccLabel = "consensus"
 	var singleAcc *wallet.Account
 	var consensusAcc *wallet.Account
 
-	for i := range wlt.Accounts {
-		err = wlt.Accounts[i].Decrypt(walletPass, keys.NEP2ScryptParams())
-		switch wlt.Accounts[i].Label {
-		case singleAccLabel:
-			if err != nil {
-				return nil, fmt.Errorf("failed to decrypt account with label '%s' in wallet '%s': %w", singleAccLabel, walletPass, err)
-			}
-
-			singleAcc = wlt.Accounts[i]
-		case consensusAccLabel:
-			if err != nil {
-				return nil, fmt.Errorf("failed to decrypt account with label '%s' in wallet '%s': %w", consensusAccLabel, walletPass, err)
-			}
-
-			consensusAcc = wlt.Accounts[i]
+	if walletPath != "" {
+		wlt, err := wallet.NewWalletFromFile(walletPath)
+		if err != nil {
+			return nil, fmt.Errorf("read wallet from file '%s': %w", walletPath, err)
 		}
-	}
 
-	isAutoDeploy, err := isAutoDeploymentMode(cfg)
-	if err != nil {
-		return nil, err
-	}
+		for i := range wlt.Accounts {
+			err = wlt.Accounts[i].Decrypt(walletPass, keys.NEP2ScryptParams())
+			switch wlt.Accounts[i].Label {
+			case singleAccLabel:
+				if err != nil {
+					return nil, fmt.Errorf("failed to decrypt account with label '%s' in wallet '%s': %w", singleAccLabel, walletPass, err)
+				}
 
-	isLocalConsensus, err := isLocalConsensusMode(cfg)
-	if err != nil {
-		return nil, fmt.Errorf("invalid consensus configuration: %w", err)
+				singleAcc = wlt.Accounts[i]
+			case consensusAccLabel:
+				if err != nil {
+					return nil, fmt.Errorf("failed to decrypt account with label '%s' in wallet '%s': %w", consensusAccLabel, walletPass, err)
+				}
+
+				consensusAcc = wlt.Accounts[i]
+			}
+		}
 	}
 
 	if isLocalConsensus {
@@ -406,7 +426,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		}
 
 		server.key = singleAcc.PrivateKey()
-	} else {
+	} else if walletPath != "" {
 		acc, err := utilConfig.LoadAccount(walletPath, cfg.GetString("wallet.address"), walletPass)
 		if err != nil {
 			return nil, fmt.Errorf("ir: %w", err)
@@ -415,6 +435,16 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		server.key = acc.PrivateKey()
 	}
 
+	server.signer, err = server.initSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.GetString("signer.agent") != "" {
+		// transactions and notary requests are signed by the agent
+		morphChain.signer = server.signer
+	}
+
 	err = serveControl(server, log, cfg, errChan)
 	if err != nil {
 		return nil, err
@@ -473,7 +503,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		}
 
 		morphChain.key = server.key
-		sidechainOpts := make([]client.Option, 3, 4)
+		sidechainOpts := make([]client.Option, 3, 5)
 		sidechainOpts[0] = client.WithContext(ctx)
 		sidechainOpts[1] = client.WithLogger(log)
 		sidechainOpts[2] = client.WithSingleClient(localWSClient)
@@ -482,6 +512,10 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 			sidechainOpts = append(sidechainOpts, client.WithAutoSidechainScope())
 		}
 
+		if morphChain.signer != nil {
+			sidechainOpts = append(sidechainOpts, client.WithSigner(morphChain.signer))
+		}
+
 		server.morphClient, err = client.New(server.key, sidechainOpts...)
 		if err != nil {
 			return nil, fmt.Errorf("init internal morph client: %w", err)
@@ -521,15 +555,20 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 				return nil, fmt.Errorf("configuration of FS chain RPC endpoints '%s' is missing or empty", cfgPathFSChainRPCEndpoints)
 			}
 
-			clnt, err = client.New(server.key,
+			deployOpts := []client.Option{
 				client.WithContext(ctx),
 				client.WithLogger(log),
-				client.WithDialTimeout(cfg.GetDuration(morphChain.name+".dial_timeout")),
+				client.WithDialTimeout(cfg.GetDuration(morphChain.name + ".dial_timeout")),
 				client.WithEndpoints(endpoints),
-				client.WithReconnectionRetries(cfg.GetInt(morphChain.name+".reconnections_number")),
-				client.WithReconnectionsDelay(cfg.GetDuration(morphChain.name+".reconnections_delay")),
+				client.WithReconnectionRetries(cfg.GetInt(morphChain.name + ".reconnections_number")),
+				client.WithReconnectionsDelay(cfg.GetDuration(morphChain.name + ".reconnections_delay")),
 				client.WithMinRequiredBlockHeight(morphChain.from),
-			)
+			}
+			if morphChain.signer != nil {
+				deployOpts = append(deployOpts, client.WithSigner(morphChain.signer))
+			}
+
+			clnt, err = client.New(server.key, deployOpts...)
 			if err != nil {
 				return nil, fmt.Errorf("create multi-endpoint client for Sidechain deployment: %w", err)
 			}
@@ -641,7 +680,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		return nil, fmt.Errorf("could not enable side chain notary support: %w", err)
 	}
 
-	server.morphListener.EnableNotarySupport(server.contracts.proxy, server.key.PublicKey().GetScriptHash(),
+	server.morphListener.EnableNotarySupport(server.contracts.proxy, server.signer.PublicKey().GetScriptHash(),
 		server.morphClient.Committee, server.morphClient)
 
 	if !server.mainNotaryConfig.disabled {
@@ -655,7 +694,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		}
 	}
 
-	server.pubKey = server.key.PublicKey().Bytes()
+	server.pubKey = server.signer.PublicKey().Bytes()
 
 	auditPool, err := ants.NewPool(cfg.GetInt("audit.task.exec_pool_size"))
 	if err != nil {
@@ -718,7 +757,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 	server.statusIndex = newInnerRingIndexer(
 		server.morphClient,
 		irf,
-		server.key.PublicKey(),
+		server.signer.PublicKey(),
 		cfg.GetDuration("indexer.cache_timeout"),
 	)
 
@@ -730,7 +769,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 
 	clientCache := newClientCache(&clientCacheParams{
 		Log:           log,
-		Key:           &server.key.PrivateKey,
+		Signer:        server.signer,
 		SGTimeout:     cfg.GetDuration("audit.timeout.get"),
 		HeadTimeout:   cfg.GetDuration("audit.timeout.head"),
 		RangeTimeout:  cfg.GetDuration("audit.timeout.rangehash"),
@@ -768,7 +807,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		IRList:           server,
 		EpochSource:      server,
 		SGSource:         clientCache,
-		Key:              &server.key.PrivateKey,
+		Signer:           server.signer,
 		RPCSearchTimeout: cfg.GetDuration("audit.timeout.search"),
 		TaskManager:      server.auditTaskManager,
 		Reporter:         server,
@@ -868,6 +907,11 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 
 	nnsService := newNeoFSNNS(nnsContractAddr, invoker.New(server.morphClient, nil))
 
+	nodeProber, err := initNodeProber(cfg, server.signer)
+	if err != nil {
+		return nil, err
+	}
+
 	// create netmap processor
 	server.netmapProcessor, err = netmap.New(&netmap.Params{
 		Log:              log,
@@ -896,7 +940,14 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 			privatedomains.New(nnsService),
 			locodeValidator,
 		),
-		NodeStateSettings: netSettings,
+		NodeStateSettings:         netSettings,
+		NodeProber:                nodeProber,
+		ProbeInterval:             cfg.GetDuration("netmap_cleaner.probe.interval"),
+		ProbePoolSize:             cfg.GetInt("netmap_cleaner.probe.pool_size"),
+		ProbeWindow:               cfg.GetInt("netmap_cleaner.probe.window"),
+		ProbeMaxLatency:           cfg.GetDuration("netmap_cleaner.probe.max_latency"),
+		ProbeMaintenanceThreshold: cfg.GetFloat64("netmap_cleaner.probe.maintenance_threshold"),
+		ProbeOfflineThreshold:     cfg.GetFloat64("netmap_cleaner.probe.offline_threshold"),
 	})
 	if err != nil {
 		return nil, err
@@ -907,6 +958,8 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		return nil, err
 	}
 
+	server.workers = append(server.workers, server.netmapProcessor.RunNodeProbes)
+
 	// container processor
 	containerProcessor, err := container.New(&container.Params{
 		Log:             log,
@@ -1100,6 +1153,9 @@ func (s *Server) createClient(ctx context.Context, p chainParams, errChan chan<-
 	if p.withAutoSidechainScope {
 		options = append(options, client.WithAutoSidechainScope())
 	}
+	if p.signer != nil {
+		options = append(options, client.WithSigner(p.signer))
+	}
 
 	return client.New(p.key, options...)
 }
@@ -1261,12 +1317,18 @@ func serveControl(server *Server, log *zap.Logger, cfg *viper.Viper, errChan cha
 		}
 		var p controlsrv.Prm
 
-		p.SetPrivateKey(*server.key)
+		p.SetSigner(server.signer)
 		p.SetHealthChecker(server)
 
-		controlSvc := controlsrv.New(p,
+		opts := []controlsrv.Option{
 			controlsrv.WithAllowedKeys(authKeys),
-		)
+		}
+
+		if server.auditResults != nil {
+			opts = append(opts, controlsrv.WithAuditResults(server.auditResults))
+		}
+
+		controlSvc := controlsrv.New(p, opts...)
 
 		grpcControlSrv := grpc.NewServer()
 		control.RegisterControlServiceServer(grpcControlSrv, controlSvc)
@@ -1289,3 +1351,50 @@ func serveMetrics(server *Server, cfg *viper.Viper) {
 		server.metrics = &m
 	}
 }
+
+// initSigner returns the signer of the Inner Ring messages, requests and
+// transactions: the external signing agent if configured, the wallet key
+// otherwise. The wallet key is optional with the agent, if it is set anyway,
+// the agent must hold it.
+func (s *Server) initSigner(cfg *viper.Viper) (signer.Signer, error) {
+	addr := cfg.GetString("signer.agent")
+	if addr == "" {
+		return signer.FromPrivateKey(s.key), nil
+	}
+
+	agent, err := signer.NewAgent(addr, cfg.GetDuration("signer.timeout"))
+	if err != nil {
+		return nil, fmt.Errorf("connect to signing agent: %w", err)
+	}
+
+	s.registerCloser(agent.Close)
+
+	if s.key != nil && !agent.PublicKey().Equal(s.key.PublicKey()) {
+		return nil, errors.New("public key of the signing agent does not match the wallet one")
+	}
+
+	return agent, nil
+}
+
+func initNodeProber(cfg *viper.Viper, s signer.Signer) (netmap.NodeProber, error) {
+	if !cfg.GetBool("netmap_cleaner.probe.enabled") {
+		return nil, nil
+	}
+
+	objStrs := cfg.GetStringSlice("netmap_cleaner.probe.objects")
+	objects := make([]oid.Address, len(objStrs))
+
+	for i := range objStrs {
+		err := objects[i].DecodeString(objStrs[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid probe object address %s: %w", objStrs[i], err)
+		}
+	}
+
+	timeout := cfg.GetDuration("netmap_cleaner.probe.timeout")
+	if timeout <= 0 {
+		return nil, fmt.Errorf("invalid probe timeout %v", timeout)
+	}
+
+	return availabilityvalidator.NewProber(signer.User(s, neofscrypto.ECDSA_SHA512), timeout, objects), nil
+}
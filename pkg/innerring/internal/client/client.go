@@ -2,15 +2,16 @@ package neofsapiclient
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"io"
 
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/storagegroup"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
@@ -28,9 +29,9 @@ func (x *Client) WrapBasicClient(c clientcore.Client) {
 	x.c = c
 }
 
-// SetPrivateKey sets a private key to sign RPC requests.
-func (x *Client) SetPrivateKey(key *ecdsa.PrivateKey) {
-	x.signer = user.NewAutoIDSigner(*key)
+// SetSigner sets a signer of RPC requests.
+func (x *Client) SetSigner(s signer.Signer) {
+	x.signer = signer.User(s, neofscrypto.ECDSA_SHA512)
 }
 
 // SearchSGPrm groups parameters of SearchSG operation.
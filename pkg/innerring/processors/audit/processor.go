@@ -2,7 +2,6 @@ package audit
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"time"
@@ -12,6 +11,7 @@ import (
 	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
 	"github.com/nspcc-dev/neofs-node/pkg/services/audit"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
 )
@@ -65,7 +65,7 @@ type (
 		RPCSearchTimeout time.Duration
 		TaskManager      TaskManager
 		Reporter         audit.Reporter
-		Key              *ecdsa.PrivateKey
+		Signer           signer.Signer
 		EpochSource      EpochSource
 	}
 )
@@ -94,8 +94,8 @@ func New(p *Params) (*Processor, error) {
 		return nil, errors.New("ir/audit: audit task manager is not set")
 	case p.Reporter == nil:
 		return nil, errors.New("ir/audit: audit result reporter is not set")
-	case p.Key == nil:
-		return nil, errors.New("ir/audit: signing key is not set")
+	case p.Signer == nil:
+		return nil, errors.New("ir/audit: signer is not set")
 	case p.EpochSource == nil:
 		return nil, errors.New("ir/audit: epoch source is not set")
 	}
@@ -99,3 +99,21 @@ func (np *Processor) handleCleanupTick(ev event.Event) {
 			zap.Int("capacity", np.pool.Cap()))
 	}
 }
+
+func (np *Processor) handleNodeHealthTick(epoch uint64) {
+	if np.nodeProber == nil {
+		return
+	}
+
+	np.log.Info("tick", zap.String("type", "node health"))
+
+	// send event to the worker pool
+	err := np.pool.Submit(func() {
+		np.processNodeHealthTick(epoch)
+	})
+	if err != nil {
+		// there system can be moved into controlled degradation stage
+		np.log.Warn("netmap worker pool drained",
+			zap.Int("capacity", np.pool.Cap()))
+	}
+}
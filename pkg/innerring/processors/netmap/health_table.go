@@ -0,0 +1,166 @@
+package netmap
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+)
+
+type (
+	// healthTable accumulates results of the active probes of the netmap
+	// nodes. Health score of the node is a share of the passed probes among
+	// the last ones.
+	healthTable struct {
+		*sync.RWMutex
+		window     int
+		maxLatency time.Duration
+		nodes      map[string]*nodeHealth
+	}
+
+	nodeHealth struct {
+		info netmap.NodeInfo
+
+		// ring buffer of the last probe results
+		results []bool
+		next    int
+		filled  bool
+
+		lastLatency time.Duration
+		lastErr     error
+
+		// node was voted to the maintenance state by the Inner Ring
+		votedMaintenance bool
+	}
+)
+
+func newHealthTable(window int, maxLatency time.Duration) healthTable {
+	return healthTable{
+		RWMutex:    new(sync.RWMutex),
+		window:     window,
+		maxLatency: maxLatency,
+		nodes:      make(map[string]*nodeHealth),
+	}
+}
+
+// Update health table based on on-chain information about netmap. Nodes
+// that left the network map or switched to maintenance state by themselves
+// are forgotten together with their probe results. Nodes voted to the
+// maintenance state by the Inner Ring are kept and probed further, so they
+// can be switched offline if they do not recover. Probe results of the nodes
+// returned from the maintenance are reset.
+func (h *healthTable) update(snapshot netmap.NetMap) {
+	h.Lock()
+	defer h.Unlock()
+
+	nmNodes := snapshot.Nodes()
+
+	newMap := make(map[string]*nodeHealth, len(nmNodes))
+
+	for i := range nmNodes {
+		keyString := netmap.StringifyPublicKey(nmNodes[i])
+
+		health, ok := h.nodes[keyString]
+
+		if nmNodes[i].IsMaintenance() {
+			if !ok || !health.votedMaintenance {
+				continue
+			}
+		} else if ok {
+			if health.info.IsMaintenance() {
+				health.reset()
+			}
+
+			// vote did not apply or the node has returned
+			health.votedMaintenance = false
+		}
+
+		if !ok {
+			health = &nodeHealth{results: make([]bool, h.window)}
+		}
+
+		health.info = nmNodes[i]
+
+		newMap[keyString] = health
+	}
+
+	h.nodes = newMap
+}
+
+// marks the node by string public key as voted to the maintenance state.
+func (h *healthTable) markVotedMaintenance(keyString string) {
+	h.Lock()
+	defer h.Unlock()
+
+	if health, ok := h.nodes[keyString]; ok {
+		health.votedMaintenance = true
+	}
+}
+
+// returns nodes to be probed.
+func (h *healthTable) probeCandidates() []netmap.NodeInfo {
+	h.RLock()
+	defer h.RUnlock()
+
+	res := make([]netmap.NodeInfo, 0, len(h.nodes))
+
+	for _, health := range h.nodes {
+		res = append(res, health.info)
+	}
+
+	return res
+}
+
+// records the probe result of the node by string public key. Probes took
+// more than configured maximum latency are considered failed.
+func (h *healthTable) record(keyString string, latency time.Duration, err error) {
+	h.Lock()
+	defer h.Unlock()
+
+	health, ok := h.nodes[keyString]
+	if !ok {
+		return // node left the netmap during the probe
+	}
+
+	health.results[health.next] = err == nil && (h.maxLatency <= 0 || latency <= h.maxLatency)
+	health.next = (health.next + 1) % len(health.results)
+	health.filled = health.filled || health.next == 0
+
+	health.lastLatency = latency
+	health.lastErr = err
+}
+
+// calls f for each node with the full window of the probe results passing
+// its public key, health score in [0, 1] range and the last probe result.
+func (h *healthTable) forEachScored(f func(keyString string, score float64, last *nodeHealth)) {
+	h.RLock()
+	defer h.RUnlock()
+
+	for keyString, health := range h.nodes {
+		if !health.filled {
+			continue
+		}
+
+		var passed int
+
+		for i := range health.results {
+			if health.results[i] {
+				passed++
+			}
+		}
+
+		f(keyString, float64(passed)/float64(len(health.results)), health)
+	}
+}
+
+// forgets the probe results.
+func (x *nodeHealth) reset() {
+	for i := range x.results {
+		x.results[i] = false
+	}
+
+	x.next = 0
+	x.filled = false
+	x.lastLatency = 0
+	x.lastErr = nil
+}
@@ -0,0 +1,124 @@
+package netmap
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthTable(t *testing.T) {
+	infos := []netmap.NodeInfo{
+		newNodeInfo(genKey(t).PublicKey()),
+		newNodeInfo(genKey(t).PublicKey()),
+		newNodeInfo(genKey(t).PublicKey()),
+	}
+
+	infos[2].SetMaintenance()
+
+	var networkMap netmap.NetMap
+	networkMap.SetNodes(infos)
+
+	key0 := netmap.StringifyPublicKey(infos[0])
+	key1 := netmap.StringifyPublicKey(infos[1])
+
+	scores := func(h *healthTable) map[string]float64 {
+		res := make(map[string]float64)
+		h.forEachScored(func(keyString string, score float64, _ *nodeHealth) {
+			res[keyString] = score
+		})
+		return res
+	}
+
+	t.Run("update", func(t *testing.T) {
+		h := newHealthTable(2, 0)
+		h.update(networkMap)
+
+		// nodes in maintenance are not probed
+		require.Len(t, h.probeCandidates(), 2)
+		require.Contains(t, h.nodes, key0)
+		require.Contains(t, h.nodes, key1)
+	})
+
+	t.Run("voted maintenance", func(t *testing.T) {
+		h := newHealthTable(2, 0)
+		h.update(networkMap)
+
+		h.record(key0, time.Millisecond, errors.New("any error"))
+		h.record(key0, time.Millisecond, errors.New("any error"))
+		h.record(key1, time.Millisecond, errors.New("any error"))
+		h.record(key1, time.Millisecond, errors.New("any error"))
+
+		h.markVotedMaintenance(key0)
+		h.markVotedMaintenance(key1)
+
+		// only node 0 is switched to maintenance
+		inMaintenance := append([]netmap.NodeInfo{}, infos...)
+		inMaintenance[0].SetMaintenance()
+
+		var nm netmap.NetMap
+		nm.SetNodes(inMaintenance)
+
+		h.update(nm)
+
+		// node voted to maintenance is still probed, its results are kept
+		require.Len(t, h.probeCandidates(), 2)
+		require.Equal(t, map[string]float64{key0: 0, key1: 0}, scores(&h))
+		require.True(t, h.nodes[key0].votedMaintenance)
+		require.False(t, h.nodes[key1].votedMaintenance)
+
+		// node 0 has returned online
+		h.update(networkMap)
+
+		require.Len(t, h.probeCandidates(), 2)
+		require.Equal(t, map[string]float64{key1: 0}, scores(&h))
+		require.False(t, h.nodes[key0].votedMaintenance)
+
+		// node 1 switched to maintenance by itself is forgotten
+		inMaintenance = append([]netmap.NodeInfo{}, infos...)
+		inMaintenance[1].SetMaintenance()
+		nm.SetNodes(inMaintenance)
+
+		h.update(nm)
+		require.NotContains(t, h.nodes, key1)
+	})
+
+	t.Run("score", func(t *testing.T) {
+		h := newHealthTable(4, time.Second)
+		h.update(networkMap)
+
+		h.record(key0, time.Millisecond, nil)
+		h.record(key0, time.Millisecond, errors.New("any error"))
+		h.record(key0, time.Minute, nil) // too slow
+		h.record(key1, time.Millisecond, nil)
+
+		// window is not full yet
+		require.Empty(t, scores(&h))
+
+		h.record(key0, time.Millisecond, nil)
+		require.Equal(t, map[string]float64{key0: 0.5}, scores(&h))
+
+		// results are shifted in the window
+		h.record(key0, time.Millisecond, nil)
+		h.record(key0, time.Millisecond, nil)
+		require.Equal(t, map[string]float64{key0: 0.75}, scores(&h))
+
+		t.Run("keep on update", func(t *testing.T) {
+			h.update(networkMap)
+			require.Equal(t, map[string]float64{key0: 0.75}, scores(&h))
+		})
+
+		t.Run("forget on leaving", func(t *testing.T) {
+			var nm netmap.NetMap
+			nm.SetNodes(infos[1:])
+
+			h.update(nm)
+			require.Empty(t, scores(&h))
+
+			h.record(key0, time.Millisecond, nil)
+			require.NotContains(t, h.nodes, key0)
+		})
+	})
+}
@@ -0,0 +1,132 @@
+package availability
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// Prober is a utility that actively checks whether the storage node from
+// the network map is still reachable and serves object requests.
+//
+// For correct operation, the Prober must be created
+// using the constructor (NewProber).
+type Prober struct {
+	signer  user.Signer
+	timeout time.Duration
+	objects []oid.Address
+}
+
+// NewProber creates a new instance of the Prober. Object requests are signed
+// by the given signer, the objects are requested from each probed node. Each
+// probe request is limited by the given timeout.
+//
+// Panics if signer is nil or timeout is non-positive.
+func NewProber(signer user.Signer, timeout time.Duration, objects []oid.Address) *Prober {
+	switch {
+	case signer == nil:
+		panic("nil signer")
+	case timeout <= 0:
+		panic(fmt.Sprintf("non-positive probe timeout %v", timeout))
+	}
+
+	return &Prober{
+		signer:  signer,
+		timeout: timeout,
+		objects: objects,
+	}
+}
+
+// Probe pings the node through the first available announced address with
+// `EndpointInfo` checking the node responds with its own public key, and then
+// requests local headers of the configured objects. Object request counts
+// successful if the node responds with the object header or with the
+// object-related status (not found, removed or access denied) since it
+// proves that the node serves object requests.
+//
+// Returns the time the probe took and the error if the node failed the probe.
+func (p *Prober) Probe(ctx context.Context, node netmap.NodeInfo) (time.Duration, error) {
+	start := time.Now()
+
+	var err error
+	var c *client.Client
+
+	node.IterateNetworkEndpoints(func(s string) bool {
+		c, err = p.dial(ctx, s, node.PublicKey())
+		if err != nil {
+			err = fmt.Errorf("'%s': %w", s, err)
+			return false
+		}
+
+		return true
+	})
+	if err != nil {
+		return time.Since(start), err
+	} else if c == nil {
+		return 0, errors.New("no network endpoints announced")
+	}
+
+	defer func() {
+		_ = c.Close()
+	}()
+
+	for i := range p.objects {
+		err = p.headObject(ctx, c, p.objects[i])
+		if err != nil {
+			return time.Since(start), fmt.Errorf("head object %s: %w", p.objects[i], err)
+		}
+	}
+
+	return time.Since(start), nil
+}
+
+// dial connects to the node listening on the given address and requests its
+// info checking the node has the given public key.
+func (p *Prober) dial(ctx context.Context, addr string, key []byte) (*client.Client, error) {
+	c, err := createSDKClient(addr, p.timeout)
+	if err != nil {
+		return nil, fmt.Errorf("client creation: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	res, err := c.EndpointInfo(ctx, client.PrmEndpointInfo{})
+	if err != nil {
+		_ = c.Close()
+		return nil, fmt.Errorf("could not ping node with `EndpointInfo`: %w", err)
+	}
+
+	if !bytes.Equal(res.NodeInfo().PublicKey(), key) {
+		_ = c.Close()
+		return nil, errors.New("`EndpointInfo` responded with another public key")
+	}
+
+	return c, nil
+}
+
+func (p *Prober) headObject(ctx context.Context, c *client.Client, addr oid.Address) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	var prm client.PrmObjectHead
+	prm.MarkLocal()
+
+	_, err := c.ObjectHead(ctx, addr.Container(), addr.Object(), p.signer, prm)
+	if err == nil ||
+		errors.Is(err, apistatus.ErrObjectNotFound) ||
+		errors.Is(err, apistatus.ErrObjectAlreadyRemoved) ||
+		errors.Is(err, apistatus.ErrObjectAccessDenied) {
+		return nil
+	}
+
+	return err
+}
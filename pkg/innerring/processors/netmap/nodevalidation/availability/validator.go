@@ -27,7 +27,7 @@ func (v Validator) Verify(nodeInfo netmap.NodeInfo) error {
 		var res *client.ResEndpointInfo
 		var c *client.Client
 
-		c, err = createSDKClient(s)
+		c, err = createSDKClient(s, pingTimeout)
 		if err != nil {
 			err = fmt.Errorf("'%s': client creation: %w", s, err)
 			return true
@@ -130,7 +130,7 @@ func compareNodeInfos(niExp, niGot netmap.NodeInfo) error {
 
 const pingTimeout = 15 * time.Second
 
-func createSDKClient(e string) (*client.Client, error) {
+func createSDKClient(e string, timeout time.Duration) (*client.Client, error) {
 	var a network.Address
 	err := a.FromString(e)
 	if err != nil {
@@ -140,8 +140,8 @@ func createSDKClient(e string) (*client.Client, error) {
 	var prmInit client.PrmInit
 	var prmDial client.PrmDial
 
-	prmDial.SetTimeout(pingTimeout)
-	prmDial.SetStreamTimeout(pingTimeout)
+	prmDial.SetTimeout(timeout)
+	prmDial.SetStreamTimeout(timeout)
 	prmDial.SetServerURI(a.URIAddr())
 
 	c, err := client.New(prmInit)
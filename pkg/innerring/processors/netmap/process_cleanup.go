@@ -24,22 +24,7 @@ func (np *Processor) processNetmapCleanupTick(ev netmapCleanupTick) {
 
 		np.log.Info("vote to remove node from netmap", zap.String("key", s))
 
-		// In notary environments we call UpdateStateIR method instead of UpdateState.
-		// It differs from UpdateState only by name, so we can do this in the same form.
-		// See https://github.com/nspcc-dev/neofs-contract/issues/225
-		const methodUpdateStateNotary = "updateStateIR"
-
-		err = np.netmapClient.Morph().NotaryInvoke(
-			np.netmapClient.ContractAddress(),
-			0,
-			uint32(ev.epoch),
-			nil,
-			methodUpdateStateNotary,
-			int64(v2netmap.Offline), key.Bytes(),
-		)
-		if err != nil {
-			np.log.Error("can't invoke netmap.UpdateState", zap.Error(err))
-		}
+		np.voteNodeState(ev.epoch, v2netmap.Offline, key)
 
 		return nil
 	})
@@ -48,3 +33,25 @@ func (np *Processor) processNetmapCleanupTick(ev netmapCleanupTick) {
 			zap.String("error", err.Error()))
 	}
 }
+
+// voteNodeState sends notary request to switch the node to the given state.
+// Epoch is used as the nonce, so requests of the Alphabet nodes made in the
+// same epoch are merged.
+func (np *Processor) voteNodeState(epoch uint64, st v2netmap.NodeState, key *keys.PublicKey) {
+	// In notary environments we call UpdateStateIR method instead of UpdateState.
+	// It differs from UpdateState only by name, so we can do this in the same form.
+	// See https://github.com/nspcc-dev/neofs-contract/issues/225
+	const methodUpdateStateNotary = "updateStateIR"
+
+	err := np.netmapClient.Morph().NotaryInvoke(
+		np.netmapClient.ContractAddress(),
+		0,
+		uint32(epoch),
+		nil,
+		methodUpdateStateNotary,
+		int64(st), key.Bytes(),
+	)
+	if err != nil {
+		np.log.Error("can't invoke netmap.UpdateState", zap.Error(err))
+	}
+}
@@ -66,6 +66,8 @@ func (np *Processor) processNewEpoch(ev netmapEvent.NewEpoch) {
 
 	np.netmapSnapshot.update(*networkMap, epoch)
 	np.handleCleanupTick(netmapCleanupTick{epoch: epoch, txHash: ev.TxHash()})
+	np.nodeHealth.update(*networkMap)
+	np.handleNodeHealthTick(epoch)
 	np.handleNewAudit(audit.NewAuditStartEvent(epoch))
 	np.handleAuditSettlements(settlement.NewAuditEvent(epoch))
 	np.handleAlphabetSync(governance.NewSyncEvent(ev.TxHash()))
@@ -0,0 +1,151 @@
+package netmap
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	v2netmap "github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"go.uber.org/zap"
+)
+
+// RunNodeProbes actively probes the network map nodes with the configured
+// interval until the context is done. Results are accumulated in the node
+// health scores which are used to switch persistently failing nodes to the
+// maintenance or offline state on the next epoch. Does nothing if probing is
+// disabled.
+func (np *Processor) RunNodeProbes(ctx context.Context) {
+	if np.nodeProber == nil {
+		return
+	}
+
+	ticker := time.NewTicker(np.probeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !np.alphabetState.IsAlphabet() {
+				np.log.Debug("non alphabet mode, ignore node probe tick")
+				continue
+			}
+
+			np.probeNodes(ctx)
+		}
+	}
+}
+
+func (np *Processor) probeNodes(ctx context.Context) {
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, np.probePoolSize)
+	)
+
+	for _, node := range np.nodeHealth.probeCandidates() {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+
+		go func(node netmap.NodeInfo) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			keyString := netmap.StringifyPublicKey(node)
+
+			latency, err := np.nodeProber.Probe(ctx, node)
+			if err != nil {
+				np.log.Debug("netmap node failed probe",
+					zap.String("key", keyString),
+					zap.Duration("latency", latency),
+					zap.String("error", err.Error()))
+			}
+
+			np.nodeHealth.record(keyString, latency, err)
+		}(node)
+	}
+
+	wg.Wait()
+}
+
+func (np *Processor) processNodeHealthTick(epoch uint64) {
+	if !np.alphabetState.IsAlphabet() {
+		np.log.Info("non alphabet mode, ignore node health tick")
+
+		return
+	}
+
+	type vote struct {
+		keyString string
+		state     v2netmap.NodeState
+	}
+
+	maintenanceAllowed := np.nodeStateSettings.MaintenanceModeAllowed() == nil
+
+	var votes []vote
+
+	np.nodeHealth.forEachScored(func(keyString string, score float64, last *nodeHealth) {
+		var st v2netmap.NodeState
+
+		switch {
+		case score < np.probeOfflineThreshold:
+			st = v2netmap.Offline
+		case last.info.IsMaintenance():
+			// already voted to maintenance, only switch offline if the node
+			// did not recover
+			return
+		case score < np.probeMaintenanceThreshold:
+			if !maintenanceAllowed {
+				np.log.Info("maintenance mode is disallowed, keep unhealthy node",
+					zap.String("key", keyString),
+					zap.Float64("score", score))
+
+				return
+			}
+
+			st = v2netmap.Maintenance
+		default:
+			return
+		}
+
+		fields := []zap.Field{
+			zap.String("key", keyString),
+			zap.Float64("score", score),
+			zap.Stringer("state", st),
+			zap.Duration("last_latency", last.lastLatency),
+		}
+		if last.lastErr != nil {
+			fields = append(fields, zap.String("last_error", last.lastErr.Error()))
+		}
+
+		np.log.Info("vote to switch unhealthy node state", fields...)
+
+		votes = append(votes, vote{keyString: keyString, state: st})
+	})
+
+	for i := range votes {
+		key, err := keys.NewPublicKeyFromString(votes[i].keyString)
+		if err != nil {
+			np.log.Warn("can't decode public key of netmap node",
+				zap.String("key", votes[i].keyString))
+
+			continue
+		}
+
+		np.voteNodeState(epoch, votes[i].state, key)
+
+		if votes[i].state == v2netmap.Maintenance {
+			np.nodeHealth.markVotedMaintenance(votes[i].keyString)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package netmap
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/nspcc-dev/neo-go/pkg/core/mempoolevent"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/netmap/nodevalidation/state"
@@ -44,6 +46,16 @@ type (
 		Verify(netmap.NodeInfo) error
 	}
 
+	// NodeProber wraps basic method of the active check of the
+	// network map node availability.
+	NodeProber interface {
+		// Probe must check that the node is reachable and serves requests.
+		//
+		// Must return the time the probe took and an error if the node
+		// failed the probe.
+		Probe(context.Context, netmap.NodeInfo) (time.Duration, error)
+	}
+
 	// Processor of events produced by network map contract
 	// and new epoch ticker, because it is related to contract.
 	Processor struct {
@@ -58,6 +70,13 @@ type (
 
 		netmapSnapshot cleanupTable
 
+		nodeProber                NodeProber
+		nodeHealth                healthTable
+		probeInterval             time.Duration
+		probePoolSize             int
+		probeMaintenanceThreshold float64
+		probeOfflineThreshold     float64
+
 		handleNewAudit         event.Handler
 		handleAuditSettlements event.Handler
 		handleAlphabetSync     event.Handler
@@ -88,6 +107,16 @@ type (
 		NodeValidator NodeValidator
 
 		NodeStateSettings state.NetworkSettings
+
+		// NodeProber is optional, active probing of the
+		// netmap nodes is disabled if it is nil.
+		NodeProber                NodeProber
+		ProbeInterval             time.Duration
+		ProbePoolSize             int
+		ProbeWindow               int // number of the last probes health score is calculated on
+		ProbeMaxLatency           time.Duration
+		ProbeMaintenanceThreshold float64 // in [0, 1]
+		ProbeOfflineThreshold     float64 // in [0, 1]
 	}
 )
 
@@ -123,6 +152,21 @@ func New(p *Params) (*Processor, error) {
 		return nil, errors.New("ir/netmap: node state settings is not set")
 	}
 
+	if p.NodeProber != nil {
+		switch {
+		case p.ProbeInterval <= 0:
+			return nil, fmt.Errorf("ir/netmap: invalid probe interval %v", p.ProbeInterval)
+		case p.ProbePoolSize <= 0:
+			return nil, fmt.Errorf("ir/netmap: invalid probe pool size %d", p.ProbePoolSize)
+		case p.ProbeWindow <= 0:
+			return nil, fmt.Errorf("ir/netmap: invalid probe window %d", p.ProbeWindow)
+		case p.ProbeOfflineThreshold < 0 || p.ProbeOfflineThreshold > 1:
+			return nil, fmt.Errorf("ir/netmap: invalid probe offline threshold %v", p.ProbeOfflineThreshold)
+		case p.ProbeMaintenanceThreshold < p.ProbeOfflineThreshold || p.ProbeMaintenanceThreshold > 1:
+			return nil, fmt.Errorf("ir/netmap: invalid probe maintenance threshold %v", p.ProbeMaintenanceThreshold)
+		}
+	}
+
 	p.Log.Debug("netmap worker pool", zap.Int("size", p.PoolSize))
 
 	pool, err := ants.NewPool(p.PoolSize, ants.WithNonblocking(true))
@@ -150,6 +194,13 @@ func New(p *Params) (*Processor, error) {
 		nodeValidator: p.NodeValidator,
 
 		nodeStateSettings: p.NodeStateSettings,
+
+		nodeProber:                p.NodeProber,
+		nodeHealth:                newHealthTable(p.ProbeWindow, p.ProbeMaxLatency),
+		probeInterval:             p.ProbeInterval,
+		probePoolSize:             p.ProbePoolSize,
+		probeMaintenanceThreshold: p.ProbeMaintenanceThreshold,
+		probeOfflineThreshold:     p.ProbeOfflineThreshold,
 	}, nil
 }
 
@@ -2,7 +2,6 @@ package innerring
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"fmt"
 	"sync"
 	"time"
@@ -14,6 +13,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/network/cache"
 	"github.com/nspcc-dev/neofs-node/pkg/services/audit/auditor"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -29,14 +29,14 @@ type (
 			Get(clientcore.NodeInfo) (clientcore.Client, error)
 			CloseAll()
 		}
-		key *ecdsa.PrivateKey
+		signer signer.Signer
 
 		sgTimeout, headTimeout, rangeTimeout time.Duration
 	}
 
 	clientCacheParams struct {
-		Log *zap.Logger
-		Key *ecdsa.PrivateKey
+		Log    *zap.Logger
+		Signer signer.Signer
 
 		AllowExternal bool
 
@@ -59,7 +59,7 @@ func newClientCache(p *clientCacheParams) *ClientCache {
 				AllowExternal: p.AllowExternal,
 				Buffers:       p.Buffers,
 				Logger:        log}),
-		key:          p.Key,
+		signer:       p.Signer,
 		sgTimeout:    p.SGTimeout,
 		headTimeout:  p.HeadTimeout,
 		rangeTimeout: p.RangeTimeout,
@@ -221,7 +221,7 @@ func (c *ClientCache) getWrappedClient(info clientcore.NodeInfo) (neofsapiclient
 	}
 
 	cInternal.WrapBasicClient(cli)
-	cInternal.SetPrivateKey(c.key)
+	cInternal.SetSigner(c.signer)
 
 	return cInternal, nil
 }
@@ -2,6 +2,7 @@ package innerring
 
 import (
 	"fmt"
+	"path/filepath"
 	"sort"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
@@ -9,6 +10,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/governance"
 	auditClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/audit"
 	"github.com/nspcc-dev/neofs-node/pkg/services/audit"
+	"github.com/nspcc-dev/neofs-node/pkg/services/audit/resultstore"
 	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
 	"github.com/nspcc-dev/neofs-node/pkg/util/glagolitsa"
 	"github.com/nspcc-dev/neofs-node/pkg/util/state"
@@ -185,6 +187,19 @@ func (s *Server) WriteReport(r *audit.Report) error {
 	res := r.Result()
 	res.SetAuditorKey(s.pubKey)
 
+	if s.auditResults != nil {
+		if cnr, ok := res.Container(); ok {
+			err := s.auditResults.Put(res.Epoch(), cnr, r.CheckResults())
+			if err != nil {
+				s.log.Warn("can't store detailed audit results",
+					zap.Stringer("cid", cnr),
+					zap.Uint64("epoch", res.Epoch()),
+					zap.String("error", err.Error()),
+				)
+			}
+		}
+	}
+
 	prm := auditClient.PutPrm{}
 	prm.SetResult(res)
 
@@ -217,3 +232,23 @@ func initPersistentStateStorage(cfg *viper.Viper) (*state.PersistentStorage, err
 
 	return persistStorage, nil
 }
+
+func initAuditResultStorage(cfg *viper.Viper) (*resultstore.Store, error) {
+	path := cfg.GetString("audit.results.path")
+	if path == "" {
+		return nil, nil
+	}
+
+	if !filepath.IsAbs(path) {
+		return nil, fmt.Errorf("audit result storage path must be absolute: %q", path)
+	}
+
+	store, err := resultstore.Open(path,
+		resultstore.WithEpochs(cfg.GetUint64("audit.results.epochs")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("audit result storage init error: %w", err)
+	}
+
+	return store, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
@@ -106,6 +107,15 @@ func WithUncompressableContentTypes(values []string) Option {
 	}
 }
 
+// WithCipher returns option to encrypt the stored objects with the given
+// cipher. Objects stored without encryption remain readable. Nil cipher
+// disables encryption.
+func WithCipher(c *encryption.Cipher) Option {
+	return func(cfg *cfg) {
+		cfg.compression.Cipher = c
+	}
+}
+
 // SetReportErrorFunc allows to provide a function to be called on disk errors.
 // This function MUST be called before Open.
 func (b *BlobStor) SetReportErrorFunc(f func(string, error)) {
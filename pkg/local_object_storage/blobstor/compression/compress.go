@@ -2,10 +2,13 @@ package compression
 
 import (
 	"bytes"
+	"errors"
 	"strings"
 
 	"github.com/klauspost/compress/zstd"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
 // Config represents common compression-related configuration.
@@ -13,6 +16,9 @@ type Config struct {
 	Enabled                    bool
 	UncompressableContentTypes []string
 
+	// Cipher encrypts the stored data if set, see Encrypt and Decrypt.
+	Cipher *encryption.Cipher
+
 	encoder *zstd.Encoder
 	decoder *zstd.Decoder
 }
@@ -90,6 +96,29 @@ func (c *Config) Compress(data []byte) []byte {
 	return c.encoder.EncodeAll(data, make([]byte, 0, maxSize))
 }
 
+// Encrypt encrypts data of the object with the given address if encryption is
+// enabled and returns data untouched otherwise. Data is encrypted after the
+// compression.
+func (c *Config) Encrypt(addr oid.Address, data []byte) []byte {
+	if c == nil || c.Cipher == nil {
+		return data
+	}
+	return c.Cipher.Encrypt(addr, data)
+}
+
+// Decrypt decrypts data of the object with the given address if it is encrypted and returns data untouched
+// otherwise, so the data stored before the encryption was enabled remains
+// readable. Data is decrypted before the decompression.
+func (c *Config) Decrypt(addr oid.Address, data []byte) ([]byte, error) {
+	if !encryption.IsEncrypted(data) {
+		return data, nil
+	}
+	if c == nil || c.Cipher == nil {
+		return nil, errors.New("data is encrypted but the encryption key is not set")
+	}
+	return c.Cipher.Decrypt(addr, data)
+}
+
 // Close closes encoder and decoder, returns any error occurred.
 func (c *Config) Close() error {
 	var err error
@@ -0,0 +1,99 @@
+package compression
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ErrPayloadOutOfRange is returned by PayloadRange when the requested range is
+// out of the object payload bounds.
+var ErrPayloadOutOfRange = errors.New("payload range is out of bounds")
+
+// field number of the payload in the binary object.
+const objectPayloadField = 4
+
+// PayloadRange returns the payload range of the binary object with the given
+// address stored in the encrypted data of the given size accessible via src.
+// Only the data header, the chunks containing the object fields preceding the
+// payload and the range itself are read and decrypted. The second return value
+// is false if the data is not encrypted or the object is compressed, the
+// object should be read and decoded in full in this case.
+func (c *Config) PayloadRange(addr oid.Address, src io.ReaderAt, size int64, from, length uint64) ([]byte, bool, error) {
+	if c == nil || c.Cipher == nil {
+		return nil, false, nil
+	}
+
+	r, err := c.Cipher.NewReader(addr, src, size)
+	if err != nil {
+		if errors.Is(err, encryption.ErrNotEncrypted) {
+			return nil, false, nil
+		}
+		return nil, true, err
+	}
+
+	plainLen := r.Len()
+
+	// decrypts [off, off+n) range of the plaintext cut at its end
+	read := func(off, n uint64) ([]byte, error) {
+		if off+n > plainLen {
+			n = plainLen - off
+		}
+		return r.ReadRange(off, n)
+	}
+
+	prefix, err := read(0, uint64(len(zstdFrameMagic)))
+	if err != nil {
+		return nil, true, err
+	} else if bytes.Equal(prefix, zstdFrameMagic) {
+		return nil, false, nil
+	}
+
+	var off uint64
+	for off < plainLen {
+		// tag and length varints take no more than 20 bytes
+		buf, err := read(off, 2*binaryVarintMaxLen)
+		if err != nil {
+			return nil, true, err
+		}
+
+		num, typ, tagLen := protowire.ConsumeTag(buf)
+		if tagLen < 0 || typ != protowire.BytesType {
+			return nil, true, errors.New("invalid binary object")
+		}
+
+		fieldLen, lenLen := protowire.ConsumeVarint(buf[tagLen:])
+		if lenLen < 0 {
+			return nil, true, errors.New("invalid binary object")
+		}
+
+		off += uint64(tagLen + lenLen)
+		if off+fieldLen < off || off+fieldLen > plainLen {
+			return nil, true, errors.New("invalid binary object")
+		}
+
+		if num == objectPayloadField {
+			if from+length < from || from+length > fieldLen {
+				return nil, true, ErrPayloadOutOfRange
+			}
+
+			res, err := read(off+from, length)
+			return res, true, err
+		}
+
+		off += fieldLen
+	}
+
+	// object without payload
+	if from != 0 || length != 0 {
+		return nil, true, ErrPayloadOutOfRange
+	}
+
+	return []byte{}, true, nil
+}
+
+const binaryVarintMaxLen = 10
@@ -0,0 +1,103 @@
+package compression
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_PayloadRange(t *testing.T) {
+	key := make([]byte, encryption.KeySize)
+	_, _ = rand.Read(key)
+
+	cph, err := encryption.NewCipher(key)
+	require.NoError(t, err)
+
+	c := Config{Cipher: cph}
+	require.NoError(t, c.Init())
+
+	obj := objecttest.Object(t)
+	addr := object.AddressOf(&obj)
+	payload := make([]byte, 3*encryption.ChunkSize+123)
+	_, _ = rand.Read(payload)
+	obj.SetPayload(payload)
+
+	raw, err := obj.Marshal()
+	require.NoError(t, err)
+
+	data := c.Encrypt(addr, raw)
+
+	dec, err := c.Decrypt(addr, data)
+	require.NoError(t, err)
+	require.Equal(t, raw, dec)
+
+	for _, r := range [][2]uint64{
+		{0, 0},
+		{0, uint64(len(payload))},
+		{10, 100},
+		{encryption.ChunkSize - 10, 20},
+		{uint64(len(payload)) - 1, 1},
+	} {
+		res, ok, err := payloadRange(&c, addr, data, r[0], r[1])
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, payload[r[0]:r[0]+r[1]], res)
+	}
+
+	_, ok, err := payloadRange(&c, addr, data, uint64(len(payload)), 1)
+	require.True(t, ok)
+	require.ErrorIs(t, err, ErrPayloadOutOfRange)
+
+	_, ok, err = payloadRange(&c, addr, data, 10, 1<<63)
+	require.True(t, ok)
+	require.ErrorIs(t, err, ErrPayloadOutOfRange)
+
+	t.Run("empty payload", func(t *testing.T) {
+		obj := objecttest.Object(t)
+		obj.SetPayload(nil)
+		addr := object.AddressOf(&obj)
+
+		raw, err := obj.Marshal()
+		require.NoError(t, err)
+
+		res, ok, err := payloadRange(&c, addr, c.Encrypt(addr, raw), 0, 0)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Empty(t, res)
+
+		_, _, err = payloadRange(&c, addr, c.Encrypt(addr, raw), 0, 1)
+		require.ErrorIs(t, err, ErrPayloadOutOfRange)
+	})
+
+	t.Run("another object", func(t *testing.T) {
+		_, ok, err := c.PayloadRange(oidtest.Address(), bytes.NewReader(data), int64(len(data)), 0, 1)
+		require.True(t, ok)
+		require.ErrorIs(t, err, encryption.ErrInvalidData)
+	})
+
+	t.Run("not encrypted", func(t *testing.T) {
+		_, ok, err := payloadRange(&c, addr, raw, 0, 1)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("compressed", func(t *testing.T) {
+		c := Config{Enabled: true, Cipher: cph}
+		require.NoError(t, c.Init())
+
+		_, ok, err := payloadRange(&c, addr, c.Encrypt(addr, c.Compress(raw)), 0, 1)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+}
+
+func payloadRange(c *Config, addr oid.Address, data []byte, from, length uint64) ([]byte, bool, error) {
+	return c.PayloadRange(addr, bytes.NewReader(data), int64(len(data)), from, length)
+}
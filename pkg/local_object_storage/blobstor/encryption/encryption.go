@@ -0,0 +1,338 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"golang.org/x/crypto/hkdf"
+)
+
+// KeySize is the size of the data and master keys in bytes.
+const KeySize = 32
+
+// ChunkSize is the size of the plaintext chunks encrypted independently.
+const ChunkSize = 64 << 10
+
+// magic contains first 4 bytes of any encrypted data, it differs from the
+// zstd frame magic and from the first bytes of any object binary.
+var magic = []byte{0xfe, 0x4e, 0x46, 0x45}
+
+const (
+	version    = 1
+	saltSize   = 32
+	headerSize = 4 + 1 + saltSize
+	nonceSize  = 12
+	tagSize    = 16
+	// address and the last chunk flag
+	adSize = 2*32 + 1
+)
+
+// objectKeyInfo is the HKDF context of the object keys.
+var objectKeyInfo = []byte("neofs-blobstor-object-key")
+
+var (
+	// ErrInvalidData is returned when encrypted data is corrupted, encrypted
+	// with another key or for another object.
+	ErrInvalidData = errors.New("invalid encrypted data")
+
+	// ErrNotEncrypted is returned when the data is not encrypted by Cipher.
+	ErrNotEncrypted = errors.New("data is not encrypted")
+)
+
+// Cipher encrypts and decrypts objects stored in the blobstor sub-storages and
+// the write-cache.
+//
+// Each object is encrypted with its own AES-256-GCM key derived from the data
+// key with HKDF-SHA256 and the random 256-bit salt, so the nonces of the
+// different objects never collide. Data is encrypted in chunks of ChunkSize
+// bytes, so any range of the plaintext can be decrypted without reading and
+// decrypting the whole data. Encrypted data consists of the header (magic,
+// format version and salt) followed by the sealed chunks. Nonce of the chunk
+// is its index, the chunks are authenticated with the object address, and the
+// last chunk is additionally authenticated as the last one, so the data can
+// not be truncated, reordered or moved to another object unnoticed.
+type Cipher struct {
+	key []byte
+}
+
+// NewCipher returns Cipher using the given data key of KeySize bytes.
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("invalid key size %d, expected %d", len(key), KeySize)
+	}
+
+	return &Cipher{key: slice.Copy(key)}, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("invalid key size %d, expected %d", len(key), KeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// objectAEAD returns AEAD with the object key derived with the given salt.
+func (c *Cipher) objectAEAD(salt []byte) (cipher.AEAD, error) {
+	key := make([]byte, KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, c.key, salt, objectKeyInfo), key); err != nil {
+		return nil, fmt.Errorf("derive object key: %w", err)
+	}
+
+	return newAEAD(key)
+}
+
+// IsEncrypted checks whether the data is encrypted by Cipher.
+func IsEncrypted(data []byte) bool {
+	return len(data) >= len(magic) && bytes.Equal(data[:len(magic)], magic)
+}
+
+// Encrypt encrypts the data of the object with the given address.
+func (c *Cipher) Encrypt(addr oid.Address, data []byte) []byte {
+	n := chunksNumber(uint64(len(data)))
+	res := make([]byte, headerSize, headerSize+len(data)+int(n)*tagSize)
+
+	copy(res, magic)
+	res[len(magic)] = version
+
+	salt := res[len(magic)+1 : headerSize]
+	if _, err := rand.Read(salt); err != nil {
+		panic(fmt.Errorf("generate salt: %w", err))
+	}
+
+	aead, err := c.objectAEAD(salt)
+	if err != nil {
+		panic(err) // the key size is checked in NewCipher
+	}
+
+	var nonce [nonceSize]byte
+	ad := newAdditionalData(addr)
+
+	for i := uint64(0); i < n; i++ {
+		from := i * ChunkSize
+		to := from + ChunkSize
+		if to > uint64(len(data)) {
+			to = uint64(len(data))
+		}
+
+		binary.BigEndian.PutUint64(nonce[nonceSize-8:], i)
+		res = aead.Seal(res, nonce[:], data[from:to], ad.chunk(i == n-1))
+	}
+
+	return res
+}
+
+// Decrypt decrypts the data of the object with the given address encrypted
+// by Encrypt.
+func (c *Cipher) Decrypt(addr oid.Address, data []byte) ([]byte, error) {
+	r, err := c.NewReader(addr, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Len() == 0 {
+		// authenticate the only empty chunk
+		if _, err = r.chunk(0); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.ReadRange(0, r.Len())
+}
+
+// PlaintextLen returns length of the data which is encrypted by Encrypt into
+// the data of the given size.
+func PlaintextLen(size uint64) (uint64, error) {
+	if size < headerSize+tagSize {
+		return 0, ErrInvalidData
+	}
+
+	body := size - headerSize
+	full := body / (ChunkSize + tagSize)
+	rest := body % (ChunkSize + tagSize)
+
+	switch {
+	case rest == 0:
+		return full * ChunkSize, nil
+	case rest < tagSize:
+		return 0, ErrInvalidData
+	default:
+		return full*ChunkSize + rest - tagSize, nil
+	}
+}
+
+// Reader provides random access to the plaintext of the data encrypted by
+// Encrypt reading and decrypting only the required chunks.
+type Reader struct {
+	aead cipher.AEAD
+	ad   additionalData
+	src  io.ReaderAt
+	size uint64
+
+	plainLen uint64
+	chunks   uint64
+
+	// last decrypted chunk, it is usually read several times in a row
+	cur   uint64
+	plain []byte
+	buf   []byte
+}
+
+// NewReader returns Reader of the data of the object with the given address
+// encrypted by Encrypt and accessible via src of the given size. Only the
+// header of the data is read. Returns ErrNotEncrypted if the data is not
+// encrypted.
+func (c *Cipher) NewReader(addr oid.Address, src io.ReaderAt, size int64) (*Reader, error) {
+	var hdr [headerSize]byte
+
+	n, err := src.ReadAt(hdr[:], 0)
+	if n < len(magic) || !IsEncrypted(hdr[:n]) {
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return nil, ErrNotEncrypted
+	}
+
+	if n < headerSize || hdr[len(magic)] != version {
+		return nil, ErrInvalidData
+	}
+
+	plainLen, err := PlaintextLen(uint64(size))
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := c.objectAEAD(hdr[len(magic)+1:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{
+		aead:     aead,
+		ad:       newAdditionalData(addr),
+		src:      src,
+		size:     uint64(size),
+		plainLen: plainLen,
+		chunks:   chunksNumber(plainLen),
+	}, nil
+}
+
+// Len returns length of the plaintext.
+func (x *Reader) Len() uint64 {
+	return x.plainLen
+}
+
+// ReadRange returns [off, off+ln) range of the plaintext. Only the chunks
+// containing the range are read and decrypted.
+func (x *Reader) ReadRange(off, ln uint64) ([]byte, error) {
+	if to := off + ln; to < off || to > x.plainLen {
+		return nil, fmt.Errorf("range [%d:%d] is out of data bounds %d", off, off+ln, x.plainLen)
+	}
+
+	res := make([]byte, 0, ln)
+
+	for i := off / ChunkSize; uint64(len(res)) < ln; i++ {
+		chunk, err := x.chunk(i)
+		if err != nil {
+			return nil, err
+		}
+
+		from := off + uint64(len(res)) - i*ChunkSize
+		to := uint64(len(chunk))
+		if rest := ln - uint64(len(res)); to-from > rest {
+			to = from + rest
+		}
+
+		res = append(res, chunk[from:to]...)
+	}
+
+	return res, nil
+}
+
+// chunk returns decrypted i-th chunk.
+func (x *Reader) chunk(i uint64) ([]byte, error) {
+	if x.plain != nil && x.cur == i {
+		return x.plain, nil
+	}
+
+	from := headerSize + i*(ChunkSize+tagSize)
+	to := from + ChunkSize + tagSize
+	if to > x.size {
+		to = x.size
+	}
+
+	if x.buf == nil {
+		x.buf = make([]byte, ChunkSize+tagSize)
+	}
+
+	sealed := x.buf[:to-from]
+
+	n, err := x.src.ReadAt(sealed, int64(from))
+	if n < len(sealed) {
+		if err == nil || errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, fmt.Errorf("read chunk #%d: %w", i, err)
+	}
+
+	var nonce [nonceSize]byte
+	binary.BigEndian.PutUint64(nonce[nonceSize-8:], i)
+
+	x.plain, err = x.aead.Open(x.plain[:0], nonce[:], sealed, x.ad.chunk(i == x.chunks-1))
+	if err != nil {
+		x.plain = nil
+		return nil, ErrInvalidData
+	}
+
+	x.cur = i
+
+	return x.plain, nil
+}
+
+// additionalData is the data the chunks are authenticated with.
+type additionalData [adSize]byte
+
+func newAdditionalData(addr oid.Address) additionalData {
+	var ad additionalData
+
+	cnr := addr.Container()
+	obj := addr.Object()
+
+	copy(ad[:], cnr[:])
+	copy(ad[len(cnr):], obj[:])
+
+	return ad
+}
+
+// chunk returns additional data of the chunk.
+func (x additionalData) chunk(last bool) []byte {
+	if last {
+		x[adSize-1] = 1
+	}
+
+	return x[:]
+}
+
+// chunksNumber returns number of the chunks the plaintext of the given length
+// is split into. Empty plaintext is encrypted as a single empty chunk.
+func chunksNumber(ln uint64) uint64 {
+	if ln == 0 {
+		return 1
+	}
+
+	return (ln + ChunkSize - 1) / ChunkSize
+}
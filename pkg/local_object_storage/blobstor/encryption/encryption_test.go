@@ -0,0 +1,168 @@
+package encryption_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(t *testing.T) []byte {
+	key := make([]byte, encryption.KeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func newCipher(t *testing.T) *encryption.Cipher {
+	c, err := encryption.NewCipher(newKey(t))
+	require.NoError(t, err)
+	return c
+}
+
+func TestCipher(t *testing.T) {
+	c := newCipher(t)
+	addr := oidtest.Address()
+
+	for _, ln := range []int{0, 1, encryption.ChunkSize - 1, encryption.ChunkSize, encryption.ChunkSize + 1, 3*encryption.ChunkSize + 100} {
+		data := make([]byte, ln)
+		_, _ = rand.Read(data)
+
+		enc := c.Encrypt(addr, data)
+		require.True(t, encryption.IsEncrypted(enc))
+
+		plainLen, err := encryption.PlaintextLen(uint64(len(enc)))
+		require.NoError(t, err)
+		require.EqualValues(t, ln, plainLen)
+
+		dec, err := c.Decrypt(addr, enc)
+		require.NoError(t, err)
+		require.Equal(t, data, dec)
+
+		src := &countingReader{r: bytes.NewReader(enc)}
+
+		r, err := c.NewReader(addr, src, int64(len(enc)))
+		require.NoError(t, err)
+		require.EqualValues(t, ln, r.Len())
+
+		for _, rng := range [][2]int{{0, 0}, {0, ln}, {ln / 2, ln - ln/2}, {ln / 3, ln / 3}} {
+			res, err := r.ReadRange(uint64(rng[0]), uint64(rng[1]))
+			require.NoError(t, err)
+			require.Equal(t, data[rng[0]:rng[0]+rng[1]], res)
+		}
+
+		_, err = r.ReadRange(uint64(ln), 1)
+		require.Error(t, err)
+	}
+
+	t.Run("only needed chunks are read", func(t *testing.T) {
+		data := make([]byte, 10*encryption.ChunkSize)
+		_, _ = rand.Read(data)
+		enc := c.Encrypt(addr, data)
+
+		src := &countingReader{r: bytes.NewReader(enc)}
+
+		r, err := c.NewReader(addr, src, int64(len(enc)))
+		require.NoError(t, err)
+
+		res, err := r.ReadRange(encryption.ChunkSize+10, encryption.ChunkSize)
+		require.NoError(t, err)
+		require.Equal(t, data[encryption.ChunkSize+10:2*encryption.ChunkSize+10], res)
+		require.Less(t, src.n, 3*(encryption.ChunkSize+100))
+	})
+
+	t.Run("random salt", func(t *testing.T) {
+		data := []byte("Hello, world!")
+		require.NotEqual(t, c.Encrypt(addr, data), c.Encrypt(addr, data))
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		data := make([]byte, 2*encryption.ChunkSize+10)
+		enc := c.Encrypt(addr, data)
+
+		corrupted := append([]byte{}, enc...)
+		corrupted[len(corrupted)/2]++
+		_, err := c.Decrypt(addr, corrupted)
+		require.ErrorIs(t, err, encryption.ErrInvalidData)
+
+		// drop the last chunk, so the previous one becomes the last
+		_, err = c.Decrypt(addr, enc[:len(enc)-10-16])
+		require.ErrorIs(t, err, encryption.ErrInvalidData)
+
+		_, err = newCipher(t).Decrypt(addr, enc)
+		require.ErrorIs(t, err, encryption.ErrInvalidData)
+
+		_, err = c.Decrypt(oidtest.Address(), enc)
+		require.ErrorIs(t, err, encryption.ErrInvalidData)
+
+		_, err = c.Decrypt(oidtest.Address(), c.Encrypt(addr, nil))
+		require.ErrorIs(t, err, encryption.ErrInvalidData)
+	})
+
+	t.Run("plaintext", func(t *testing.T) {
+		require.False(t, encryption.IsEncrypted([]byte("Hello, world!")))
+		_, err := c.Decrypt(addr, []byte("Hello, world!"))
+		require.ErrorIs(t, err, encryption.ErrNotEncrypted)
+	})
+}
+
+type countingReader struct {
+	r io.ReaderAt
+	n int
+}
+
+func (x *countingReader) ReadAt(p []byte, off int64) (int, error) {
+	n, err := x.r.ReadAt(p, off)
+	x.n += n
+	return n, err
+}
+
+func TestDataKey(t *testing.T) {
+	master := newKey(t)
+	path := filepath.Join(t.TempDir(), "keys", "data.key")
+
+	_, err := encryption.LoadDataKey(path, master)
+	require.Error(t, err)
+
+	key, err := encryption.LoadOrCreateDataKey(path, master)
+	require.NoError(t, err)
+	require.Len(t, key, encryption.KeySize)
+
+	loaded, err := encryption.LoadOrCreateDataKey(path, master)
+	require.NoError(t, err)
+	require.Equal(t, key, loaded)
+
+	loaded, err = encryption.LoadDataKey(path, master)
+	require.NoError(t, err)
+	require.Equal(t, key, loaded)
+
+	_, err = encryption.LoadDataKey(path, newKey(t))
+	require.Error(t, err)
+}
+
+func TestMasterKeyFromSigner(t *testing.T) {
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	key1, err := encryption.MasterKeyFromSigner(signer.FromPrivateKey(pk))
+	require.NoError(t, err)
+	require.Len(t, key1, encryption.KeySize)
+
+	key2, err := encryption.MasterKeyFromSigner(signer.FromPrivateKey(pk))
+	require.NoError(t, err)
+	require.Equal(t, key1, key2)
+
+	pk, err = keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	key2, err = encryption.MasterKeyFromSigner(signer.FromPrivateKey(pk))
+	require.NoError(t, err)
+	require.NotEqual(t, key1, key2)
+}
@@ -0,0 +1,127 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+)
+
+// masterKeyLabel is the label the master key is derived by the signer with.
+const masterKeyLabel = "neofs-blobstor-master-key"
+
+// MasterKeyFromSigner derives the master key from the signer, so the master
+// key is not stored anywhere and is available only with the signer (e.g.
+// external signing agent). The key is derived by the dedicated signer
+// operation, so it stays the same for the same signer key and can not be
+// obtained from any signature made by the signer.
+func MasterKeyFromSigner(s signer.Signer) ([]byte, error) {
+	key, err := s.DeriveKey([]byte(masterKeyLabel))
+	if err != nil {
+		return nil, fmt.Errorf("derive master key: %w", err)
+	}
+
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("invalid derived master key size %d, expected %d", len(key), KeySize)
+	}
+
+	return key, nil
+}
+
+// WrapKey encrypts the data key with the master key.
+func WrapKey(master, key []byte) ([]byte, error) {
+	aead, err := newAEAD(master)
+	if err != nil {
+		return nil, fmt.Errorf("invalid master key: %w", err)
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(key)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, key, nil), nil
+}
+
+// UnwrapKey decrypts the data key encrypted by WrapKey.
+func UnwrapKey(master, wrapped []byte) ([]byte, error) {
+	aead, err := newAEAD(master)
+	if err != nil {
+		return nil, fmt.Errorf("invalid master key: %w", err)
+	}
+
+	if len(wrapped) < aead.NonceSize() {
+		return nil, ErrInvalidData
+	}
+
+	key, err := aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.New("wrong master key or corrupted data key")
+	}
+
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("invalid data key size %d", len(key))
+	}
+
+	return key, nil
+}
+
+// LoadDataKey reads the data key wrapped by the master key from the file.
+func LoadDataKey(path string, master []byte) ([]byte, error) {
+	wrapped, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read data key file: %w", err)
+	}
+
+	return UnwrapKey(master, wrapped)
+}
+
+// LoadOrCreateDataKey reads the data key wrapped by the master key from the
+// file. If the file does not exist, new random data key is generated and
+// written to it wrapped by the master key.
+func LoadOrCreateDataKey(path string, master []byte) ([]byte, error) {
+	key, err := LoadDataKey(path, master)
+	if err == nil || !errors.Is(err, fs.ErrNotExist) {
+		return key, err
+	}
+
+	key = make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate data key: %w", err)
+	}
+
+	wrapped, err := WrapKey(master, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("create data key directory: %w", err)
+	}
+
+	// O_EXCL protects from overwriting the key created concurrently
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("create data key file: %w", err)
+	}
+
+	_, err = f.Write(wrapped)
+	if err == nil {
+		err = f.Sync()
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("write data key file: %w", err)
+	}
+
+	return key, nil
+}
@@ -139,11 +139,14 @@ func (t *FSTree) iterate(depth uint64, curPath []string, prm common.IteratePrm)
 		if prm.LazyHandler != nil {
 			err = prm.LazyHandler(*addr, func() ([]byte, error) {
 				data, err := os.ReadFile(filepath.Join(curPath...))
-				if err != nil && errors.Is(err, fs.ErrNotExist) {
-					return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+				if err != nil {
+					if errors.Is(err, fs.ErrNotExist) {
+						return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+					}
+					return nil, err
 				}
 
-				return data, err
+				return t.Decrypt(*addr, data)
 			})
 		} else {
 			var data []byte
@@ -152,6 +155,9 @@ func (t *FSTree) iterate(depth uint64, curPath []string, prm common.IteratePrm)
 			if err != nil && errors.Is(err, fs.ErrNotExist) {
 				continue
 			}
+			if err == nil {
+				data, err = t.Decrypt(*addr, data)
+			}
 			if err == nil {
 				data, err = t.Decompress(data)
 			}
@@ -264,6 +270,7 @@ func (t *FSTree) Put(prm common.PutPrm) (common.PutRes, error) {
 	if !prm.DontCompress {
 		prm.RawData = t.Compress(prm.RawData)
 	}
+	prm.RawData = t.Encrypt(prm.Address, prm.RawData)
 	err := t.writeData(p, prm.RawData)
 	if err != nil {
 		return common.PutRes{}, fmt.Errorf("write object data into file %q: %w", p, err)
@@ -284,6 +291,11 @@ func (t *FSTree) Get(prm common.GetPrm) (common.GetRes, error) {
 		return common.GetRes{}, fmt.Errorf("read file %q: %w", p, err)
 	}
 
+	data, err = t.Decrypt(prm.Address, data)
+	if err != nil {
+		return common.GetRes{}, fmt.Errorf("decrypt file data %q: %w", p, err)
+	}
+
 	data, err = t.Decompress(data)
 	if err != nil {
 		return common.GetRes{}, fmt.Errorf("decompress file data %q: %w", p, err)
@@ -299,6 +311,14 @@ func (t *FSTree) Get(prm common.GetPrm) (common.GetRes, error) {
 
 // GetRange implements common.Storage.
 func (t *FSTree) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	if t.Config != nil && t.Cipher != nil {
+		// encrypted objects are decrypted partially if possible
+		data, ok, err := t.getEncryptedRange(prm)
+		if ok {
+			return common.GetRangeRes{Data: data}, err
+		}
+	}
+
 	res, err := t.Get(common.GetPrm{Address: prm.Address})
 	if err != nil {
 		return common.GetRangeRes{}, err
@@ -317,6 +337,33 @@ func (t *FSTree) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
 	}, nil
 }
 
+func (t *FSTree) getEncryptedRange(prm common.GetRangePrm) ([]byte, bool, error) {
+	p := t.treePath(prm.Address)
+
+	f, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, true, logicerr.Wrap(apistatus.ObjectNotFound{})
+		}
+		return nil, true, fmt.Errorf("open file %q: %w", p, err)
+	}
+	defer f.Close()
+
+	st, err := f.Stat()
+	if err != nil {
+		return nil, true, fmt.Errorf("stat file %q: %w", p, err)
+	}
+
+	res, ok, err := t.PayloadRange(prm.Address, f, st.Size(), prm.Range.GetOffset(), prm.Range.GetLength())
+	if errors.Is(err, compression.ErrPayloadOutOfRange) {
+		return nil, true, logicerr.Wrap(apistatus.ObjectOutOfRange{})
+	} else if err != nil {
+		return nil, true, fmt.Errorf("read payload range from file %q: %w", p, err)
+	}
+
+	return res, ok, nil
+}
+
 // NumberOfObjects walks the file tree rooted at FSTree's root
 // and returns number of stored objects.
 func (t *FSTree) NumberOfObjects() (uint64, error) {
@@ -28,6 +28,16 @@ func TestGeneric(t *testing.T) {
 
 	blobstortest.TestAll(t, newTree, 2048, 16*1024)
 
+	t.Run("encrypted", func(t *testing.T) {
+		newEncryptedTree := func(t *testing.T) common.Storage {
+			s := newTree(t)
+			s.SetCompressor(blobstortest.NewEncryptedCompressor(t))
+			return s
+		}
+
+		blobstortest.TestAll(t, newEncryptedTree, 2048, 16*1024)
+	})
+
 	t.Run("info", func(t *testing.T) {
 		dir := filepath.Join(t.Name(), "info")
 		blobstortest.TestInfo(t, func(t *testing.T) common.Storage {
@@ -6,6 +6,8 @@ import (
 
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -95,3 +97,18 @@ func NewObject(sz uint64) *objectSDK.Object {
 
 	return raw
 }
+
+// NewEncryptedCompressor returns compression config encrypting data with the
+// random key.
+func NewEncryptedCompressor(t *testing.T) *compression.Config {
+	key := make([]byte, encryption.KeySize)
+	rand.Read(key)
+
+	cph, err := encryption.NewCipher(key)
+	require.NoError(t, err)
+
+	c := &compression.Config{Cipher: cph}
+	require.NoError(t, c.Init())
+
+	return c
+}
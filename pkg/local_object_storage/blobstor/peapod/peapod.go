@@ -302,6 +302,11 @@ func (x *Peapod) Get(prm common.GetPrm) (common.GetRes, error) {
 	}
 
 	// copy-paste from FSTree
+	data, err = x.compress.Decrypt(prm.Address, data)
+	if err != nil {
+		return common.GetRes{}, fmt.Errorf("decrypt data: %w", err)
+	}
+
 	data, err = x.compress.Decompress(data)
 	if err != nil {
 		return common.GetRes{}, fmt.Errorf("decompress data: %w", err)
@@ -370,6 +375,7 @@ func (x *Peapod) Put(prm common.PutPrm) (common.PutRes, error) {
 	if !prm.DontCompress {
 		prm.RawData = x.compress.Compress(prm.RawData)
 	}
+	prm.RawData = x.compress.Encrypt(prm.Address, prm.RawData)
 
 	// Track https://github.com/nspcc-dev/neofs-node/issues/2480
 	err := x.batch(context.TODO(), func(bktRoot *bbolt.Bucket) error {
@@ -466,7 +472,10 @@ func (x *Peapod) Iterate(prm common.IteratePrm) (common.IterateRes, error) {
 				return fmt.Errorf("decode object address from bucket key: %w", err)
 			}
 
-			v, err = x.compress.Decompress(v)
+			v, err = x.compress.Decrypt(addr, v)
+			if err == nil {
+				v, err = x.compress.Decompress(v)
+			}
 			if err != nil {
 				if prm.IgnoreErrors {
 					if prm.ErrorHandler != nil {
@@ -476,7 +485,7 @@ func (x *Peapod) Iterate(prm common.IteratePrm) (common.IterateRes, error) {
 					return nil
 				}
 
-				return fmt.Errorf("decompress value for object '%s': %w", addr, err)
+				return fmt.Errorf("decode value for object '%s': %w", addr, err)
 			}
 
 			if prm.LazyHandler != nil {
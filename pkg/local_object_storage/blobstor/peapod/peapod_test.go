@@ -24,6 +24,14 @@ func TestGeneric(t *testing.T) {
 		return peapod.New(newPath(), 0o600, 10*time.Millisecond)
 	}, 2048, 16*1024)
 
+	t.Run("encrypted", func(t *testing.T) {
+		blobstortest.TestAll(t, func(t *testing.T) common.Storage {
+			s := peapod.New(newPath(), 0o600, 10*time.Millisecond)
+			s.SetCompressor(blobstortest.NewEncryptedCompressor(t))
+			return s
+		}, 2048, 16*1024)
+	})
+
 	t.Run("info", func(t *testing.T) {
 		path := newPath()
 		blobstortest.TestInfo(t, func(t *testing.T) common.Storage {
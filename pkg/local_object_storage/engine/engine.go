@@ -207,6 +207,8 @@ type cfg struct {
 	shardPoolSize uint32
 
 	containerSource container.Source
+
+	inhumedObjectsCallback shard.InhumedObjectsCallback
 }
 
 func defaultCfg() *cfg {
@@ -263,6 +265,14 @@ func WithErrorThreshold(sz uint32) Option {
 	}
 }
 
+// WithInhumedObjectsCallback returns an option to specify callback of the
+// objects marked as removed in any shard.
+func WithInhumedObjectsCallback(f shard.InhumedObjectsCallback) Option {
+	return func(c *cfg) {
+		c.inhumedObjectsCallback = f
+	}
+}
+
 // WithContainersSource returns an option to specify container source.
 func WithContainersSource(cs container.Source) Option {
 	return func(c *cfg) {
@@ -60,6 +60,9 @@ var errInhumeFailure = errors.New("inhume operation failed")
 // NOTE: Marks any object as removed (despite any prohibitions on operations
 // with that object) if WithForceRemoval option has been provided.
 //
+// Metadata overlays of the removed objects are marked as garbage in all
+// shards.
+//
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) Inhume(prm InhumePrm) (res InhumeRes, err error) {
 	err = e.execIfNotBlocked(func() error {
@@ -113,9 +116,37 @@ func (e *StorageEngine) inhume(prm InhumePrm) (InhumeRes, error) {
 		}
 	}
 
+	e.inhumeOverlays(prm.addrs)
+
 	return InhumeRes{}, nil
 }
 
+// inhumeOverlays marks metadata overlays of the removed objects as garbage.
+// Overlays may be stored in any shard, so all of them are checked.
+func (e *StorageEngine) inhumeOverlays(addrs []oid.Address) {
+	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+		overlays, err := sh.Overlays(addrs)
+		if err != nil {
+			e.reportShardError(sh, "could not read overlays of the removed objects", err)
+			return false
+		}
+
+		if len(overlays) == 0 {
+			return false
+		}
+
+		var inhumePrm shard.InhumePrm
+		inhumePrm.MarkAsGarbage(overlays...)
+
+		_, err = sh.Inhume(inhumePrm)
+		if err != nil {
+			e.reportShardError(sh, "could not mark overlays of the removed objects as garbage", err)
+		}
+
+		return false
+	})
+}
+
 // InhumeContainer marks every object in a container as removed.
 // Any further [StorageEngine.Get] calls will return [apistatus.ObjectNotFound]
 // errors.
@@ -8,6 +8,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/stretchr/testify/require"
 )
 
@@ -81,4 +82,45 @@ func TestStorageEngine_Inhume(t *testing.T) {
 		require.NoError(t, err)
 		require.Empty(t, addrs)
 	})
+
+	t.Run("overlays", func(t *testing.T) {
+		s1 := testNewShard(t, 1)
+		s2 := testNewShard(t, 2)
+
+		e := testNewEngineWithShards(s1, s2)
+		defer e.Close()
+
+		target := generateObjectWithCID(t, cnr)
+		idTarget, _ := target.ID()
+
+		overlay := generateObjectWithCID(t, cnr)
+		addAttribute(overlay, object.AttributeOverlayTarget, idTarget.EncodeToString())
+		addAttribute(overlay, "tier", "cold")
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(target)
+		_, err := s1.Put(putPrm)
+		require.NoError(t, err)
+
+		putPrm.SetObject(overlay)
+		_, err = s2.Put(putPrm)
+		require.NoError(t, err)
+
+		tagged := objectSDK.SearchFilters{}
+		tagged.AddFilter("tier", "cold", objectSDK.MatchStringEqual)
+
+		addrs, err := Select(e, cnr, tagged)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []oid.Address{object.AddressOf(target), object.AddressOf(overlay)}, addrs)
+
+		var inhumePrm InhumePrm
+		inhumePrm.WithTarget(tombstoneID, object.AddressOf(target))
+
+		_, err = e.Inhume(inhumePrm)
+		require.NoError(t, err)
+
+		addrs, err = Select(e, cnr, tagged)
+		require.NoError(t, err)
+		require.Empty(t, addrs)
+	})
 }
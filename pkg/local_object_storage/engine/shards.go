@@ -98,6 +98,10 @@ func (e *StorageEngine) createShard(opts []shard.Option) (*shard.Shard, error) {
 
 	e.mtx.RUnlock()
 
+	if e.inhumedObjectsCallback != nil {
+		opts = append(opts, shard.WithInhumedObjectsCallback(e.inhumedObjectsCallback))
+	}
+
 	sh := shard.New(append(opts,
 		shard.WithID(id),
 		shard.WithExpiredTombstonesCallback(e.processExpiredTombstones),
@@ -206,6 +206,11 @@ func (db *DB) DeleteContainer(cID cid.ID) error {
 			return fmt.Errorf("split id index cleanup: %w", err)
 		}
 
+		err = tx.DeleteBucket(overlayBucketName(cID, buff))
+		if err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
+			return fmt.Errorf("overlay index cleanup: %w", err)
+		}
+
 		// Attributes index
 		var keysToDelete [][]byte // see https://github.com/etcd-io/bbolt/issues/146
 		c := tx.Cursor()
@@ -214,6 +219,11 @@ func (db *DB) DeleteContainer(cID cid.ID) error {
 			keysToDelete = append(keysToDelete, k)
 		}
 
+		bktPrefix = overlayAttributeBucketName(cID, "", make([]byte, bucketKeySize))
+		for k, _ := c.Seek(bktPrefix); k != nil && bytes.HasPrefix(k, bktPrefix); k, _ = c.Next() {
+			keysToDelete = append(keysToDelete, k)
+		}
+
 		for _, k := range keysToDelete {
 			err = tx.DeleteBucket(k)
 			if err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
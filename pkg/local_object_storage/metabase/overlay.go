@@ -0,0 +1,106 @@
+package meta
+
+import (
+	"fmt"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// Overlays returns addresses of the metadata overlays of the objects with the
+// given addresses. Overlays are looked up in the index by the target ID, so
+// the removed overlays may be returned too.
+//
+// Returns only non-logical errors related to underlying database.
+func (db *DB) Overlays(addrs []oid.Address) ([]oid.Address, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	var res []oid.Address
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		bucketName := make([]byte, bucketKeySize)
+		key := make([]byte, objectKeySize)
+
+		for i := range addrs {
+			bkt := tx.Bucket(overlayBucketName(addrs[i].Container(), bucketName))
+			if bkt == nil {
+				continue
+			}
+
+			lst, err := decodeList(bkt.Get(objectKey(addrs[i].Object(), key)))
+			if err != nil {
+				return fmt.Errorf("can't decode overlays of %s: %w", addrs[i], err)
+			}
+
+			for j := range lst {
+				var id oid.ID
+				if err := id.Decode(lst[j]); err != nil {
+					return fmt.Errorf("invalid overlay ID of %s: %w", addrs[i], err)
+				}
+
+				var addr oid.Address
+				addr.SetContainer(addrs[i].Container())
+				addr.SetObject(id)
+
+				res = append(res, addr)
+			}
+		}
+
+		return nil
+	})
+
+	return res, err
+}
+
+// migrateOverlayIndexes indexes the metadata overlays stored by the version 2
+// metabase.
+func migrateOverlayIndexes(tx *bbolt.Tx) error {
+	var overlays []*objectSDK.Object
+
+	// buckets are not created while iterating over the root ones
+	err := tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+		if len(name) != bucketKeySize || name[0] != primaryPrefix {
+			return nil
+		}
+
+		return b.ForEach(func(_, v []byte) error {
+			obj := objectSDK.New()
+			if err := obj.Unmarshal(v); err != nil {
+				return fmt.Errorf("can't unmarshal object header: %w", err)
+			}
+
+			for _, a := range obj.Attributes() {
+				if a.Key() == objectCore.AttributeOverlayTarget {
+					overlays = append(overlays, obj)
+					break
+				}
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range overlays {
+		err = updateOverlayListIndex(tx, overlays[i], putListIndexItem)
+		if err != nil {
+			return fmt.Errorf("can't put overlay list index: %w", err)
+		}
+
+		err = updateOverlayFKBTIndexes(tx, overlays[i], putFKBTIndexItem)
+		if err != nil {
+			return fmt.Errorf("can't put overlay fake bucket tree indexes: %w", err)
+		}
+	}
+
+	return nil
+}
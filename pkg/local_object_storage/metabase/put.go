@@ -291,7 +291,25 @@ func updateListIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFun
 		}
 	}
 
-	return nil
+	return updateOverlayListIndex(tx, obj, f)
+}
+
+// updateOverlayListIndex indexes the metadata overlay by its target.
+func updateOverlayListIndex(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFunc) error {
+	target, ok, err := objectCore.OverlayTarget(obj)
+	if err != nil || !ok {
+		// see updateOverlayFKBTIndexes
+		return nil
+	}
+
+	id, _ := obj.ID()
+	cnr, _ := obj.ContainerID()
+
+	return f(tx, namedBucketItem{
+		name: overlayBucketName(cnr, make([]byte, bucketKeySize)),
+		key:  objectKey(target, make([]byte, objectKeySize)),
+		val:  objectKey(id, make([]byte, objectKeySize)),
+	})
 }
 
 func updateFKBTIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFunc) error {
@@ -324,6 +342,45 @@ func updateFKBTIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFun
 		}
 	}
 
+	return updateOverlayFKBTIndexes(tx, obj, f)
+}
+
+// updateOverlayFKBTIndexes indexes the attributes of the metadata overlay for
+// its target.
+func updateOverlayFKBTIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFunc) error {
+	target, ok, err := objectCore.OverlayTarget(obj)
+	if err != nil || !ok {
+		// invalid overlays are not accepted by the node, so the error is
+		// not expected here, such objects are not indexed as overlays
+		return nil
+	}
+
+	id, _ := obj.ID()
+	cnr, _ := obj.ContainerID()
+	objKey := objectKey(id, make([]byte, objectKeySize))
+	attrs := obj.Attributes()
+	key := make([]byte, bucketKeySize)
+
+	// attributes of the overlay are indexed for its target, overlay ID makes
+	// the key unique, so each overlay is removed from the index separately
+	overlayKey := append(objectKey(target, make([]byte, objectKeySize, 2*objectKeySize)), objKey...)
+
+	for i := range attrs {
+		if !objectCore.IsOverlayAttribute(attrs[i].Key()) {
+			continue
+		}
+
+		key = overlayAttributeBucketName(cnr, attrs[i].Key(), key)
+		err := f(tx, namedBucketItem{
+			name: key,
+			key:  []byte(attrs[i].Value()),
+			val:  overlayKey,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -205,6 +205,27 @@ func (db *DB) selectFastFilter(
 			selectOutsideFKBT(tx, allBucketNames(cnr), bucketName, to, fNum)
 		} else {
 			db.selectFromFKBT(tx, bucketName, f, to, fNum)
+
+			// objects labeled by the available overlays
+			var (
+				overlay   oid.Address
+				overlayID oid.ID
+			)
+
+			overlay.SetContainer(cnr)
+
+			overlayBucketName := overlayAttributeBucketName(cnr, f.Header(), make([]byte, bucketKeySize))
+			db.iterateFKBT(tx, overlayBucketName, f, func(k []byte) {
+				if len(k) != 2*objectKeySize || overlayID.Decode(k[objectKeySize:]) != nil {
+					return
+				}
+
+				overlay.SetObject(overlayID)
+
+				if objectStatus(tx, overlay, currEpoch) == 0 {
+					markAddressInCache(to, fNum, string(k[:objectKeySize]))
+				}
+			})
 		}
 	}
 }
@@ -266,6 +287,14 @@ func (db *DB) selectFromFKBT(
 	to map[string]int, // resulting cache
 	fNum int, // index of filter
 ) { //
+	db.iterateFKBT(tx, name, f, func(k []byte) {
+		markAddressInCache(to, fNum, string(k))
+	})
+}
+
+// iterateFKBT passes the leaf keys of the <fkbt> index matching the filter to
+// the handler.
+func (db *DB) iterateFKBT(tx *bbolt.Tx, name []byte, f object.SearchFilter, h func(k []byte)) {
 	matchFunc, ok := db.matchers[f.Operation()]
 	if !ok {
 		db.log.Debug("missing matcher", zap.Uint32("operation", uint32(f.Operation())))
@@ -285,7 +314,7 @@ func (db *DB) selectFromFKBT(
 		}
 
 		return fkbtLeaf.ForEach(func(k, _ []byte) error {
-			markAddressInCache(to, fNum, string(k))
+			h(k)
 
 			return nil
 		})
@@ -344,6 +344,83 @@ func TestDB_SelectInhume(t *testing.T) {
 	)
 }
 
+func TestDB_SelectOverlays(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	target := generateObjectWithCID(t, cnr)
+	addAttribute(target, "tier", "hot")
+	require.NoError(t, putBig(db, target))
+
+	targetID, _ := target.ID()
+
+	newOverlay := func(tier string) *objectSDK.Object {
+		o := generateObjectWithCID(t, cnr)
+		addAttribute(o, object.AttributeOverlayTarget, targetID.EncodeToString())
+		addAttribute(o, "tier", tier)
+		require.NoError(t, putBig(db, o))
+
+		return o
+	}
+
+	overlay1 := newOverlay("cold")
+	overlay2 := newOverlay("cold")
+
+	fs := objectSDK.SearchFilters{}
+	fs.AddFilter("tier", "cold", objectSDK.MatchStringEqual)
+	testSelect(t, db, cnr, fs,
+		object.AddressOf(target),
+		object.AddressOf(overlay1),
+		object.AddressOf(overlay2),
+	)
+
+	fs = objectSDK.SearchFilters{}
+	fs.AddFilter(object.AttributeOverlayTarget, targetID.EncodeToString(), objectSDK.MatchStringEqual)
+	testSelect(t, db, cnr, fs,
+		object.AddressOf(overlay1),
+		object.AddressOf(overlay2),
+	)
+
+	overlays, err := db.Overlays([]oid.Address{object.AddressOf(target)})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []oid.Address{object.AddressOf(overlay1), object.AddressOf(overlay2)}, overlays)
+
+	// each overlay is removed from the index separately
+	require.NoError(t, metaDelete(db, object.AddressOf(overlay1)))
+
+	fs = objectSDK.SearchFilters{}
+	fs.AddFilter("tier", "cold", objectSDK.MatchStringEqual)
+	testSelect(t, db, cnr, fs,
+		object.AddressOf(target),
+		object.AddressOf(overlay2),
+	)
+
+	require.NoError(t, metaDelete(db, object.AddressOf(overlay2)))
+	testSelect(t, db, cnr, fs)
+
+	overlays, err = db.Overlays([]oid.Address{object.AddressOf(target)})
+	require.NoError(t, err)
+	require.Empty(t, overlays)
+
+	fs = objectSDK.SearchFilters{}
+	fs.AddFilter("tier", "hot", objectSDK.MatchStringEqual)
+	testSelect(t, db, cnr, fs, object.AddressOf(target))
+
+	// removed overlays do not label the target
+	overlay3 := newOverlay("warm")
+
+	var tombstone oid.Address
+	tombstone.SetContainer(cnr)
+	tombstone.SetObject(oidtest.ID())
+
+	require.NoError(t, metaInhume(db, object.AddressOf(overlay3), tombstone))
+
+	fs = objectSDK.SearchFilters{}
+	fs.AddFilter("tier", "warm", objectSDK.MatchStringEqual)
+	testSelect(t, db, cnr, fs)
+}
+
 func TestDB_SelectPayloadHash(t *testing.T) {
 	db := newDB(t)
 
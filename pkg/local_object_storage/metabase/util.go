@@ -120,6 +120,16 @@ const (
 	// 	Key: container ID
 	// 	Value: dummy value
 	garbageContainersPrefix
+
+	// overlayAttributePrefix is used for prefixing FKBT index buckets
+	// containing objects labeled by the metadata overlays.
+	// Key: attribute value
+	// Value: bucket containing target object IDs followed by overlay IDs as keys
+	overlayAttributePrefix
+	// overlayPrefix is used for prefixing List index buckets mapping target ID to a list of metadata overlay IDs.
+	//  Key: target ID
+	//  Value: list of overlay IDs
+	overlayPrefix
 )
 
 const (
@@ -164,6 +174,13 @@ func attributeBucketName(cnr cid.ID, attributeKey string, key []byte) []byte {
 	return append(key[:bucketKeySize], attributeKey...)
 }
 
+// overlayAttributeBucketName returns <CID>_overlay_attr_<attributeKey>.
+func overlayAttributeBucketName(cnr cid.ID, attributeKey string, key []byte) []byte {
+	key[0] = overlayAttributePrefix
+	cnr.Encode(key[1:])
+	return append(key[:bucketKeySize], attributeKey...)
+}
+
 // returns <CID> from attributeBucketName result, nil otherwise.
 func cidFromAttributeBucket(val []byte, attributeKey string) []byte {
 	if len(val) < bucketKeySize || val[0] != userAttributePrefix || !bytes.Equal(val[bucketKeySize:], []byte(attributeKey)) {
@@ -198,6 +215,11 @@ func splitBucketName(cnr cid.ID, key []byte) []byte {
 	return bucketName(cnr, splitPrefix, key)
 }
 
+// overlayBucketName returns <CID>_overlay.
+func overlayBucketName(cnr cid.ID, key []byte) []byte {
+	return bucketName(cnr, overlayPrefix, key)
+}
+
 // addressKey returns key for K-V tables when key is a whole address.
 func addressKey(addr oid.Address, key []byte) []byte {
 	addr.Container().Encode(key)
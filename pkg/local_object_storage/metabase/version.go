@@ -9,7 +9,7 @@ import (
 )
 
 // version contains current metabase version.
-const version = 2
+const version = 3
 
 var versionKey = []byte("version")
 
@@ -29,7 +29,7 @@ func checkVersion(tx *bbolt.Tx, initialized bool) error {
 
 			stored := binary.LittleEndian.Uint64(data)
 			if stored != version {
-				return fmt.Errorf("%w: expected=%d, stored=%d", ErrOutdatedVersion, version, stored)
+				return migrate(tx, stored)
 			}
 		}
 	}
@@ -49,6 +49,21 @@ func checkVersion(tx *bbolt.Tx, initialized bool) error {
 	return nil
 }
 
+// migrate brings the metabase of the stored version to the current one if
+// the migration is supported, resynchronization is required otherwise.
+func migrate(tx *bbolt.Tx, stored uint64) error {
+	if stored != 2 {
+		return fmt.Errorf("%w: expected=%d, stored=%d", ErrOutdatedVersion, version, stored)
+	}
+
+	err := migrateOverlayIndexes(tx)
+	if err != nil {
+		return fmt.Errorf("migrate from version %d: %w", stored, err)
+	}
+
+	return updateVersion(tx, version)
+}
+
 func updateVersion(tx *bbolt.Tx, version uint64) error {
 	data := make([]byte, 8)
 	binary.LittleEndian.PutUint64(data, version)
@@ -7,6 +7,13 @@ import (
 	"path/filepath"
 	"testing"
 
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	checksumtest "github.com/nspcc-dev/neofs-sdk-go/checksum/test"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/bbolt"
 )
@@ -65,6 +72,62 @@ func TestVersion(t *testing.T) {
 		check(t, db)
 		require.NoError(t, db.Close())
 	})
+	t.Run("migration from 2", func(t *testing.T) {
+		cnr := cidtest.ID()
+		target := oidtest.ID()
+		owner := usertest.ID(t)
+
+		overlay := objectSDK.New()
+		overlay.SetContainerID(cnr)
+		overlay.SetID(oidtest.ID())
+		overlay.SetOwnerID(&owner)
+		overlay.SetPayloadChecksum(checksumtest.Checksum())
+		overlay.SetAttributes(
+			newAttribute(objectcore.AttributeOverlayTarget, target.EncodeToString()),
+			newAttribute("tier", "cold"),
+		)
+
+		db := newDB(t)
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+
+		var prm PutPrm
+		prm.SetObject(overlay)
+		_, err := db.Put(prm)
+		require.NoError(t, err)
+
+		// overlays are not indexed by version 2
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			require.NoError(t, tx.DeleteBucket(overlayBucketName(cnr, make([]byte, bucketKeySize))))
+			require.NoError(t, tx.DeleteBucket(overlayAttributeBucketName(cnr, "tier", make([]byte, bucketKeySize))))
+			return updateVersion(tx, 2)
+		}))
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+		check(t, db)
+
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(target)
+
+		overlays, err := db.Overlays([]oid.Address{addr})
+		require.NoError(t, err)
+		require.Equal(t, []oid.Address{objectcore.AddressOf(overlay)}, overlays)
+
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("tier", "cold", objectSDK.MatchStringEqual)
+
+		var selectPrm SelectPrm
+		selectPrm.SetContainerID(cnr)
+		selectPrm.SetFilters(fs)
+
+		res, err := db.Select(selectPrm)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []oid.Address{addr, objectcore.AddressOf(overlay)}, res.AddressList())
+		require.NoError(t, db.Close())
+	})
 	t.Run("invalid version", func(t *testing.T) {
 		db := newDB(t)
 		require.NoError(t, db.Open(false))
@@ -85,3 +148,11 @@ func TestVersion(t *testing.T) {
 		})
 	})
 }
+
+func newAttribute(k, v string) objectSDK.Attribute {
+	var a objectSDK.Attribute
+	a.SetKey(k)
+	a.SetValue(v)
+
+	return a
+}
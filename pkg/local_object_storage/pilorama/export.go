@@ -0,0 +1,215 @@
+package pilorama
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	nio "github.com/nspcc-dev/neo-go/pkg/io"
+	cidSDK "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// Tree export stream format.
+//
+// The stream starts with the header:
+//
+//	magic (8 bytes) | version (1 byte) | container ID (32 bytes) | tree ID (var string)
+//
+// followed by the operations in the ascending order of their timestamps.
+// Each operation is prefixed with a non-zero marker byte:
+//
+//	marker (1 byte) | parent (uint64 LE) | child (uint64 LE) | meta (see Meta.EncodeBinary)
+//
+// The stream is terminated with a zero marker byte, so truncated streams
+// can be distinguished from the complete ones.
+const (
+	// ExportVersion is the current version of the tree export stream format.
+	ExportVersion = 1
+
+	exportOpMarker  = 1
+	exportEndMarker = 0
+)
+
+var exportMagic = []byte("NEOFSTRE")
+
+var (
+	// ErrInvalidExportStream is returned when the tree export stream is malformed.
+	ErrInvalidExportStream = errors.New("invalid tree export stream")
+	// ErrUnsupportedExportVersion is returned when the tree export stream
+	// has the version this node does not support.
+	ErrUnsupportedExportVersion = errors.New("unsupported tree export stream version")
+)
+
+// ExportHeader describes the tree stored in the export stream.
+type ExportHeader struct {
+	Version   byte
+	Container cidSDK.ID
+	TreeID    string
+}
+
+// ExportWriter writes tree operations to the export stream.
+type ExportWriter struct {
+	w *nio.BinWriter
+}
+
+// NewExportWriter writes header of the export stream for the given tree
+// and returns the writer for the tree operations. Close must be called after
+// all operations are written.
+func NewExportWriter(w io.Writer, cnr cidSDK.ID, treeID string) (*ExportWriter, error) {
+	bw := nio.NewBinWriterFromIO(w)
+	bw.WriteBytes(exportMagic)
+	bw.WriteB(ExportVersion)
+	bw.WriteBytes(cnr[:])
+	bw.WriteString(treeID)
+	if bw.Err != nil {
+		return nil, fmt.Errorf("write header: %w", bw.Err)
+	}
+
+	return &ExportWriter{w: bw}, nil
+}
+
+// WriteOp writes a single log operation to the stream.
+func (x *ExportWriter) WriteOp(m *Move) error {
+	x.w.WriteB(exportOpMarker)
+	x.w.WriteU64LE(m.Parent)
+	x.w.WriteU64LE(m.Child)
+	m.Meta.EncodeBinary(x.w)
+	return x.w.Err
+}
+
+// Close terminates the stream. It does not close the underlying writer.
+func (x *ExportWriter) Close() error {
+	x.w.WriteB(exportEndMarker)
+	return x.w.Err
+}
+
+// ExportReader reads tree operations from the export stream.
+type ExportReader struct {
+	r      *nio.BinReader
+	header ExportHeader
+	done   bool
+}
+
+// NewExportReader reads and checks header of the export stream and returns
+// the reader for the tree operations.
+func NewExportReader(r io.Reader) (*ExportReader, error) {
+	br := nio.NewBinReaderFromIO(r)
+
+	magic := make([]byte, len(exportMagic))
+	br.ReadBytes(magic)
+	if br.Err != nil {
+		return nil, fmt.Errorf("read header: %w", br.Err)
+	}
+	if !bytes.Equal(magic, exportMagic) {
+		return nil, fmt.Errorf("%w: wrong magic", ErrInvalidExportStream)
+	}
+
+	var res ExportReader
+	res.r = br
+	res.header.Version = br.ReadB()
+	if br.Err == nil && res.header.Version != ExportVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedExportVersion, res.header.Version)
+	}
+
+	br.ReadBytes(res.header.Container[:])
+	res.header.TreeID = br.ReadString()
+	if br.Err != nil {
+		return nil, fmt.Errorf("read header: %w", br.Err)
+	}
+
+	return &res, nil
+}
+
+// Header returns header of the export stream.
+func (x *ExportReader) Header() ExportHeader {
+	return x.header
+}
+
+// ReadOp reads the next log operation from the stream. Returns io.EOF
+// after the last operation.
+func (x *ExportReader) ReadOp(m *Move) error {
+	if x.done {
+		return io.EOF
+	}
+
+	switch marker := x.r.ReadB(); {
+	case x.r.Err != nil:
+		// do not wrap the reader error, it may be io.EOF for truncated streams
+		return fmt.Errorf("%w: read operation marker: %v", ErrInvalidExportStream, x.r.Err)
+	case marker == exportEndMarker:
+		x.done = true
+		return io.EOF
+	case marker != exportOpMarker:
+		return fmt.Errorf("%w: unknown operation marker %d", ErrInvalidExportStream, marker)
+	}
+
+	m.Parent = x.r.ReadU64LE()
+	m.Child = x.r.ReadU64LE()
+	m.Meta.DecodeBinary(x.r)
+	if x.r.Err != nil {
+		return fmt.Errorf("%w: read operation: %v", ErrInvalidExportStream, x.r.Err)
+	}
+
+	return nil
+}
+
+// ExportTree writes the whole operation log of the tree to w. Returns the
+// number of written operations.
+func ExportTree(f Forest, cnr cidSDK.ID, treeID string, w io.Writer) (int, error) {
+	ew, err := NewExportWriter(w, cnr, treeID)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		n      int
+		height uint64
+	)
+	for {
+		lm, err := f.TreeGetOpLog(cnr, treeID, height)
+		if err != nil {
+			return n, fmt.Errorf("get operation at height %d: %w", height, err)
+		}
+		if lm.Time == 0 {
+			break
+		}
+
+		if err := ew.WriteOp(&lm); err != nil {
+			return n, fmt.Errorf("write operation: %w", err)
+		}
+
+		n++
+		height = lm.Time + 1
+	}
+
+	return n, ew.Close()
+}
+
+// ImportTree replays all operations from the export stream through
+// Forest.TreeApply. Operations are applied to the tree with the given ID in
+// the container from the descriptor, so they may differ from the ones stored
+// in the stream header. Returns the number of applied operations.
+func ImportTree(f Forest, d CIDDescriptor, treeID string, r io.Reader) (int, error) {
+	er, err := NewExportReader(r)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	for {
+		var m Move
+
+		err := er.ReadOp(&m)
+		if errors.Is(err, io.EOF) {
+			return n, nil
+		} else if err != nil {
+			return n, err
+		}
+
+		if err := f.TreeApply(d, treeID, &m, false); err != nil {
+			return n, fmt.Errorf("apply operation with timestamp %d: %w", m.Time, err)
+		}
+		n++
+	}
+}
@@ -0,0 +1,99 @@
+package pilorama
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportTree(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testExportImportTree(t, providers[i].construct)
+		})
+	}
+}
+
+func testExportImportTree(t *testing.T, constructor func(t testing.TB, _ ...Option) Forest) {
+	cnr := cidtest.ID()
+	d := CIDDescriptor{cnr, 0, 1}
+	treeID := "version"
+
+	src := constructor(t)
+	for i := 0; i < 10; i++ {
+		_, err := src.TreeMove(d, treeID, &Move{
+			Parent: RootID,
+			Child:  RootID,
+			Meta:   Meta{Items: []KeyValue{{Key: AttributeFilename, Value: []byte{byte(i)}}, {Key: "k", Value: []byte("v")}}},
+		})
+		require.NoError(t, err)
+	}
+
+	var buf bytes.Buffer
+	n, err := ExportTree(src, cnr, treeID, &buf)
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+
+	er, err := NewExportReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, ExportHeader{Version: ExportVersion, Container: cnr, TreeID: treeID}, er.Header())
+
+	t.Run("another container", func(t *testing.T) {
+		dst := constructor(t)
+		dstCnr := cidtest.ID()
+
+		n, err := ImportTree(dst, CIDDescriptor{dstCnr, 0, 1}, "other", bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, 10, n)
+
+		for h := uint64(0); ; {
+			expected, err := src.TreeGetOpLog(cnr, treeID, h)
+			require.NoError(t, err)
+
+			actual, err := dst.TreeGetOpLog(dstCnr, "other", h)
+			require.NoError(t, err)
+			require.Equal(t, expected, actual)
+
+			if expected.Time == 0 {
+				break
+			}
+			h = expected.Time + 1
+		}
+
+		nodes, err := dst.TreeGetByPath(dstCnr, "other", AttributeFilename, []string{"\x05"}, false)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+	})
+	t.Run("truncated stream", func(t *testing.T) {
+		_, err := ImportTree(constructor(t), d, treeID, bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+		require.ErrorIs(t, err, ErrInvalidExportStream)
+	})
+	t.Run("wrong magic", func(t *testing.T) {
+		_, err := NewExportReader(bytes.NewReader([]byte("NEOFSTRX")))
+		require.ErrorIs(t, err, ErrInvalidExportStream)
+	})
+	t.Run("unsupported version", func(t *testing.T) {
+		raw := append([]byte(nil), buf.Bytes()...)
+		raw[len(exportMagic)] = ExportVersion + 1
+
+		_, err := NewExportReader(bytes.NewReader(raw))
+		require.ErrorIs(t, err, ErrUnsupportedExportVersion)
+	})
+	t.Run("empty tree", func(t *testing.T) {
+		var buf bytes.Buffer
+		w, err := NewExportWriter(&buf, cnr, treeID)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		r, err := NewExportReader(&buf)
+		require.NoError(t, err)
+
+		var m Move
+		require.True(t, errors.Is(r.ReadOp(&m), io.EOF))
+		require.True(t, errors.Is(r.ReadOp(&m), io.EOF))
+	})
+}
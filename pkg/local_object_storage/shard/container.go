@@ -63,5 +63,7 @@ func (s *Shard) DeleteContainer(_ context.Context, cID cid.ID) error {
 
 	s.decObjectCounterBy(logical, inhumedAvailable)
 
+	s.inhumedObjectsCallback(cID, nil)
+
 	return nil
 }
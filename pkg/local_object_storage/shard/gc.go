@@ -263,6 +263,8 @@ func (s *Shard) collectExpiredObjects(ctx context.Context, e Event) {
 	}
 
 	s.decObjectCounterBy(logical, res.AvailableInhumed())
+
+	s.notifyInhumed(expired)
 }
 
 func (s *Shard) collectExpiredTombstones(ctx context.Context, e Event) {
@@ -453,6 +455,8 @@ func (s *Shard) HandleExpiredLocks(lockers []oid.Address) {
 	}
 
 	s.decObjectCounterBy(logical, res.AvailableInhumed())
+
+	s.notifyInhumed(expired)
 }
 
 // HandleDeletedLocks unlocks all objects which were locked by lockers.
@@ -496,6 +500,8 @@ func (s *Shard) HandleDeletedLocks(lockers []oid.Address) {
 	}
 
 	s.decObjectCounterBy(logical, res.AvailableInhumed())
+
+	s.notifyInhumed(expired)
 }
 
 // NotificationChannel returns channel for shard events.
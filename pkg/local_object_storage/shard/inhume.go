@@ -112,6 +112,8 @@ func (s *Shard) Inhume(prm InhumePrm) (InhumeRes, error) {
 
 	s.decObjectCounterBy(logical, res.AvailableInhumed())
 
+	s.notifyInhumed(prm.target)
+
 	if deletedLockObjs := res.DeletedLockObjects(); len(deletedLockObjs) != 0 {
 		s.deletedLockCallBack(context.Background(), deletedLockObjs)
 	}
@@ -119,6 +121,25 @@ func (s *Shard) Inhume(prm InhumePrm) (InhumeRes, error) {
 	return InhumeRes{}, nil
 }
 
+// notifyInhumed passes the objects marked as removed to the callback grouped
+// by containers.
+func (s *Shard) notifyInhumed(addrs []oid.Address) {
+	if len(addrs) == 0 {
+		return
+	}
+
+	byCnr := make(map[cid.ID][]oid.ID)
+
+	for i := range addrs {
+		cnr := addrs[i].Container()
+		byCnr[cnr] = append(byCnr[cnr], addrs[i].Object())
+	}
+
+	for cnr, ids := range byCnr {
+		s.inhumedObjectsCallback(cnr, ids)
+	}
+}
+
 // InhumeContainer marks every object in a container as removed.
 // Any further [StorageEngine.Get] calls will return [apistatus.ObjectNotFound]
 // errors.
@@ -143,5 +164,7 @@ func (s *Shard) InhumeContainer(cID cid.ID) error {
 
 	s.decObjectCounterBy(logical, removedObjects)
 
+	s.inhumedObjectsCallback(cID, nil)
+
 	return nil
 }
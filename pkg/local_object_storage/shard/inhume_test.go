@@ -6,7 +6,9 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,3 +54,32 @@ func testShardInhume(t *testing.T, hasWriteCache bool) {
 	_, err = sh.Get(getPrm)
 	require.ErrorAs(t, err, new(apistatus.ObjectAlreadyRemoved))
 }
+
+func TestShard_InhumedObjectsCallback(t *testing.T) {
+	inhumed := make(map[cid.ID][]oid.ID)
+
+	sh := newCustomShard(t, t.TempDir(), false, nil, nil,
+		shard.WithInhumedObjectsCallback(func(cnr cid.ID, ids []oid.ID) {
+			inhumed[cnr] = append(inhumed[cnr], ids...)
+		}))
+	defer releaseShard(sh, t)
+
+	cnr := cidtest.ID()
+
+	obj := generateObjectWithCID(t, cnr)
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := sh.Put(putPrm)
+	require.NoError(t, err)
+
+	var inhPrm shard.InhumePrm
+	inhPrm.MarkAsGarbage(object.AddressOf(obj))
+
+	_, err = sh.Inhume(inhPrm)
+	require.NoError(t, err)
+
+	id, _ := obj.ID()
+	require.Equal(t, map[cid.ID][]oid.ID{cnr: {id}}, inhumed)
+}
@@ -0,0 +1,26 @@
+package shard
+
+import (
+	"fmt"
+
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Overlays returns addresses of the metadata overlays of the objects with the
+// given addresses stored in the shard. Requires healthy metabase, returns
+// ErrDegradedMode otherwise.
+func (s *Shard) Overlays(addrs []oid.Address) ([]oid.Address, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	res, err := s.metaBase.Overlays(addrs)
+	if err != nil {
+		return nil, fmt.Errorf("metabase overlays: %w", err)
+	}
+
+	return res, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
@@ -44,6 +45,10 @@ type ExpiredObjectsCallback func(context.Context, []oid.Address)
 // DeletedLockCallback is a callback handling list of deleted LOCK objects.
 type DeletedLockCallback func(context.Context, []oid.Address)
 
+// InhumedObjectsCallback is a callback handling objects of the container
+// marked as removed. Nil list means all objects of the container.
+type InhumedObjectsCallback func(cid.ID, []oid.ID)
+
 // MetricsWriter is an interface that must store shard's metrics.
 type MetricsWriter interface {
 	// SetObjectCounter must set object counter taking into account object type.
@@ -100,6 +105,8 @@ type cfg struct {
 
 	deletedLockCallBack DeletedLockCallback
 
+	inhumedObjectsCallback InhumedObjectsCallback
+
 	tsSource TombstoneSource
 
 	metricsWriter MetricsWriter
@@ -113,6 +120,8 @@ func defaultCfg() *cfg {
 		log:             zap.L(),
 		gcCfg:           defaultGCCfg(),
 		reportErrorFunc: func(string, string, error) {},
+
+		inhumedObjectsCallback: func(cid.ID, []oid.ID) {},
 	}
 }
 
@@ -288,6 +297,15 @@ func WithDeletedLockCallback(v DeletedLockCallback) Option {
 	}
 }
 
+// WithInhumedObjectsCallback returns option to specify callback of the
+// objects marked as removed by any reason: tombstones, expiration, unlocking
+// and container removal.
+func WithInhumedObjectsCallback(v InhumedObjectsCallback) Option {
+	return func(c *cfg) {
+		c.inhumedObjectsCallback = v
+	}
+}
+
 // WithMetricsWriter returns option to specify storage of the
 // shard's metrics.
 func WithMetricsWriter(v MetricsWriter) Option {
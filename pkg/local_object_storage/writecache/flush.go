@@ -114,8 +114,13 @@ func (c *cache) flushDB() {
 				continue
 			}
 
+			data, err := c.decryptDB(m[i].addr, m[i].data)
+			if err != nil {
+				continue
+			}
+
 			obj := object.New()
-			if err := obj.Unmarshal(m[i].data); err != nil {
+			if err := obj.Unmarshal(data); err != nil {
 				continue
 			}
 
@@ -310,6 +315,15 @@ func (c *cache) flush(ignoreErrors bool) error {
 				return err
 			}
 
+			data, err := c.encryption.Decrypt(addr, data)
+			if err != nil {
+				c.reportFlushError("can't decrypt an object from the DB", sa, err)
+				if ignoreErrors {
+					continue
+				}
+				return err
+			}
+
 			var obj object.Object
 			if err := obj.Unmarshal(data); err != nil {
 				c.reportFlushError("can't unmarshal an object from the DB", sa, err)
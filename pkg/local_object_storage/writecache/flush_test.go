@@ -1,6 +1,7 @@
 package writecache
 
 import (
+	"crypto/rand"
 	"os"
 	"path/filepath"
 	"sync/atomic"
@@ -9,6 +10,7 @@ import (
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
@@ -123,6 +125,42 @@ func TestFlush(t *testing.T) {
 		check(t, mb, bs, objects[2:])
 	})
 
+	t.Run("encrypted", func(t *testing.T) {
+		key := make([]byte, encryption.KeySize)
+		_, _ = rand.Read(key)
+		cph, err := encryption.NewCipher(key)
+		require.NoError(t, err)
+
+		wc, bs, mb := newCache(t, WithCipher(cph))
+		objects := putObjects(t, wc)
+
+		for i := range objects {
+			raw, err := objects[i].obj.Marshal()
+			require.NoError(t, err)
+
+			var stored []byte
+			if v, err := Get(wc.(*cache).db, []byte(objects[i].addr.EncodeToString())); err == nil {
+				stored = v
+			} else {
+				p := objects[i].addr.Object().EncodeToString() + "." + objects[i].addr.Container().EncodeToString()
+				stored, err = os.ReadFile(filepath.Join(wc.(*cache).fsTree.RootPath, p[:1], p[1:]))
+				require.NoError(t, err)
+			}
+			require.True(t, encryption.IsEncrypted(stored))
+			require.NotContains(t, string(stored), string(raw))
+
+			res, err := wc.Get(objects[i].addr)
+			require.NoError(t, err)
+			require.Equal(t, objects[i].obj, res)
+		}
+
+		require.NoError(t, bs.SetMode(mode.ReadWrite))
+		require.NoError(t, mb.SetMode(mode.ReadWrite))
+		require.NoError(t, wc.Flush(false))
+
+		check(t, mb, bs, objects)
+	})
+
 	t.Run("flush on moving to degraded mode", func(t *testing.T) {
 		wc, bs, mb := newCache(t)
 		objects := putObjects(t, wc)
@@ -1,6 +1,8 @@
 package writecache
 
 import (
+	"fmt"
+
 	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
@@ -18,6 +20,11 @@ func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
 
 	value, err := Get(c.db, []byte(saddr))
 	if err == nil {
+		value, err = c.encryption.Decrypt(addr, value)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt object from the database: %w", err)
+		}
+
 		obj := objectSDK.New()
 		c.flushed.Get(saddr)
 		return obj, obj.Unmarshal(value)
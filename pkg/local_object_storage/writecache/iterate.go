@@ -45,6 +45,15 @@ func (c *cache) Iterate(prm IterationPrm) error {
 			if _, ok := c.flushed.Peek(string(k)); ok {
 				return nil
 			}
+
+			data, err := c.decryptDB(string(k), data)
+			if err != nil {
+				if prm.ignoreErrors {
+					return nil
+				}
+				return err
+			}
+
 			return prm.handler(data)
 		})
 	})
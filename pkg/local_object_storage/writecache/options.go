@@ -5,6 +5,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/encryption"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
@@ -54,6 +55,8 @@ type options struct {
 	noSync bool
 	// reportError is the function called when encountering disk errors in background workers.
 	reportError func(string, error)
+	// cipher encrypts objects stored in the database and FSTree, nil disables encryption.
+	cipher *encryption.Cipher
 }
 
 // WithLogger sets logger.
@@ -151,3 +154,11 @@ func WithReportErrorFunc(f func(string, error)) Option {
 		o.reportError = f
 	}
 }
+
+// WithCipher sets cipher encrypting objects stored in the write-cache. Objects
+// written before the encryption was enabled remain readable.
+func WithCipher(c *encryption.Cipher) Option {
+	return func(o *options) {
+		o.cipher = c
+	}
+}
@@ -35,6 +35,7 @@ func (c *cache) Put(prm common.PutPrm) (common.PutRes, error) {
 	}
 
 	if sz <= c.smallObjectSize {
+		oi.data = c.encryption.Encrypt(prm.Address, oi.data)
 		return common.PutRes{}, c.putSmall(oi)
 	}
 	return common.PutRes{}, c.putBig(oi.addr, prm)
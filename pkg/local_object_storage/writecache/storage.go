@@ -8,6 +8,7 @@ import (
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/hashicorp/golang-lru/v2/simplelru"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
@@ -61,12 +62,21 @@ func (c *cache) openStore(readOnly bool) error {
 		}
 	}
 
+	if c.encryption == nil {
+		cfg := &compression.Config{Cipher: c.cipher}
+		if err := cfg.Init(); err != nil {
+			return fmt.Errorf("could not init encryption: %w", err)
+		}
+		c.encryption = cfg
+	}
+
 	c.fsTree = fstree.New(
 		fstree.WithPath(c.path),
 		fstree.WithPerm(os.ModePerm),
 		fstree.WithDepth(1),
 		fstree.WithDirNameLen(1),
 		fstree.WithNoSync(c.noSync))
+	c.fsTree.SetCompressor(c.encryption)
 	if err := c.fsTree.Open(readOnly); err != nil {
 		return fmt.Errorf("could not open FSTree: %w", err)
 	}
@@ -161,3 +171,18 @@ func (c *cache) deleteFromDisk(keys []string) []string {
 
 	return keys[:copyIndex]
 }
+
+// decryptDB decrypts the object stored in the database by the given key.
+func (c *cache) decryptDB(key string, data []byte) ([]byte, error) {
+	var addr oid.Address
+	if err := addr.DecodeString(key); err != nil {
+		return nil, fmt.Errorf("decode object address %q: %w", key, err)
+	}
+
+	data, err := c.encryption.Decrypt(addr, data)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt object %q: %w", key, err)
+	}
+
+	return data, nil
+}
@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -65,6 +66,8 @@ type cache struct {
 	store
 	// fsTree contains big files stored directly on file-system.
 	fsTree *fstree.FSTree
+	// encryption encrypts objects stored in the database and fsTree.
+	encryption *compression.Config
 }
 
 // wcStorageType is used for write-cache operations logging.
@@ -173,5 +176,9 @@ func (c *cache) Close() error {
 			c.db = nil
 		}
 	}
+	if c.encryption != nil {
+		_ = c.encryption.Close()
+		c.encryption = nil
+	}
 	return nil
 }
@@ -10,6 +10,7 @@ type NodeMetrics struct {
 	objectServiceMetrics
 	engineMetrics
 	stateMetrics
+	replicatorMetrics
 	epoch prometheus.Gauge
 }
 
@@ -25,6 +26,9 @@ func NewNodeMetrics(version string) *NodeMetrics {
 	state := newStateMetrics()
 	state.register()
 
+	replicator := newReplicatorMetrics()
+	replicator.register()
+
 	epoch := prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: storageNodeNameSpace,
 		Subsystem: stateSubsystem,
@@ -37,6 +41,7 @@ func NewNodeMetrics(version string) *NodeMetrics {
 		objectServiceMetrics: objectService,
 		engineMetrics:        engine,
 		stateMetrics:         state,
+		replicatorMetrics:    replicator,
 		epoch:                epoch,
 	}
 }
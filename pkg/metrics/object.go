@@ -35,6 +35,12 @@ type (
 		putPayload prometheus.Counter
 		getPayload prometheus.Counter
 
+		cacheHits   prometheus.Counter
+		cacheMisses prometheus.Counter
+		cacheSize   prometheus.Gauge
+
+		eaclCheckDuration *prometheus.HistogramVec
+
 		shardMetrics   *prometheus.GaugeVec
 		shardsReadonly *prometheus.GaugeVec
 
@@ -52,6 +58,7 @@ const (
 	shardIDLabelKey     = "shard"
 	counterTypeLabelKey = "type"
 	containerIDLabelKey = "cid"
+	decisionLabelKey    = "decision"
 )
 
 func newMethodCallCounter(name string) methodCount {
@@ -211,6 +218,36 @@ func newObjectServiceMetrics() objectServiceMetrics {
 			Help:      "Accumulated payload size at object get method",
 		})
 
+		cacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: objectSubsystem,
+			Name:      "cache_hits",
+			Help:      "Number of object requests served from the read cache",
+		})
+
+		cacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: objectSubsystem,
+			Name:      "cache_misses",
+			Help:      "Number of object requests not found in the read cache",
+		})
+
+		cacheSize = prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: objectSubsystem,
+			Name:      "cache_size",
+			Help:      "Total payload size of the objects in the read cache",
+		})
+
+		eaclCheckDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: objectSubsystem,
+			Name:      "eacl_check_time",
+			Help:      "Extended ACL check time of the object requests",
+		},
+			[]string{decisionLabelKey},
+		)
+
 		shardsMetrics = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: storageNodeNameSpace,
 			Subsystem: objectSubsystem,
@@ -247,6 +284,10 @@ func newObjectServiceMetrics() objectServiceMetrics {
 		rangeHashDuration: rangeHashDuration,
 		putPayload:        putPayload,
 		getPayload:        getPayload,
+		cacheHits:         cacheHits,
+		cacheMisses:       cacheMisses,
+		cacheSize:         cacheSize,
+		eaclCheckDuration: eaclCheckDuration,
 		shardMetrics:      shardsMetrics,
 		shardsReadonly:    shardsReadonly,
 
@@ -280,6 +321,12 @@ func (m objectServiceMetrics) register() {
 	prometheus.MustRegister(m.putPayload)
 	prometheus.MustRegister(m.getPayload)
 
+	prometheus.MustRegister(m.cacheHits)
+	prometheus.MustRegister(m.cacheMisses)
+	prometheus.MustRegister(m.cacheSize)
+
+	prometheus.MustRegister(m.eaclCheckDuration)
+
 	prometheus.MustRegister(m.shardMetrics)
 	prometheus.MustRegister(m.shardsReadonly)
 
@@ -363,6 +410,27 @@ func (m objectServiceMetrics) AddGetPayload(ln int) {
 	m.getPayload.Add(float64(ln))
 }
 
+func (m objectServiceMetrics) AddObjectCacheRequest(hit bool) {
+	if hit {
+		m.cacheHits.Inc()
+	} else {
+		m.cacheMisses.Inc()
+	}
+}
+
+func (m objectServiceMetrics) SetObjectCacheSize(size uint64) {
+	m.cacheSize.Set(float64(size))
+}
+
+func (m objectServiceMetrics) AddEACLCheckDuration(d time.Duration, allowed bool) {
+	decision := "deny"
+	if allowed {
+		decision = "allow"
+	}
+
+	m.eaclCheckDuration.With(prometheus.Labels{decisionLabelKey: decision}).Observe(d.Seconds())
+}
+
 func (m objectServiceMetrics) AddToObjectCounter(shardID, objectType string, delta int) {
 	m.shardMetrics.With(
 		prometheus.Labels{
@@ -0,0 +1,81 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const replicatorSubsystem = "replicator"
+
+type replicatorMetrics struct {
+	pushedPayload prometheus.Counter
+	pulledPayload prometheus.Counter
+	pushCounter   methodCount
+	pullCounter   methodCount
+	tasksInQueue  prometheus.Gauge
+}
+
+func newReplicatorMethodCounter(name string) methodCount {
+	return methodCount{
+		success: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: replicatorSubsystem,
+			Name:      name + "_count_success",
+			Help:      "The number of successful object " + name + " operations",
+		}),
+		total: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: replicatorSubsystem,
+			Name:      name + "_count",
+			Help:      "Total number of object " + name + " operations",
+		}),
+	}
+}
+
+func newReplicatorMetrics() replicatorMetrics {
+	return replicatorMetrics{
+		pushedPayload: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: replicatorSubsystem,
+			Name:      "push_payload",
+			Help:      "Accumulated payload size of the objects replicated to other nodes",
+		}),
+		pulledPayload: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: replicatorSubsystem,
+			Name:      "pull_payload",
+			Help:      "Accumulated payload size of the objects pulled by other nodes from the holders on request",
+		}),
+		pushCounter: newReplicatorMethodCounter("push"),
+		pullCounter: newReplicatorMethodCounter("pull"),
+		tasksInQueue: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: storageNodeNameSpace,
+			Subsystem: replicatorSubsystem,
+			Name:      "tasks_in_progress",
+			Help:      "Number of replication tasks being processed or waiting for the bandwidth",
+		}),
+	}
+}
+
+func (m replicatorMetrics) register() {
+	prometheus.MustRegister(m.pushedPayload)
+	prometheus.MustRegister(m.pulledPayload)
+	m.pushCounter.mustRegister()
+	m.pullCounter.mustRegister()
+	prometheus.MustRegister(m.tasksInQueue)
+}
+
+func (m replicatorMetrics) AddReplicationPush(success bool, size int) {
+	m.pushCounter.Inc(success)
+	if success {
+		m.pushedPayload.Add(float64(size))
+	}
+}
+
+func (m replicatorMetrics) AddReplicationPull(success bool, size int) {
+	m.pullCounter.Inc(success)
+	if success {
+		m.pulledPayload.Add(float64(size))
+	}
+}
+
+func (m replicatorMetrics) AddToReplicationTasks(delta int) {
+	m.tasksInQueue.Add(float64(delta))
+}
@@ -62,6 +62,8 @@ type Client struct {
 	acc     *wallet.Account // neo account
 	accAddr util.Uint160    // account's address
 
+	placeholder *keys.PrivateKey // placeholder key of the accounts signed by the signer, see WithSigner
+
 	notary *notaryInfo
 
 	cfg cfg
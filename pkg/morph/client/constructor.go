@@ -17,6 +17,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
 	"github.com/nspcc-dev/neo-go/pkg/util"
 	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	"go.uber.org/zap"
 )
 
@@ -40,6 +41,8 @@ type cfg struct {
 	autoSidechainScope bool
 	signer             *transaction.Signer
 
+	accSigner signer.Signer // signer of the account, nil if the key is used
+
 	endpoints []string
 
 	singleCli *rpcclient.WSClient // neo-go client for single client mode
@@ -81,7 +84,8 @@ var ErrStaleNodes = errors.New("RPC nodes are not yet up to date")
 // Notary support should be enabled with EnableNotarySupport client
 // method separately.
 //
-// If private key is nil, it panics.
+// If private key is nil and the signer is not provided via WithSigner, it
+// panics.
 //
 // Other values are set according to provided options, or by default:
 //   - client context: Background;
@@ -95,13 +99,6 @@ var ErrStaleNodes = errors.New("RPC nodes are not yet up to date")
 // If multiple options of the same config value are supplied,
 // the option with the highest index in the arguments will be used.
 func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
-	if key == nil {
-		panic("empty private key")
-	}
-
-	acc := wallet.NewAccountFromPrivateKey(key)
-	accAddr := key.GetScriptHash()
-
 	// build default configuration
 	cfg := defaultConfig()
 
@@ -110,14 +107,39 @@ func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
 		opt(cfg)
 	}
 
+	var (
+		acc         *wallet.Account
+		placeholder *keys.PrivateKey
+		err         error
+	)
+
+	if cfg.accSigner != nil {
+		placeholder, err = keys.NewPrivateKey()
+		if err != nil {
+			return nil, fmt.Errorf("generate placeholder key: %w", err)
+		}
+
+		acc, err = newSignerAccount(placeholder, cfg.accSigner.PublicKey().GetVerificationScript(), 1)
+		if err != nil {
+			return nil, fmt.Errorf("create signer account: %w", err)
+		}
+	} else {
+		if key == nil {
+			panic("empty private key")
+		}
+
+		acc = wallet.NewAccountFromPrivateKey(key)
+	}
+
 	cli := &Client{
-		cache:      newClientCache(),
-		logger:     cfg.logger,
-		acc:        acc,
-		accAddr:    accAddr,
-		cfg:        *cfg,
-		switchLock: &sync.RWMutex{},
-		closeChan:  make(chan struct{}),
+		cache:       newClientCache(),
+		logger:      cfg.logger,
+		acc:         acc,
+		accAddr:     acc.ScriptHash(),
+		placeholder: placeholder,
+		cfg:         *cfg,
+		switchLock:  &sync.RWMutex{},
+		closeChan:   make(chan struct{}),
 		subs: subscriptions{
 			notifyChan:             make(chan *state.ContainedNotificationEvent),
 			blockChan:              make(chan *block.Block),
@@ -132,7 +154,6 @@ func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
 		},
 	}
 
-	var err error
 	var act *actor.Actor
 	if cfg.singleCli != nil {
 		// return client in single RPC node mode that uses
@@ -150,7 +171,7 @@ func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
 				return nil, fmt.Errorf("scope setup: %w", err)
 			}
 		}
-		act, err = newActor(cfg.singleCli, acc, *cfg)
+		act, err = cli.newActor(cfg.singleCli)
 		if err != nil {
 			return nil, fmt.Errorf("could not create RPC actor: %w", err)
 		}
@@ -221,7 +242,7 @@ func (c *Client) newCli(endpoint string) (*rpcclient.WSClient, *actor.Actor, err
 		}
 	}
 
-	act, err := newActor(cli, c.acc, c.cfg)
+	act, err := c.newActor(cli)
 	if err != nil {
 		return nil, nil, fmt.Errorf("RPC actor creation: %w", err)
 	}
@@ -229,16 +250,21 @@ func (c *Client) newCli(endpoint string) (*rpcclient.WSClient, *actor.Actor, err
 	return cli, act, nil
 }
 
-func newActor(ws *rpcclient.WSClient, acc *wallet.Account, cfg cfg) (*actor.Actor, error) {
-	return actor.New(ws, []actor.SignerAccount{{
+func (c *Client) newActor(ws *rpcclient.WSClient) (*actor.Actor, error) {
+	rpc, err := c.actorRPC(ws)
+	if err != nil {
+		return nil, err
+	}
+
+	return actor.New(rpc, []actor.SignerAccount{{
 		Signer: transaction.Signer{
-			Account:          acc.ScriptHash(),
-			Scopes:           cfg.signer.Scopes,
-			AllowedContracts: cfg.signer.AllowedContracts,
-			AllowedGroups:    cfg.signer.AllowedGroups,
-			Rules:            cfg.signer.Rules,
+			Account:          c.acc.ScriptHash(),
+			Scopes:           c.cfg.signer.Scopes,
+			AllowedContracts: c.cfg.signer.AllowedContracts,
+			AllowedGroups:    c.cfg.signer.AllowedGroups,
+			Rules:            c.cfg.signer.Rules,
 		},
-		Account: acc,
+		Account: c.acc,
 	}})
 }
 
@@ -373,6 +399,16 @@ func WithAutoSidechainScope() Option {
 	}
 }
 
+// WithSigner returns a client constructor option that makes Client sign
+// transactions and notary requests through the given signer instead of the
+// private key passed to New, so the private key may be nil. The signer MUST
+// support neofscrypto.ECDSA_DETERMINISTIC_SHA256 scheme.
+func WithSigner(s signer.Signer) Option {
+	return func(c *cfg) {
+		c.accSigner = s
+	}
+}
+
 // reachedHeight checks if [Client] has least expected block height and
 // returns error if it is not reached that height.
 // This function is required to avoid connections to unsynced RPC nodes, because
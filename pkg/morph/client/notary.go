@@ -465,7 +465,12 @@ func (c *Client) NotarySignAndInvokeTX(mainTx *transaction.Transaction) error {
 		})
 	}
 
-	nAct, err := notary.NewActor(c.client, s, c.acc)
+	rpc, err := c.actorRPC(c.client)
+	if err != nil {
+		return err
+	}
+
+	nAct, err := notary.NewActor(rpc, s, c.acc)
 	if err != nil {
 		return err
 	}
@@ -525,7 +530,12 @@ func (c *Client) notaryInvoke(committee, invokedByAlpha bool, contract util.Uint
 		return err
 	}
 
-	nAct, err := notary.NewActor(c.client, cosigners, c.acc)
+	rpc, err := c.actorRPC(c.client)
+	if err != nil {
+		return err
+	}
+
+	nAct, err := notary.NewActor(rpc, cosigners, c.acc)
 	if err != nil {
 		return err
 	}
@@ -604,7 +614,13 @@ func (c *Client) notaryMultisigAccount(ir []*keys.PublicKey, committee, invokedB
 
 	var multisigAccount *wallet.Account
 	var err error
-	if invokedByAlpha {
+	if invokedByAlpha && c.cfg.accSigner != nil {
+		multisigAccount, err = c.signerMultisigAccount(m, ir)
+		if err != nil {
+			// wrap error as NeoFS-specific since the call is not related to any client
+			return nil, fmt.Errorf("can't make inner ring multisig wallet: %w", err)
+		}
+	} else if invokedByAlpha {
 		multisigAccount = wallet.NewAccountFromPrivateKey(c.acc.PrivateKey())
 		err := multisigAccount.ConvertMultisig(m, ir)
 		if err != nil {
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/hash"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/address"
+	"github.com/nspcc-dev/neo-go/pkg/network/payload"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/notary"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// Accounts of the Client configured with WithSigner can not hold the private
+// key. neo-go actors sign transactions and notary requests only with the
+// private key of the account, so such accounts hold the random placeholder
+// key instead: the actors sign with it, and the placeholder signatures are
+// replaced with the signatures calculated by the signer.Signer right before
+// sending (see signingRPC). The placeholder signatures have the same size, so
+// network fees are calculated correctly, and they never leave the Client.
+
+// placeholderScryptParams are the scrypt parameters used to set the
+// placeholder key to the account. The key is not secret, so the cheapest
+// parameters are used.
+var placeholderScryptParams = keys.ScryptParams{N: 2, R: 1, P: 1}
+
+// newSignerAccount returns account with the given verification script
+// requiring nSigs signatures which are calculated with the placeholder key.
+func newSignerAccount(placeholder *keys.PrivateKey, script []byte, nSigs int) (*wallet.Account, error) {
+	// the only way to set the key to the account with the arbitrary script
+	// and address is the decryption of the encrypted key
+	wif, err := keys.NEP2Encrypt(placeholder, "", placeholderScryptParams)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt placeholder key: %w", err)
+	}
+
+	params := make([]wallet.ContractParam, nSigs)
+	for i := range params {
+		params[i] = wallet.ContractParam{
+			Name: fmt.Sprintf("parameter%d", i),
+			Type: smartcontract.SignatureType,
+		}
+	}
+
+	acc := &wallet.Account{
+		Address:      address.Uint160ToString(hash.Hash160(script)),
+		EncryptedWIF: wif,
+		Contract: &wallet.Contract{
+			Script:     script,
+			Parameters: params,
+		},
+	}
+
+	if err = acc.Decrypt("", placeholderScryptParams); err != nil {
+		return nil, fmt.Errorf("set placeholder key: %w", err)
+	}
+
+	return acc, nil
+}
+
+// signerMultisigAccount returns m out of len(pubs) multi-signature account
+// signed by the signer.Signer of the Client.
+func (c *Client) signerMultisigAccount(m int, pubs keys.PublicKeys) (*wallet.Account, error) {
+	if !pubs.Contains(c.cfg.accSigner.PublicKey()) {
+		return nil, errors.New("own public key was not found among multisig keys")
+	}
+
+	script, err := smartcontract.CreateMultiSigRedeemScript(m, pubs)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSignerAccount(c.placeholder, script, m)
+}
+
+// signingRPC is an RPC client replacing the placeholder signatures of the
+// sent transactions and notary requests with the signatures calculated by the
+// signer.Signer.
+type signingRPC struct {
+	*rpcclient.WSClient
+
+	signer      signer.Signer
+	placeholder *keys.PublicKey
+	magic       netmode.Magic
+}
+
+// actorRPC returns RPC client the actors of the Client send transactions and
+// notary requests through.
+func (c *Client) actorRPC(ws *rpcclient.WSClient) (notary.RPCActor, error) {
+	if c.cfg.accSigner == nil {
+		return ws, nil
+	}
+
+	v, err := ws.GetVersion()
+	if err != nil {
+		return nil, fmt.Errorf("get network magic: %w", err)
+	}
+
+	return &signingRPC{
+		WSClient:    ws,
+		signer:      c.cfg.accSigner,
+		placeholder: c.placeholder.PublicKey(),
+		magic:       v.Protocol.Network,
+	}, nil
+}
+
+// SendRawTransaction signs the transaction by the signer.Signer and sends it.
+func (x *signingRPC) SendRawTransaction(tx *transaction.Transaction) (util.Uint256, error) {
+	if err := x.signWitnesses(tx); err != nil {
+		return util.Uint256{}, err
+	}
+
+	return x.WSClient.SendRawTransaction(tx)
+}
+
+// SubmitP2PNotaryRequest signs transactions of the notary request and the
+// request itself by the signer.Signer and sends it.
+func (x *signingRPC) SubmitP2PNotaryRequest(req *payload.P2PNotaryRequest) (util.Uint256, error) {
+	if err := x.signWitnesses(req.MainTransaction); err != nil {
+		return util.Uint256{}, fmt.Errorf("main transaction: %w", err)
+	}
+
+	if err := x.signWitnesses(req.FallbackTransaction); err != nil {
+		return util.Uint256{}, fmt.Errorf("fallback transaction: %w", err)
+	}
+
+	// hash of the request covers the transaction witnesses and is cached, so
+	// the new request is signed
+	signed := &payload.P2PNotaryRequest{
+		MainTransaction:     req.MainTransaction,
+		FallbackTransaction: req.FallbackTransaction,
+	}
+
+	sig, err := x.sign(signed)
+	if err != nil {
+		return util.Uint256{}, fmt.Errorf("sign notary request: %w", err)
+	}
+
+	signed.Witness = transaction.Witness{
+		InvocationScript:   append([]byte{byte(opcode.PUSHDATA1), keys.SignatureLen}, sig...),
+		VerificationScript: req.Witness.VerificationScript,
+	}
+
+	return x.WSClient.SubmitP2PNotaryRequest(signed)
+}
+
+// signWitnesses replaces all the placeholder signatures in the transaction
+// witnesses.
+func (x *signingRPC) signWitnesses(tx *transaction.Transaction) error {
+	var sig []byte
+
+	for i := range tx.Scripts {
+		invoc := tx.Scripts[i].InvocationScript
+
+		// invocation script is a sequence of PUSHDATA1 instructions with
+		// the signatures
+		for off := 0; off+2+keys.SignatureLen <= len(invoc); off += 2 + keys.SignatureLen {
+			if invoc[off] != byte(opcode.PUSHDATA1) || invoc[off+1] != keys.SignatureLen {
+				break
+			}
+
+			chunk := invoc[off+2 : off+2+keys.SignatureLen]
+			if !x.placeholder.VerifyHashable(chunk, uint32(x.magic), tx) {
+				continue
+			}
+
+			if sig == nil {
+				var err error
+				if sig, err = x.sign(tx); err != nil {
+					return fmt.Errorf("sign witness #%d: %w", i, err)
+				}
+			}
+
+			copy(chunk, sig)
+		}
+	}
+
+	return nil
+}
+
+// sign signs the network-specific hash of the item by the signer.Signer.
+func (x *signingRPC) sign(item hash.Hashable) ([]byte, error) {
+	h := item.Hash()
+
+	data := make([]byte, 4+util.Uint256Size)
+	binary.LittleEndian.PutUint32(data, uint32(x.magic))
+	copy(data[4:], h[:])
+
+	sig, err := x.signer.Sign(neofscrypto.ECDSA_DETERMINISTIC_SHA256, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sig) != keys.SignatureLen {
+		return nil, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+
+	return sig, nil
+}
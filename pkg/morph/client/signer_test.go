@@ -0,0 +1,58 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract"
+	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningRPC_SignWitnesses(t *testing.T) {
+	const magic = netmode.UnitTestNet
+
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	otherKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	placeholder, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	acc, err := newSignerAccount(placeholder, key.PublicKey().GetVerificationScript(), 1)
+	require.NoError(t, err)
+	require.Equal(t, key.GetScriptHash(), acc.ScriptHash())
+
+	pubs := keys.PublicKeys{key.PublicKey(), otherKey.PublicKey()}
+
+	multiScript, err := smartcontract.CreateMultiSigRedeemScript(2, pubs)
+	require.NoError(t, err)
+
+	multiAcc, err := newSignerAccount(placeholder, multiScript, 2)
+	require.NoError(t, err)
+
+	tx := transaction.New([]byte{byte(opcode.RET)}, 0)
+	tx.Signers = []transaction.Signer{{Account: acc.ScriptHash()}, {Account: multiAcc.ScriptHash()}}
+
+	require.NoError(t, acc.SignTx(magic, tx))
+	require.NoError(t, multiAcc.SignTx(magic, tx))
+
+	rpc := &signingRPC{
+		signer:      signer.FromPrivateKey(key),
+		placeholder: placeholder.PublicKey(),
+		magic:       magic,
+	}
+
+	require.NoError(t, rpc.signWitnesses(tx))
+
+	expected := key.SignHashable(uint32(magic), tx)
+
+	require.Equal(t, append([]byte{byte(opcode.PUSHDATA1), keys.SignatureLen}, expected...), tx.Scripts[0].InvocationScript)
+	require.Equal(t, append([]byte{byte(opcode.PUSHDATA1), keys.SignatureLen}, expected...), tx.Scripts[1].InvocationScript)
+	require.True(t, key.PublicKey().VerifyHashable(tx.Scripts[0].InvocationScript[2:], uint32(magic), tx))
+}
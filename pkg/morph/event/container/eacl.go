@@ -1,7 +1,13 @@
 package container
 
 import (
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
 	"github.com/nspcc-dev/neo-go/pkg/network/payload"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 )
 
 // SetEACL represents structure of notification about
@@ -47,3 +53,47 @@ func (x SetEACL) SessionToken() []byte {
 func (x SetEACL) NotaryRequest() *payload.P2PNotaryRequest {
 	return x.notaryRequest
 }
+
+// SetEACLSuccess structures notification event of successful eACL table
+// update thrown by Container contract.
+type SetEACLSuccess struct {
+	// Identifier of the container with the updated eACL table.
+	ID cid.ID
+}
+
+// MorphEvent implements Neo:Morph Event interface.
+func (SetEACLSuccess) MorphEvent() {}
+
+// ParseSetEACLSuccess decodes notification event thrown by Container contract
+// into SetEACLSuccess and returns it as event.Event.
+func ParseSetEACLSuccess(e *state.ContainedNotificationEvent) (event.Event, error) {
+	items, err := event.ParseStackArray(e)
+	if err != nil {
+		return nil, fmt.Errorf("parse stack array from raw notification event: %w", err)
+	}
+
+	const expectedItemNumSetEACLSuccess = 2
+
+	if ln := len(items); ln != expectedItemNumSetEACLSuccess {
+		return nil, event.WrongNumberOfParameters(expectedItemNumSetEACLSuccess, ln)
+	}
+
+	binID, err := client.BytesFromStackItem(items[0])
+	if err != nil {
+		return nil, fmt.Errorf("parse container ID item: %w", err)
+	}
+
+	_, err = client.BytesFromStackItem(items[1])
+	if err != nil {
+		return nil, fmt.Errorf("parse public key item: %w", err)
+	}
+
+	var res SetEACLSuccess
+
+	err = res.ID.Decode(binID)
+	if err != nil {
+		return nil, fmt.Errorf("decode container ID: %w", err)
+	}
+
+	return res, nil
+}
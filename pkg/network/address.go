@@ -34,12 +34,12 @@ func (a Address) equal(addr Address) bool {
 	return a.ma.Equal(addr.ma)
 }
 
-// URIAddr returns Address as a URI.
+// HostAddr returns host address of the Address in "host:port" format
+// without any URI scheme. Use IsTLSEnabled to find out whether the
+// endpoint requires secure transport.
 //
 // Panics if host address cannot be fetched from Address.
-//
-// See also FromString.
-func (a Address) URIAddr() string {
+func (a Address) HostAddr() string {
 	_, host, err := manet.DialArgs(a.ma)
 	if err != nil {
 		// the only correct way to construct Address is AddressFromString
@@ -47,7 +47,18 @@ func (a Address) URIAddr() string {
 		panic(fmt.Errorf("could not get host addr: %w", err))
 	}
 
-	if !a.isTLSEnabled() {
+	return host
+}
+
+// URIAddr returns Address as a URI.
+//
+// Panics if host address cannot be fetched from Address.
+//
+// See also FromString.
+func (a Address) URIAddr() string {
+	host := a.HostAddr()
+
+	if !a.IsTLSEnabled() {
 		return host
 	}
 
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/multiformats/go-multiaddr"
@@ -59,6 +60,7 @@ func TestAddress_HostAddrString(t *testing.T) {
 			got := addr.URIAddr()
 
 			require.Equal(t, testcase.exp, got)
+			require.Equal(t, strings.TrimPrefix(testcase.exp, "grpcs://"), addr.HostAddr())
 		}
 	})
 
@@ -70,6 +72,7 @@ func TestAddress_HostAddrString(t *testing.T) {
 		for _, testcase := range testcases {
 			addr := Address{testcase}
 			require.Panics(t, func() { addr.URIAddr() })
+			require.Panics(t, func() { addr.HostAddr() })
 		}
 	})
 }
@@ -11,8 +11,9 @@ const (
 // tls var is used for (un)wrapping other multiaddrs around TLS multiaddr.
 var tls, _ = multiaddr.NewMultiaddr("/" + tlsProtocolName)
 
-// isTLSEnabled searches for wrapped TLS protocol in multiaddr.
-func (a Address) isTLSEnabled() bool {
+// IsTLSEnabled searches for wrapped TLS protocol in multiaddr.
+// Endpoints with TLS enabled must be dialed using secure transport.
+func (a Address) IsTLSEnabled() bool {
 	for _, protoc := range a.ma.Protocols() {
 		if protoc.Code == multiaddr.P_TLS {
 			return true
@@ -2,10 +2,10 @@ package accounting
 
 import (
 	"context"
-	"crypto/ecdsa"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/accounting"
 	"github.com/nspcc-dev/neofs-node/pkg/services/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 )
 
 type signService struct {
@@ -14,9 +14,9 @@ type signService struct {
 	svc Server
 }
 
-func NewSignService(key *ecdsa.PrivateKey, svc Server) Server {
+func NewSignService(s signer.Signer, svc Server) Server {
 	return &signService{
-		sigSvc: util.NewUnarySignService(key),
+		sigSvc: util.NewUnarySignService(s),
 		svc:    svc,
 	}
 }
@@ -61,6 +61,9 @@ type gamePair struct {
 	rn1, rn2 []*object.Range
 
 	hh1, hh2 [][]byte
+
+	// hashErr is the first error of the range hash request
+	hashErr error
 }
 
 type shortHeader struct {
@@ -2,9 +2,11 @@ package auditor
 
 import (
 	"bytes"
+	"fmt"
 	"sync"
 	"time"
 
+	"github.com/nspcc-dev/neofs-node/pkg/services/audit"
 	"github.com/nspcc-dev/neofs-node/pkg/util/rand"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -145,6 +147,11 @@ func (c *Context) collectHashes(p *gamePair) {
 					zap.String("node", netmap.StringifyPublicKey(n)),
 					zap.String("error", err.Error()),
 				)
+
+				if p.hashErr == nil {
+					p.hashErr = fmt.Errorf("get payload range hash from %s: %w", netmap.StringifyPublicKey(n), err)
+				}
+
 				return res
 			}
 			res[i] = h
@@ -157,32 +164,58 @@ func (c *Context) collectHashes(p *gamePair) {
 }
 
 func (c *Context) analyzeHashes(p *gamePair) {
-	if len(p.hh1) != hashRangeNumber-1 || len(p.hh2) != hashRangeNumber-1 {
-		c.failNodesPDP(p.n1, p.n2)
+	if p.hashErr != nil || len(p.hh1) != hashRangeNumber-1 || len(p.hh2) != hashRangeNumber-1 {
+		reason := "incomplete range hash responses"
+		if p.hashErr != nil {
+			reason = p.hashErr.Error()
+		}
+
+		c.failPairPDP(p, reason, nil, nil)
 		return
 	}
 
 	h1, err := tz.Concat([][]byte{p.hh2[0], p.hh2[1]})
 	if err != nil || !bytes.Equal(p.hh1[0], h1) {
-		c.failNodesPDP(p.n1, p.n2)
+		c.failPairPDP(p, "first range hash mismatch", p.hh1[0], h1)
 		return
 	}
 
 	h2, err := tz.Concat([][]byte{p.hh1[1], p.hh1[2]})
 	if err != nil || !bytes.Equal(p.hh2[2], h2) {
-		c.failNodesPDP(p.n1, p.n2)
+		c.failPairPDP(p, "last range hash mismatch", p.hh2[2], h2)
 		return
 	}
 
 	fh, err := tz.Concat([][]byte{h1, h2})
-	if err != nil || !bytes.Equal(fh, c.objectHomoHash(p.id)) {
-		c.failNodesPDP(p.n1, p.n2)
+	expected := c.objectHomoHash(p.id)
+	if err != nil || !bytes.Equal(fh, expected) {
+		c.failPairPDP(p, "payload hash mismatch", expected, fh)
 		return
 	}
 
+	c.report.AddCheckResult(audit.CheckResult{
+		Type:   audit.CheckPDP,
+		Passed: true,
+		Object: p.id,
+		Nodes:  [][]byte{p.n1.PublicKey(), p.n2.PublicKey()},
+	})
+
 	c.passNodesPDP(p.n1, p.n2)
 }
 
+func (c *Context) failPairPDP(p *gamePair, reason string, expected, actual []byte) {
+	c.report.AddCheckResult(audit.CheckResult{
+		Type:         audit.CheckPDP,
+		Object:       p.id,
+		Nodes:        [][]byte{p.n1.PublicKey(), p.n2.PublicKey()},
+		Reason:       reason,
+		ExpectedHash: expected,
+		ActualHash:   actual,
+	})
+
+	c.failNodesPDP(p.n1, p.n2)
+}
+
 func (c *Context) failNodesPDP(ns ...netmap.NodeInfo) {
 	c.pairedMtx.Lock()
 
@@ -1,6 +1,9 @@
 package auditor
 
 import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/audit"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/tzhash/tz"
@@ -46,6 +49,8 @@ func (c *Context) processObjectPlacement(id oid.ID, nodes []netmap.NodeInfo, rep
 		unpairedCandidate1, unpairedCandidate2 = -1, -1
 
 		pairedCandidate = -1
+
+		unresponsive [][]byte
 	)
 
 	var getHeaderPrm GetHeaderPrm
@@ -65,6 +70,8 @@ func (c *Context) processObjectPlacement(id oid.ID, nodes []netmap.NodeInfo, rep
 				zap.String("error", err.Error()),
 			)
 
+			unresponsive = append(unresponsive, nodes[i].PublicKey())
+
 			continue
 		}
 
@@ -93,14 +100,25 @@ func (c *Context) processObjectPlacement(id oid.ID, nodes []netmap.NodeInfo, rep
 		}
 	}
 
+	res := audit.CheckResult{
+		Type:   audit.CheckPoP,
+		Passed: ok == replicas,
+		Object: id,
+		Nodes:  unresponsive,
+	}
+
 	if optimal {
 		c.counters.hit++
 	} else if ok == replicas {
 		c.counters.miss++
+		res.Reason = "object is not stored on the optimal nodes"
 	} else {
 		c.counters.fail++
+		res.Reason = fmt.Sprintf("object is stored in %d replicas instead of %d", ok, replicas)
 	}
 
+	c.report.AddCheckResult(res)
+
 	if unpairedCandidate1 >= 0 {
 		if unpairedCandidate2 >= 0 {
 			c.composePair(id, nodes[unpairedCandidate1], nodes[unpairedCandidate2])
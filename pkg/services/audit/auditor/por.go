@@ -2,8 +2,10 @@ package auditor
 
 import (
 	"bytes"
+	"fmt"
 	"sync"
 
+	"github.com/nspcc-dev/neofs-node/pkg/services/audit"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/util/rand"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
@@ -60,6 +62,13 @@ func (c *Context) checkStorageGroupPoR(sgID oid.ID, sg storagegroupSDK.StorageGr
 				zap.String("member_id", members[i].String()),
 			)
 
+			c.report.AddCheckResult(audit.CheckResult{
+				Type:         audit.CheckPoR,
+				StorageGroup: sgID,
+				Object:       members[i],
+				Reason:       "can't build placement: " + err.Error(),
+			})
+
 			continue
 		}
 
@@ -71,6 +80,11 @@ func (c *Context) checkStorageGroupPoR(sgID oid.ID, sg storagegroupSDK.StorageGr
 
 		getHeaderPrm.OID = members[i]
 
+		var (
+			headed       bool
+			unresponsive [][]byte
+		)
+
 		for j := range flat {
 			accRequests++
 			if j > 0 { // in best case audit get object header on first iteration
@@ -86,9 +100,13 @@ func (c *Context) checkStorageGroupPoR(sgID oid.ID, sg storagegroupSDK.StorageGr
 					zap.Stringer("oid", members[i]),
 				)
 
+				unresponsive = append(unresponsive, flat[j].PublicKey())
+
 				continue
 			}
 
+			headed = true
+
 			// update cache for PoR and PDP audit checks
 			c.updateHeadResponses(hdr)
 
@@ -115,6 +133,16 @@ func (c *Context) checkStorageGroupPoR(sgID oid.ID, sg storagegroupSDK.StorageGr
 
 			break
 		}
+
+		if !headed {
+			c.report.AddCheckResult(audit.CheckResult{
+				Type:         audit.CheckPoR,
+				StorageGroup: sgID,
+				Object:       members[i],
+				Nodes:        unresponsive,
+				Reason:       "object header is not available on any container node",
+			})
+		}
 	}
 
 	c.porRequests.Add(accRequests)
@@ -124,6 +152,29 @@ func (c *Context) checkStorageGroupPoR(sgID oid.ID, sg storagegroupSDK.StorageGr
 	cs, _ := sg.ValidationDataHash()
 	tzCheck := !homomorphicHashingEnabled || bytes.Equal(tzHash, cs.Value())
 
+	res := audit.CheckResult{
+		Type:         audit.CheckPoR,
+		Passed:       sizeCheck && tzCheck,
+		StorageGroup: sgID,
+	}
+
+	if !sizeCheck {
+		res.Reason = fmt.Sprintf("storage group size mismatch: expected %d, got %d",
+			sg.ValidationDataSize(), totalSize)
+	}
+
+	if !tzCheck {
+		if res.Reason != "" {
+			res.Reason += "; "
+		}
+
+		res.Reason += "storage group homomorphic hash mismatch"
+		res.ExpectedHash = cs.Value()
+		res.ActualHash = tzHash
+	}
+
+	c.report.AddCheckResult(res)
+
 	if sizeCheck && tzCheck {
 		c.report.PassedPoR(sgID) // write report
 	} else {
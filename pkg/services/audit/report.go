@@ -10,8 +10,70 @@ import (
 
 // Report tracks the progress of auditing container data.
 type Report struct {
-	mu  sync.RWMutex
-	res audit.Result
+	mu     sync.RWMutex
+	res    audit.Result
+	checks []CheckResult
+}
+
+// CheckType is a type of the data audit check.
+type CheckType uint8
+
+const (
+	_ CheckType = iota
+
+	// CheckPoR is a Proof of Retrievability check: storage group members are
+	// available and match the storage group.
+	CheckPoR
+
+	// CheckPoP is a Proof of Placement check: object is stored on the nodes
+	// according to the container placement policy.
+	CheckPoP
+
+	// CheckPDP is a Proof of Data Possession check: pair of nodes stores the
+	// same object payload.
+	CheckPDP
+)
+
+// String implements fmt.Stringer.
+func (x CheckType) String() string {
+	switch x {
+	default:
+		return "UNDEFINED"
+	case CheckPoR:
+		return "PoR"
+	case CheckPoP:
+		return "PoP"
+	case CheckPDP:
+		return "PDP"
+	}
+}
+
+// CheckResult is a detailed result of the single data audit check.
+type CheckResult struct {
+	// Type of the check.
+	Type CheckType
+
+	// Passed is true if the check is passed.
+	Passed bool
+
+	// StorageGroup is an identifier of the storage group checked by PoR. Zero
+	// for other checks.
+	StorageGroup oid.ID
+
+	// Object is an identifier of the checked object. Zero for PoR check of the
+	// whole storage group.
+	Object oid.ID
+
+	// Nodes are public keys of the storage nodes involved in the check: nodes
+	// failed to respond for PoR and PoP, the node pair for PDP.
+	Nodes [][]byte
+
+	// Reason describes the check failure or the deviation from the optimal
+	// result.
+	Reason string
+
+	// ExpectedHash and ActualHash are the mismatched homomorphic hashes.
+	ExpectedHash, ActualHash []byte
 }
 
 // Reporter is an interface of the entity that records
@@ -87,3 +149,20 @@ func (r *Report) SetPoRCounters(requests, retries uint32) {
 	r.res.SetRequestsPoR(requests)
 	r.res.SetRetriesPoR(retries)
 }
+
+// AddCheckResult records detailed result of the single check.
+func (r *Report) AddCheckResult(res CheckResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checks = append(r.checks, res)
+}
+
+// CheckResults returns detailed results of the checks recorded by
+// AddCheckResult.
+func (r *Report) CheckResults() []CheckResult {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]CheckResult(nil), r.checks...)
+}
@@ -0,0 +1,36 @@
+package resultstore
+
+import (
+	"time"
+)
+
+type cfg struct {
+	timeout time.Duration
+	epochs  uint64
+}
+
+// Option allows setting optional parameters of the Store.
+type Option func(*cfg)
+
+func defaultCfg() *cfg {
+	return &cfg{
+		timeout: time.Second,
+	}
+}
+
+// WithTimeout returns option to specify
+// database connection timeout.
+func WithTimeout(v time.Duration) Option {
+	return func(c *cfg) {
+		c.timeout = v
+	}
+}
+
+// WithEpochs returns option to specify number
+// of the last epochs which results are kept.
+// Zero means results are never removed.
+func WithEpochs(v uint64) Option {
+	return func(c *cfg) {
+		c.epochs = v
+	}
+}
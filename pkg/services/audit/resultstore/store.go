@@ -0,0 +1,302 @@
+package resultstore
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/audit"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// Result is a detailed result of the single audit check stored in Store.
+type Result struct {
+	audit.CheckResult
+
+	// Epoch when the container was audited.
+	Epoch uint64
+
+	// Container is an identifier of the audited container.
+	Container cid.ID
+}
+
+// Store is a persistent storage of the detailed audit results. Results are
+// grouped by epochs, only the results of the last configured number of epochs
+// are kept.
+type Store struct {
+	db *bbolt.DB
+
+	epochs uint64
+}
+
+// Open opens the Store located at the given path creating it if necessary.
+func Open(path string, opts ...Option) (*Store, error) {
+	cfg := defaultCfg()
+
+	for _, o := range opts {
+		o(cfg)
+	}
+
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{
+		Timeout: cfg.timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can't open bbolt at %s: %w", path, err)
+	}
+
+	return &Store{
+		db:     db,
+		epochs: cfg.epochs,
+	}, nil
+}
+
+// Close closes the Store.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// storedResult is a JSON representation of the Result.
+type storedResult struct {
+	Type         audit.CheckType `json:"type"`
+	Passed       bool            `json:"passed"`
+	StorageGroup []byte          `json:"storage_group,omitempty"`
+	Object       []byte          `json:"object,omitempty"`
+	Nodes        [][]byte        `json:"nodes,omitempty"`
+	Reason       string          `json:"reason,omitempty"`
+	ExpectedHash []byte          `json:"expected_hash,omitempty"`
+	ActualHash   []byte          `json:"actual_hash,omitempty"`
+}
+
+// Put saves results of the checks of the container audited in the given
+// epoch. Results of the epochs out of the retention window are removed.
+func (s *Store) Put(epoch uint64, cnr cid.ID, results []audit.CheckResult) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(epochKey(epoch))
+		if err != nil {
+			return fmt.Errorf("create epoch bucket: %w", err)
+		}
+
+		for i := range results {
+			v, err := json.Marshal(toStored(results[i]))
+			if err != nil {
+				return fmt.Errorf("encode result: %w", err)
+			}
+
+			seq, err := b.NextSequence()
+			if err != nil {
+				return fmt.Errorf("get next sequence: %w", err)
+			}
+
+			key := make([]byte, sha256.Size+8)
+			cnr.Encode(key)
+			binary.BigEndian.PutUint64(key[sha256.Size:], seq)
+
+			if err = b.Put(key, v); err != nil {
+				return fmt.Errorf("put result: %w", err)
+			}
+		}
+
+		return s.removeOld(tx, epoch)
+	})
+}
+
+// removeOld removes buckets of the epochs out of the retention window
+// relative to the given one.
+func (s *Store) removeOld(tx *bbolt.Tx, epoch uint64) error {
+	if s.epochs == 0 || epoch < s.epochs {
+		return nil
+	}
+
+	var old [][]byte
+
+	c := tx.Cursor()
+	for k, _ := c.First(); k != nil && binary.BigEndian.Uint64(k) <= epoch-s.epochs; k, _ = c.Next() {
+		old = append(old, k)
+	}
+
+	for i := range old {
+		if err := tx.DeleteBucket(old[i]); err != nil {
+			return fmt.Errorf("remove results of epoch %d: %w", binary.BigEndian.Uint64(old[i]), err)
+		}
+	}
+
+	return nil
+}
+
+// cursorSize is the size of the List cursor: epoch bucket name followed by the
+// result key.
+const cursorSize = 8 + sha256.Size + 8
+
+// List returns stored results of the given epoch involving the storage node
+// with the given public key. Zero epoch means all epochs, empty key means all
+// nodes.
+//
+// Results are returned by pages of at most limit results starting after the
+// given cursor, nil cursor means the first page. The returned cursor should be
+// passed to get the next page, it is nil if there are no more results.
+// Non-positive limit means no limit.
+func (s *Store) List(epoch uint64, nodeKey []byte, cursor []byte, limit int) ([]Result, []byte, error) {
+	if cursor != nil && len(cursor) != cursorSize {
+		return nil, nil, fmt.Errorf("invalid cursor size %d, expected %d", len(cursor), cursorSize)
+	}
+
+	var (
+		res  []Result
+		last []byte
+		next []byte
+	)
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var name, after []byte
+
+		c := tx.Cursor()
+
+		switch {
+		case cursor != nil:
+			name, _ = c.Seek(cursor[:8])
+			if bytes.Equal(name, cursor[:8]) {
+				after = cursor[8:]
+			}
+		case epoch != 0:
+			name, _ = c.Seek(epochKey(epoch))
+		default:
+			name, _ = c.First()
+		}
+
+		for ; name != nil; name, _ = c.Next() {
+			e := binary.BigEndian.Uint64(name)
+			if epoch != 0 && e != epoch {
+				if e > epoch {
+					break
+				}
+				continue
+			}
+
+			bc := tx.Bucket(name).Cursor()
+
+			var k, v []byte
+			if after != nil {
+				k, v = bc.Seek(after)
+				if bytes.Equal(k, after) {
+					k, v = bc.Next()
+				}
+				after = nil
+			} else {
+				k, v = bc.First()
+			}
+
+			for ; k != nil; k, v = bc.Next() {
+				r, err := decodeResult(e, k, v)
+				if err != nil {
+					return err
+				}
+
+				if len(nodeKey) != 0 && !involves(r.Nodes, nodeKey) {
+					continue
+				}
+
+				if limit > 0 && len(res) == limit {
+					next = last
+					return nil
+				}
+
+				res = append(res, r)
+				last = append(append(make([]byte, 0, cursorSize), name...), k...)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, next, nil
+}
+
+func decodeResult(epoch uint64, k, v []byte) (Result, error) {
+	var r Result
+
+	err := r.Container.Decode(k[:sha256.Size])
+	if err != nil {
+		return r, fmt.Errorf("invalid container ID in key: %w", err)
+	}
+
+	var st storedResult
+
+	if err = json.Unmarshal(v, &st); err != nil {
+		return r, fmt.Errorf("decode result: %w", err)
+	}
+
+	r.Epoch = epoch
+	r.CheckResult, err = fromStored(st)
+
+	return r, err
+}
+
+func involves(nodes [][]byte, key []byte) bool {
+	for i := range nodes {
+		if bytes.Equal(nodes[i], key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func epochKey(epoch uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, epoch)
+
+	return key
+}
+
+func toStored(r audit.CheckResult) storedResult {
+	st := storedResult{
+		Type:         r.Type,
+		Passed:       r.Passed,
+		Nodes:        r.Nodes,
+		Reason:       r.Reason,
+		ExpectedHash: r.ExpectedHash,
+		ActualHash:   r.ActualHash,
+	}
+
+	if r.StorageGroup != (oid.ID{}) {
+		st.StorageGroup = r.StorageGroup[:]
+	}
+
+	if r.Object != (oid.ID{}) {
+		st.Object = r.Object[:]
+	}
+
+	return st
+}
+
+func fromStored(st storedResult) (audit.CheckResult, error) {
+	r := audit.CheckResult{
+		Type:         st.Type,
+		Passed:       st.Passed,
+		Nodes:        st.Nodes,
+		Reason:       st.Reason,
+		ExpectedHash: st.ExpectedHash,
+		ActualHash:   st.ActualHash,
+	}
+
+	if len(st.StorageGroup) > 0 {
+		if err := r.StorageGroup.Decode(st.StorageGroup); err != nil {
+			return r, fmt.Errorf("invalid storage group ID: %w", err)
+		}
+	}
+
+	if len(st.Object) > 0 {
+		if err := r.Object.Decode(st.Object); err != nil {
+			return r, fmt.Errorf("invalid object ID: %w", err)
+		}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,121 @@
+package resultstore
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/audit"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	s, err := Open(filepath.Join(t.TempDir(), "audit"), WithEpochs(2))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+
+	cnr := cidtest.ID()
+	node1, node2 := []byte{1, 2, 3}, []byte{4, 5, 6}
+
+	por := audit.CheckResult{
+		Type:         audit.CheckPoR,
+		StorageGroup: oidtest.ID(),
+		Reason:       "storage group homomorphic hash mismatch",
+		ExpectedHash: []byte{1},
+		ActualHash:   []byte{2},
+	}
+	pdp := audit.CheckResult{
+		Type:   audit.CheckPDP,
+		Passed: true,
+		Object: oidtest.ID(),
+		Nodes:  [][]byte{node1, node2},
+	}
+	pop := audit.CheckResult{
+		Type:   audit.CheckPoP,
+		Object: oidtest.ID(),
+		Nodes:  [][]byte{node2},
+		Reason: "object is stored in 1 replicas instead of 2",
+	}
+
+	require.NoError(t, s.Put(1, cnr, []audit.CheckResult{por, pdp}))
+	require.NoError(t, s.Put(2, cnr, []audit.CheckResult{pop}))
+
+	res, _, err := s.List(0, nil, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, []Result{
+		{CheckResult: por, Epoch: 1, Container: cnr},
+		{CheckResult: pdp, Epoch: 1, Container: cnr},
+		{CheckResult: pop, Epoch: 2, Container: cnr},
+	}, res)
+
+	res, _, err = s.List(1, nil, nil, 0)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	res, _, err = s.List(0, node2, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, []Result{
+		{CheckResult: pdp, Epoch: 1, Container: cnr},
+		{CheckResult: pop, Epoch: 2, Container: cnr},
+	}, res)
+
+	res, _, err = s.List(2, node1, nil, 0)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	// results of the epoch 1 are out of retention window now
+	require.NoError(t, s.Put(3, cnr, nil))
+
+	res, _, err = s.List(1, nil, nil, 0)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	res, _, err = s.List(0, nil, nil, 0)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+
+	t.Run("paging", func(t *testing.T) {
+		s, err := Open(filepath.Join(t.TempDir(), "audit"))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = s.Close() })
+
+		var all []Result
+		for e := uint64(1); e <= 3; e++ {
+			require.NoError(t, s.Put(e, cnr, []audit.CheckResult{por, pdp, pop}))
+			all = append(all,
+				Result{CheckResult: por, Epoch: e, Container: cnr},
+				Result{CheckResult: pdp, Epoch: e, Container: cnr},
+				Result{CheckResult: pop, Epoch: e, Container: cnr},
+			)
+		}
+
+		list := func(epoch uint64, nodeKey []byte, limit int) []Result {
+			var (
+				res    []Result
+				cursor []byte
+			)
+
+			for {
+				page, next, err := s.List(epoch, nodeKey, cursor, limit)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(page), limit)
+
+				res = append(res, page...)
+				if next == nil {
+					return res
+				}
+				cursor = next
+			}
+		}
+
+		for _, limit := range []int{1, 2, 4, 9, 10} {
+			require.Equal(t, all, list(0, nil, limit), limit)
+			require.Equal(t, all[3:6], list(2, nil, limit), limit)
+			require.Equal(t, []Result{all[1], all[2], all[4], all[5], all[7], all[8]}, list(0, node2, limit), limit)
+		}
+
+		_, _, err = s.List(0, nil, []byte{1}, 1)
+		require.Error(t, err)
+	})
+}
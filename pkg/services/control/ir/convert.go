@@ -32,3 +32,22 @@ func (w *healthCheckResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 
 	return nil
 }
+
+type listAuditResultsResponseWrapper struct {
+	m *ListAuditResultsResponse
+}
+
+func (w *listAuditResultsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *listAuditResultsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*ListAuditResultsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
@@ -8,7 +8,8 @@ import (
 const serviceName = "ircontrol.ControlService"
 
 const (
-	rpcHealthCheck = "HealthCheck"
+	rpcHealthCheck      = "HealthCheck"
+	rpcListAuditResults = "ListAuditResults"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -32,3 +33,25 @@ func HealthCheck(
 
 	return wResp.m, nil
 }
+
+// ListAuditResults executes ControlService.ListAuditResults RPC.
+func ListAuditResults(
+	cli *client.Client,
+	req *ListAuditResultsRequest,
+	opts ...client.CallOption,
+) (*ListAuditResultsResponse, error) {
+	wResp := &listAuditResultsResponseWrapper{
+		m: new(ListAuditResultsResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListAuditResults), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
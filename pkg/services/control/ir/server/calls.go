@@ -3,7 +3,9 @@ package control
 import (
 	"context"
 
+	"github.com/nspcc-dev/neofs-node/pkg/services/audit"
 	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -26,9 +28,96 @@ func (s *Server) HealthCheck(_ context.Context, req *control.HealthCheckRequest)
 	body.SetHealthStatus(s.prm.healthChecker.HealthStatus())
 
 	// sign the response
-	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+	if err := s.signResponse(resp); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return resp, nil
 }
+
+// maxAuditResultsPage is the maximum number of results returned by the single
+// ListAuditResults call.
+const maxAuditResultsPage = 1000
+
+// ListAuditResults returns detailed results of the data audit checks
+// stored by the local IR node by pages of at most maxAuditResultsPage results.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) ListAuditResults(_ context.Context, req *control.ListAuditResultsRequest) (*control.ListAuditResultsResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.auditResults == nil {
+		return nil, status.Error(codes.Unavailable, "audit results are not stored by the node")
+	}
+
+	limit := req.GetBody().GetLimit()
+	if limit == 0 || limit > maxAuditResultsPage {
+		limit = maxAuditResultsPage
+	}
+
+	cursor := req.GetBody().GetCursor()
+	if len(cursor) == 0 {
+		cursor = nil
+	}
+
+	list, next, err := s.auditResults.List(req.GetBody().GetEpoch(), req.GetBody().GetPublicKey(), cursor, int(limit))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results := make([]*control.AuditCheckResult, 0, len(list))
+
+	for i := range list {
+		r := new(control.AuditCheckResult)
+		r.SetEpoch(list[i].Epoch)
+		r.SetContainerID(list[i].Container[:])
+		r.SetType(auditCheckTypeToGRPC(list[i].Type))
+		r.SetPassed(list[i].Passed)
+		r.SetNodes(list[i].Nodes)
+		r.SetReason(list[i].Reason)
+		r.SetExpectedHash(list[i].ExpectedHash)
+		r.SetActualHash(list[i].ActualHash)
+
+		if list[i].StorageGroup != (oid.ID{}) {
+			r.SetStorageGroupID(list[i].StorageGroup[:])
+		}
+
+		if list[i].Object != (oid.ID{}) {
+			r.SetObjectID(list[i].Object[:])
+		}
+
+		results = append(results, r)
+	}
+
+	// create and fill response
+	resp := new(control.ListAuditResultsResponse)
+
+	body := new(control.ListAuditResultsResponse_Body)
+	resp.SetBody(body)
+
+	body.SetResults(results)
+	body.SetCursor(next)
+
+	// sign the response
+	if err := s.signResponse(resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+func auditCheckTypeToGRPC(t audit.CheckType) control.AuditCheckType {
+	switch t {
+	default:
+		return control.AuditCheckType_AUDIT_CHECK_UNDEFINED
+	case audit.CheckPoR:
+		return control.AuditCheckType_POR
+	case audit.CheckPoP:
+		return control.AuditCheckType_POP
+	case audit.CheckPDP:
+		return control.AuditCheckType_PDP
+	}
+}
@@ -1,6 +1,9 @@
 package control
 
-import control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/services/audit/resultstore"
+	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+)
 
 // HealthChecker is component interface for calculating
 // the current health status of a node.
@@ -11,3 +14,16 @@ type HealthChecker interface {
 	// control.HealthStatus_HEALTH_STATUS_UNDEFINED should be returned.
 	HealthStatus() control.HealthStatus
 }
+
+// AuditResultsSource is a source of the detailed audit results
+// stored by the IR node.
+type AuditResultsSource interface {
+	// List must return stored results of the given epoch involving
+	// the storage node with the given public key. Zero epoch means all
+	// epochs, empty key means all nodes.
+	//
+	// Results must be returned by pages of at most limit results starting
+	// after the given cursor (nil means the first page) along with the
+	// cursor of the next page (nil if there are no more results).
+	List(epoch uint64, nodeKey []byte, cursor []byte, limit int) ([]resultstore.Result, []byte, error)
+}
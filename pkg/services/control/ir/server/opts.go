@@ -5,6 +5,8 @@ type Option func(*options)
 
 type options struct {
 	allowedKeys [][]byte
+
+	auditResults AuditResultsSource
 }
 
 func defaultOptions() *options {
@@ -18,3 +20,12 @@ func WithAllowedKeys(keys [][]byte) Option {
 		o.allowedKeys = append(o.allowedKeys, keys...)
 	}
 }
+
+// WithAuditResults returns option to specify source of the
+// detailed audit results served by the Control service.
+// ListAuditResults RPC is unavailable without it.
+func WithAuditResults(src AuditResultsSource) Option {
+	return func(o *options) {
+		o.auditResults = src
+	}
+}
@@ -1,20 +1,20 @@
 package control
 
 import (
-	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 )
 
 // Prm groups required parameters of
 // Server's constructor.
 type Prm struct {
-	key keys.PrivateKey
+	signer signer.Signer
 
 	healthChecker HealthChecker
 }
 
-// SetPrivateKey sets private key to sign responses.
-func (x *Prm) SetPrivateKey(key keys.PrivateKey) {
-	x.key = key
+// SetSigner sets signer of responses.
+func (x *Prm) SetSigner(s signer.Signer) {
+	x.signer = s
 }
 
 // SetHealthChecker sets HealthChecker to calculate
@@ -13,6 +13,8 @@ type Server struct {
 	prm Prm
 
 	allowedKeys [][]byte
+
+	auditResults AuditResultsSource
 }
 
 func panicOnPrmValue(n string, v any) {
@@ -23,17 +25,19 @@ func panicOnPrmValue(n string, v any) {
 // New creates a new instance of the Server.
 //
 // Panics if:
-//   - parameterized private key is nil;
+//   - parameterized signer is nil;
 //   - parameterized HealthChecker is nil.
 //
 // Forms white list from all keys specified via
 // WithAllowedKeys option and a public key of
-// the parameterized private key.
+// the parameterized signer.
 func New(prm Prm, opts ...Option) *Server {
 	// verify required parameters
 	switch {
 	case prm.healthChecker == nil:
 		panicOnPrmValue("health checker", prm.healthChecker)
+	case prm.signer == nil:
+		panicOnPrmValue("signer", prm.signer)
 	}
 
 	// compute optional parameters
@@ -46,6 +50,8 @@ func New(prm Prm, opts ...Option) *Server {
 	return &Server{
 		prm: prm,
 
-		allowedKeys: append(o.allowedKeys, prm.key.PublicKey().Bytes()),
+		allowedKeys: append(o.allowedKeys, prm.signer.PublicKey().Bytes()),
+
+		auditResults: o.auditResults,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 )
@@ -68,6 +69,15 @@ func (s *Server) isValidRequest(req SignedMessage) error {
 
 // SignMessage signs Control service message with private key.
 func SignMessage(key *ecdsa.PrivateKey, msg SignedMessage) error {
+	return signMessage(neofsecdsa.Signer(*key), msg)
+}
+
+// signResponse signs Control service response with the Inner Ring signer.
+func (s *Server) signResponse(msg SignedMessage) error {
+	return signMessage(signer.SDK(s.prm.signer, neofscrypto.ECDSA_SHA512), msg)
+}
+
+func signMessage(sgn neofscrypto.Signer, msg SignedMessage) error {
 	binBody, err := msg.ReadSignedData(nil)
 	if err != nil {
 		return fmt.Errorf("marshal request body: %w", err)
@@ -75,7 +85,7 @@ func SignMessage(key *ecdsa.PrivateKey, msg SignedMessage) error {
 
 	var sig neofscrypto.Signature
 
-	err = sig.Calculate(neofsecdsa.Signer(*key), binBody)
+	err = sig.Calculate(sgn, binBody)
 	if err != nil {
 		return fmt.Errorf("calculate signature: %w", err)
 	}
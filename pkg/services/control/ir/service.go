@@ -20,3 +20,59 @@ func (x *HealthCheckResponse) SetBody(v *HealthCheckResponse_Body) {
 		x.Body = v
 	}
 }
+
+// SetEpoch sets epoch of the audit results.
+func (x *ListAuditResultsRequest_Body) SetEpoch(v uint64) {
+	if x != nil {
+		x.Epoch = v
+	}
+}
+
+// SetPublicKey sets public key of the storage node involved in the checks.
+func (x *ListAuditResultsRequest_Body) SetPublicKey(v []byte) {
+	if x != nil {
+		x.PublicKey = v
+	}
+}
+
+// SetLimit sets maximum number of results in the response.
+func (x *ListAuditResultsRequest_Body) SetLimit(v uint32) {
+	if x != nil {
+		x.Limit = v
+	}
+}
+
+// SetCursor sets cursor of the requested page.
+func (x *ListAuditResultsRequest_Body) SetCursor(v []byte) {
+	if x != nil {
+		x.Cursor = v
+	}
+}
+
+// SetBody sets list audit results request body.
+func (x *ListAuditResultsRequest) SetBody(v *ListAuditResultsRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetResults sets detailed results of the audit checks.
+func (x *ListAuditResultsResponse_Body) SetResults(v []*AuditCheckResult) {
+	if x != nil {
+		x.Results = v
+	}
+}
+
+// SetCursor sets cursor of the next page.
+func (x *ListAuditResultsResponse_Body) SetCursor(v []byte) {
+	if x != nil {
+		x.Cursor = v
+	}
+}
+
+// SetBody sets list audit results response body.
+func (x *ListAuditResultsResponse) SetBody(v *ListAuditResultsResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
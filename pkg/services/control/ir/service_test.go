@@ -1,6 +1,7 @@
 package control_test
 
 import (
+	"bytes"
 	"testing"
 
 	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
@@ -42,3 +43,72 @@ func generateHealthCheckResponseBody() *control.HealthCheckResponse_Body {
 func equalHealthCheckResponseBodies(b1, b2 *control.HealthCheckResponse_Body) bool {
 	return b1.GetHealthStatus() == b2.GetHealthStatus()
 }
+
+func TestListAuditResultsResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateListAuditResultsResponseBody(),
+		new(control.ListAuditResultsResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalListAuditResultsResponseBodies(
+				m1.(*control.ListAuditResultsResponse_Body),
+				m2.(*control.ListAuditResultsResponse_Body),
+			)
+		},
+	)
+}
+
+func generateListAuditResultsResponseBody() *control.ListAuditResultsResponse_Body {
+	r1 := new(control.AuditCheckResult)
+	r1.SetEpoch(13)
+	r1.SetContainerID([]byte{1, 2, 3})
+	r1.SetType(control.AuditCheckType_POR)
+	r1.SetStorageGroupID([]byte{4, 5, 6})
+	r1.SetReason("storage group homomorphic hash mismatch")
+	r1.SetExpectedHash([]byte{7})
+	r1.SetActualHash([]byte{8})
+
+	r2 := new(control.AuditCheckResult)
+	r2.SetEpoch(14)
+	r2.SetContainerID([]byte{1, 2, 3})
+	r2.SetType(control.AuditCheckType_PDP)
+	r2.SetPassed(true)
+	r2.SetObjectID([]byte{9, 10})
+	r2.SetNodes([][]byte{{11}, {12}})
+
+	body := new(control.ListAuditResultsResponse_Body)
+	body.SetResults([]*control.AuditCheckResult{r1, r2})
+	body.SetCursor([]byte{13, 14})
+
+	return body
+}
+
+func equalListAuditResultsResponseBodies(b1, b2 *control.ListAuditResultsResponse_Body) bool {
+	if len(b1.GetResults()) != len(b2.GetResults()) || !bytes.Equal(b1.GetCursor(), b2.GetCursor()) {
+		return false
+	}
+
+	for i := range b1.GetResults() {
+		r1, r2 := b1.GetResults()[i], b2.GetResults()[i]
+
+		if r1.GetEpoch() != r2.GetEpoch() ||
+			!bytes.Equal(r1.GetContainerId(), r2.GetContainerId()) ||
+			r1.GetType() != r2.GetType() ||
+			r1.GetPassed() != r2.GetPassed() ||
+			!bytes.Equal(r1.GetStorageGroupId(), r2.GetStorageGroupId()) ||
+			!bytes.Equal(r1.GetObjectId(), r2.GetObjectId()) ||
+			len(r1.GetNodes()) != len(r2.GetNodes()) ||
+			r1.GetReason() != r2.GetReason() ||
+			!bytes.Equal(r1.GetExpectedHash(), r2.GetExpectedHash()) ||
+			!bytes.Equal(r1.GetActualHash(), r2.GetActualHash()) {
+			return false
+		}
+
+		for j := range r1.GetNodes() {
+			if !bytes.Equal(r1.GetNodes()[j], r2.GetNodes()[j]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -13,3 +13,73 @@ func (x *Signature) SetSign(v []byte) {
 		x.Sign = v
 	}
 }
+
+// SetEpoch sets epoch of the audit.
+func (x *AuditCheckResult) SetEpoch(v uint64) {
+	if x != nil {
+		x.Epoch = v
+	}
+}
+
+// SetContainerID sets identifier of the audited container.
+func (x *AuditCheckResult) SetContainerID(v []byte) {
+	if x != nil {
+		x.ContainerId = v
+	}
+}
+
+// SetType sets type of the check.
+func (x *AuditCheckResult) SetType(v AuditCheckType) {
+	if x != nil {
+		x.Type = v
+	}
+}
+
+// SetPassed sets flag of the passed check.
+func (x *AuditCheckResult) SetPassed(v bool) {
+	if x != nil {
+		x.Passed = v
+	}
+}
+
+// SetStorageGroupID sets identifier of the storage group checked by PoR.
+func (x *AuditCheckResult) SetStorageGroupID(v []byte) {
+	if x != nil {
+		x.StorageGroupId = v
+	}
+}
+
+// SetObjectID sets identifier of the checked object.
+func (x *AuditCheckResult) SetObjectID(v []byte) {
+	if x != nil {
+		x.ObjectId = v
+	}
+}
+
+// SetNodes sets public keys of the storage nodes involved in the check.
+func (x *AuditCheckResult) SetNodes(v [][]byte) {
+	if x != nil {
+		x.Nodes = v
+	}
+}
+
+// SetReason sets description of the check failure.
+func (x *AuditCheckResult) SetReason(v string) {
+	if x != nil {
+		x.Reason = v
+	}
+}
+
+// SetExpectedHash sets expected homomorphic hash in case of mismatch.
+func (x *AuditCheckResult) SetExpectedHash(v []byte) {
+	if x != nil {
+		x.ExpectedHash = v
+	}
+}
+
+// SetActualHash sets actual homomorphic hash in case of mismatch.
+func (x *AuditCheckResult) SetActualHash(v []byte) {
+	if x != nil {
+		x.ActualHash = v
+	}
+}
@@ -35,7 +35,7 @@ func (s *Server) DumpShard(_ context.Context, req *control.DumpShardRequest) (*c
 	resp := new(control.DumpShardResponse)
 	resp.SetBody(new(control.DumpShardResponse_Body))
 
-	err = SignMessage(s.key, resp)
+	err = s.signResponse(resp)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
@@ -46,7 +45,7 @@ func (s *Server) EvacuateShard(_ context.Context, req *control.EvacuateShardRequ
 		},
 	}
 
-	err = SignMessage(s.key, resp)
+	err = s.signResponse(resp)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -77,7 +76,7 @@ func (s *Server) replicate(addr oid.Address, obj *objectSDK.Object) error {
 	}
 
 	nodes := placement.FlattenNodes(ns)
-	bs := (*keys.PublicKey)(&s.key.PublicKey).Bytes()
+	bs := s.signer.PublicKey().Bytes()
 	for i := 0; i < len(nodes); i++ {
 		if bytes.Equal(nodes[i].PublicKey(), bs) {
 			copy(nodes[i:], nodes[i+1:])
@@ -33,7 +33,7 @@ func (s *Server) FlushCache(_ context.Context, req *control.FlushCacheRequest) (
 
 	resp := &control.FlushCacheResponse{Body: &control.FlushCacheResponse_Body{}}
 
-	err = SignMessage(s.key, resp)
+	err = s.signResponse(resp)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
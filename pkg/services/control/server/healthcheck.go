@@ -27,7 +27,7 @@ func (s *Server) HealthCheck(_ context.Context, req *control.HealthCheckRequest)
 	body.SetHealthStatus(s.healthChecker.HealthStatus())
 
 	// sign the response
-	if err := SignMessage(s.key, resp); err != nil {
+	if err := s.signResponse(resp); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -64,7 +64,7 @@ func (s *Server) ListShards(_ context.Context, req *control.ListShardsRequest) (
 	body.SetShards(shardInfos)
 
 	// sign the response
-	if err := SignMessage(s.key, resp); err != nil {
+	if err := s.signResponse(resp); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
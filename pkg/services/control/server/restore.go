@@ -35,7 +35,7 @@ func (s *Server) RestoreShard(_ context.Context, req *control.RestoreShardReques
 	resp := new(control.RestoreShardResponse)
 	resp.SetBody(new(control.RestoreShardResponse_Body))
 
-	err = SignMessage(s.key, resp)
+	err = s.signResponse(resp)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -1,7 +1,6 @@
 package control
 
 import (
-	"crypto/ecdsa"
 	"fmt"
 	"sync/atomic"
 
@@ -10,6 +9,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 )
 
 // Server is an entity that serves
@@ -56,7 +56,7 @@ type NodeState interface {
 type Option func(*cfg)
 
 type cfg struct {
-	key *ecdsa.PrivateKey
+	signer signer.Signer
 
 	allowedKeys [][]byte
 
@@ -79,9 +79,9 @@ type cfg struct {
 // Must be marked as available with [Server.MarkReady] when all the
 // components for serving are ready. Before [Server.MarkReady] call
 // only health checks are available.
-func New(key *ecdsa.PrivateKey, authorizedKeys [][]byte, healthChecker HealthChecker) *Server {
+func New(s signer.Signer, authorizedKeys [][]byte, healthChecker HealthChecker) *Server {
 	cfg := &cfg{
-		key:           key,
+		signer:        s,
 		allowedKeys:   authorizedKeys,
 		healthChecker: healthChecker,
 	}
@@ -49,7 +49,7 @@ func (s *Server) SetNetmapStatus(_ context.Context, req *control.SetNetmapStatus
 	resp.SetBody(body)
 
 	// sign the response
-	if err := SignMessage(s.key, resp); err != nil {
+	if err := s.signResponse(resp); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
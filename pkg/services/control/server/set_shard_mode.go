@@ -56,7 +56,7 @@ func (s *Server) SetShardMode(_ context.Context, req *control.SetShardModeReques
 	resp.SetBody(body)
 
 	// sign the response
-	err = SignMessage(s.key, resp)
+	err = s.signResponse(resp)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -45,7 +45,7 @@ func (s *Server) SynchronizeTree(ctx context.Context, req *control.SynchronizeTr
 	resp := new(control.SynchronizeTreeResponse)
 	resp.SetBody(new(control.SynchronizeTreeResponse_Body))
 
-	err = SignMessage(s.key, resp)
+	err = s.signResponse(resp)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -5,15 +5,18 @@ import (
 	"crypto/elliptic"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
 	eaclV2 "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl/v2"
 	v2 "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/v2"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
@@ -22,18 +25,24 @@ import (
 // constructor.
 type CheckerPrm struct {
 	eaclSrc      container.EACLSource
-	validator    *eaclSDK.Validator
 	localStorage *engine.StorageEngine
 	state        netmap.State
+	metrics      Metrics
 }
 
-func (c *CheckerPrm) SetEACLSource(v container.EACLSource) *CheckerPrm {
-	c.eaclSrc = v
-	return c
+// Metrics tracks the extended ACL decisions of the Checker.
+type Metrics interface {
+	// AddEACLCheckDuration registers the duration of the extended ACL check
+	// resulted in the specified decision.
+	AddEACLCheckDuration(d time.Duration, allowed bool)
 }
 
-func (c *CheckerPrm) SetValidator(v *eaclSDK.Validator) *CheckerPrm {
-	c.validator = v
+type noopMetrics struct{}
+
+func (noopMetrics) AddEACLCheckDuration(time.Duration, bool) {}
+
+func (c *CheckerPrm) SetEACLSource(v container.EACLSource) *CheckerPrm {
+	c.eaclSrc = v
 	return c
 }
 
@@ -47,18 +56,25 @@ func (c *CheckerPrm) SetNetmapState(v netmap.State) *CheckerPrm {
 	return c
 }
 
+// SetMetrics sets the register of the extended ACL decisions. Decisions are
+// not registered by default.
+func (c *CheckerPrm) SetMetrics(v Metrics) *CheckerPrm {
+	c.metrics = v
+	return c
+}
+
 // Checker implements v2.ACLChecker interfaces and provides
 // ACL/eACL validation functionality.
 type Checker struct {
 	eaclSrc      container.EACLSource
-	validator    *eaclSDK.Validator
+	matchers     *eaclMatcher.Cache
 	localStorage *engine.StorageEngine
 	state        netmap.State
+	metrics      Metrics
 }
 
 // Various EACL check errors.
 var (
-	errEACLDeniedByRule         = errors.New("denied by rule")
 	errBearerExpired            = errors.New("bearer token has expired")
 	errBearerInvalidSignature   = errors.New("bearer token has invalid signature")
 	errBearerInvalidContainerID = errors.New("bearer token was created for another container")
@@ -76,18 +92,29 @@ func NewChecker(prm *CheckerPrm) *Checker {
 	}
 
 	panicOnNil("EACLSource", prm.eaclSrc)
-	panicOnNil("EACLValidator", prm.validator)
 	panicOnNil("LocalStorageEngine", prm.localStorage)
 	panicOnNil("NetmapState", prm.state)
 
+	metrics := prm.metrics
+	if metrics == nil {
+		metrics = noopMetrics{}
+	}
+
 	return &Checker{
 		eaclSrc:      prm.eaclSrc,
-		validator:    prm.validator,
+		matchers:     eaclMatcher.NewCache(prm.eaclSrc, eaclMatcher.DefaultCacheSize),
 		localStorage: prm.localStorage,
 		state:        prm.state,
+		metrics:      metrics,
 	}
 }
 
+// InvalidateEACL drops the compiled eACL table of the container. Should be
+// called when the table is changed.
+func (c *Checker) InvalidateEACL(cnr cid.ID) {
+	c.matchers.Invalidate(cnr)
+}
+
 // CheckBasicACL is a main check function for basic ACL.
 func (c *Checker) CheckBasicACL(info v2.RequestInfo) bool {
 	// check basic ACL permissions
@@ -115,6 +142,16 @@ func (c *Checker) StickyBitCheck(info v2.RequestInfo, owner user.ID) bool {
 
 // CheckEACL is a main check function for extended ACL.
 func (c *Checker) CheckEACL(msg any, reqInfo v2.RequestInfo) error {
+	start := time.Now()
+
+	err := c.checkEACL(msg, reqInfo)
+
+	c.metrics.AddEACLCheckDuration(time.Since(start), err == nil)
+
+	return err
+}
+
+func (c *Checker) checkEACL(msg any, reqInfo v2.RequestInfo) error {
 	basicACL := reqInfo.BasicACL()
 	if !basicACL.Extendable() {
 		return nil
@@ -125,22 +162,22 @@ func (c *Checker) CheckEACL(msg any, reqInfo v2.RequestInfo) error {
 		reqInfo.CleanBearer()
 	}
 
-	var table eaclSDK.Table
+	var matcher *eaclMatcher.Matcher
 	cnr := reqInfo.ContainerID()
 
 	bearerTok := reqInfo.Bearer()
 	if bearerTok == nil {
-		eaclInfo, err := c.eaclSrc.GetEACL(cnr)
+		var err error
+
+		matcher, err = c.matchers.Matcher(cnr)
 		if err != nil {
 			if errors.Is(err, apistatus.ErrEACLNotFound) {
 				return nil
 			}
 			return err
 		}
-
-		table = *eaclInfo.Value
 	} else {
-		table = bearerTok.EACLTable()
+		matcher = eaclMatcher.Compile(bearerTok.EACLTable())
 	}
 
 	// if bearer token is not present, isValidBearer returns true
@@ -148,30 +185,6 @@ func (c *Checker) CheckEACL(msg any, reqInfo v2.RequestInfo) error {
 		return err
 	}
 
-	hdrSrcOpts := make([]eaclV2.Option, 0, 3)
-
-	hdrSrcOpts = append(hdrSrcOpts,
-		eaclV2.WithLocalObjectStorage(c.localStorage),
-		eaclV2.WithCID(cnr),
-		eaclV2.WithOID(reqInfo.ObjectID()),
-	)
-
-	if req, ok := msg.(eaclV2.Request); ok {
-		hdrSrcOpts = append(hdrSrcOpts, eaclV2.WithServiceRequest(req))
-	} else {
-		hdrSrcOpts = append(hdrSrcOpts,
-			eaclV2.WithServiceResponse(
-				msg.(eaclV2.Response),
-				reqInfo.Request().(eaclV2.Request),
-			),
-		)
-	}
-
-	hdrSrc, err := eaclV2.NewMessageHeaderSource(hdrSrcOpts...)
-	if err != nil {
-		return fmt.Errorf("can't parse headers: %w", err)
-	}
-
 	var eaclRole eaclSDK.Role
 	switch op := reqInfo.RequestRole(); op {
 	default:
@@ -184,17 +197,47 @@ func (c *Checker) CheckEACL(msg any, reqInfo v2.RequestInfo) error {
 		eaclRole = eaclSDK.RoleOthers
 	}
 
-	action, _ := c.validator.CalculateAction(new(eaclSDK.ValidationUnit).
-		WithRole(eaclRole).
-		WithOperation(eaclSDK.Operation(reqInfo.Operation())).
-		WithContainerID(&cnr).
-		WithSenderKey(reqInfo.SenderKey()).
-		WithHeaderSource(hdrSrc).
-		WithEACLTable(&table),
-	)
+	// headers are composed only if the applicable records have filters since
+	// it may require reading the object from the local storage
+	hdrSrc := func() (eaclSDK.TypedHeaderSource, error) {
+		hdrSrcOpts := make([]eaclV2.Option, 0, 3)
+
+		hdrSrcOpts = append(hdrSrcOpts,
+			eaclV2.WithLocalObjectStorage(c.localStorage),
+			eaclV2.WithCID(cnr),
+			eaclV2.WithOID(reqInfo.ObjectID()),
+		)
+
+		if req, ok := msg.(eaclV2.Request); ok {
+			hdrSrcOpts = append(hdrSrcOpts, eaclV2.WithServiceRequest(req))
+		} else {
+			hdrSrcOpts = append(hdrSrcOpts,
+				eaclV2.WithServiceResponse(
+					msg.(eaclV2.Response),
+					reqInfo.Request().(eaclV2.Request),
+				),
+			)
+		}
+
+		hdrSrc, err := eaclV2.NewMessageHeaderSource(hdrSrcOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("can't parse headers: %w", err)
+		}
+
+		return hdrSrc, nil
+	}
+
+	action, record, err := matcher.Match(eaclSDK.Operation(reqInfo.Operation()), eaclRole, reqInfo.SenderKey(), hdrSrc)
+	if err != nil {
+		return err
+	}
 
 	if action != eaclSDK.ActionAllow {
-		return errEACLDeniedByRule
+		return v2.EACLDeniedError{
+			Record: record,
+			Role:   eaclRole,
+			Bearer: bearerTok != nil,
+		}
 	}
 	return nil
 }
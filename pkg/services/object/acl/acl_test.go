@@ -8,7 +8,6 @@ import (
 	v2 "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/v2"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
-	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
@@ -29,7 +28,6 @@ func (e emptyNetmapState) CurrentEpoch() uint64 {
 func TestStickyCheck(t *testing.T) {
 	checker := NewChecker(new(CheckerPrm).
 		SetLocalStorage(&engine.StorageEngine{}).
-		SetValidator(eaclSDK.NewValidator()).
 		SetEACLSource(emptyEACLSource{}).
 		SetNetmapState(emptyNetmapState{}),
 	)
@@ -0,0 +1,82 @@
+package eacl
+
+import (
+	"sync"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+)
+
+// DefaultCacheSize is a default number of containers with the compiled eACL
+// tables kept by Cache.
+const DefaultCacheSize = 1000
+
+// Cache is an LRU cache of the eACL tables of the containers compiled into
+// Matcher.
+//
+// Table is requested from the source on each access and compiled again once
+// the source returns the new one, so the cached tables are as up-to-date as
+// the source ones. The source is expected to cache the tables itself and to
+// return the same table until it is changed.
+type Cache struct {
+	src container.EACLSource
+
+	mtx sync.Mutex
+	lru *simplelru.LRU[cid.ID, compiledTable]
+}
+
+type compiledTable struct {
+	table *eaclSDK.Table
+
+	matcher *Matcher
+}
+
+// NewCache constructs Cache of the tables from the given source keeping up to
+// size containers. Non-positive size is replaced with DefaultCacheSize.
+func NewCache(src container.EACLSource, size int) *Cache {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+
+	lru, _ := simplelru.NewLRU[cid.ID, compiledTable](size, nil) // no error since size is positive
+
+	return &Cache{
+		src: src,
+		lru: lru,
+	}
+}
+
+// Matcher returns the compiled eACL table of the container. Errors of the
+// source are returned as is.
+func (c *Cache) Matcher(cnr cid.ID) (*Matcher, error) {
+	eACL, err := c.src.GetEACL(cnr)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mtx.Lock()
+	cached, ok := c.lru.Get(cnr)
+	c.mtx.Unlock()
+
+	if ok && cached.table == eACL.Value {
+		return cached.matcher, nil
+	}
+
+	m := Compile(*eACL.Value)
+
+	c.mtx.Lock()
+	c.lru.Add(cnr, compiledTable{table: eACL.Value, matcher: m})
+	c.mtx.Unlock()
+
+	return m, nil
+}
+
+// Invalidate drops the compiled eACL table of the container, e.g. when the
+// table is changed.
+func (c *Cache) Invalidate(cnr cid.ID) {
+	c.mtx.Lock()
+	c.lru.Remove(cnr)
+	c.mtx.Unlock()
+}
@@ -0,0 +1,327 @@
+package eacl
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+)
+
+// Keys of the TokenConstraints, see TokenConstraints.Encode.
+const (
+	// ConstraintMaxOperations is a key of the maximum number of the
+	// operations. Decimal integer is expected.
+	ConstraintMaxOperations = ConstraintPrefix + "MAX_OPERATIONS"
+
+	// ConstraintMaxBytes is a key of the maximum number of the payload bytes
+	// read and written. Decimal integer is expected.
+	ConstraintMaxBytes = ConstraintPrefix + "MAX_BYTES"
+
+	// ConstraintSourceNetworks is a key of the comma-separated list of the
+	// networks in CIDR notation the requests are allowed from.
+	ConstraintSourceNetworks = ConstraintPrefix + "SOURCE_NETWORKS"
+
+	// ConstraintNotBefore is a key of the Unix timestamp in seconds the
+	// token is valid from.
+	ConstraintNotBefore = ConstraintPrefix + "NOT_BEFORE"
+
+	// ConstraintNotAfter is a key of the Unix timestamp in seconds the token
+	// is valid until.
+	ConstraintNotAfter = ConstraintPrefix + "NOT_AFTER"
+)
+
+// ConstraintPrefix is a prefix of the TokenConstraints keys. The eACL filter
+// values with this prefix are reserved for the constraints.
+const ConstraintPrefix = "__NEOFS__CONSTRAINT_"
+
+// constraintKeys are the keys of the TokenConstraints in the encoding order.
+var constraintKeys = []string{
+	ConstraintMaxOperations,
+	ConstraintMaxBytes,
+	ConstraintSourceNetworks,
+	ConstraintNotBefore,
+	ConstraintNotAfter,
+}
+
+// constraintOps are the operations denied by the constraint records on the
+// nodes not aware of them.
+var constraintOps = []eaclSDK.Operation{
+	eaclSDK.OperationGet,
+	eaclSDK.OperationHead,
+	eaclSDK.OperationPut,
+	eaclSDK.OperationDelete,
+	eaclSDK.OperationSearch,
+	eaclSDK.OperationRange,
+	eaclSDK.OperationRangeHash,
+}
+
+// TokenUsage is the consumption of the token.
+type TokenUsage struct {
+	// Number of the operations.
+	Operations uint64
+
+	// Number of the payload bytes read and written.
+	Bytes uint64
+}
+
+// ErrTokenUsageExceeded is returned when the token is used beyond its
+// TokenConstraints.
+var ErrTokenUsageExceeded = errors.New("token usage limit exceeded")
+
+// TokenConstraints are the node-enforced usage limits of the bearer and
+// session tokens.
+//
+// Protocol does not provide the token fields for the limits, so they are
+// encoded in the eACL table of the bearer token, see AddTokenConstraints.
+// Limits of the session token are passed in the X-headers of the request
+// creating the session on the node, see DecodeTokenConstraints. Consumption
+// is tracked by each node independently.
+type TokenConstraints struct {
+	// Maximum consumption of the token, zero fields are unlimited.
+	Limit TokenUsage
+
+	// Networks the requests are allowed from, any if empty.
+	SourceNetworks []*net.IPNet
+
+	// Wall-clock validity window of the token, zero bounds are open.
+	NotBefore, NotAfter time.Time
+}
+
+// IsEmpty checks whether there are no constraints.
+func (c TokenConstraints) IsEmpty() bool {
+	return c.Limit == (TokenUsage{}) && len(c.SourceNetworks) == 0 && c.NotBefore.IsZero() && c.NotAfter.IsZero()
+}
+
+// Encode returns the non-empty constraints by the Constraint* keys.
+func (c TokenConstraints) Encode() map[string]string {
+	res := make(map[string]string)
+
+	if c.Limit.Operations > 0 {
+		res[ConstraintMaxOperations] = strconv.FormatUint(c.Limit.Operations, 10)
+	}
+
+	if c.Limit.Bytes > 0 {
+		res[ConstraintMaxBytes] = strconv.FormatUint(c.Limit.Bytes, 10)
+	}
+
+	if len(c.SourceNetworks) > 0 {
+		nets := make([]string, len(c.SourceNetworks))
+		for i := range c.SourceNetworks {
+			nets[i] = c.SourceNetworks[i].String()
+		}
+
+		res[ConstraintSourceNetworks] = strings.Join(nets, ",")
+	}
+
+	if !c.NotBefore.IsZero() {
+		res[ConstraintNotBefore] = strconv.FormatInt(c.NotBefore.Unix(), 10)
+	}
+
+	if !c.NotAfter.IsZero() {
+		res[ConstraintNotAfter] = strconv.FormatInt(c.NotAfter.Unix(), 10)
+	}
+
+	return res
+}
+
+// DecodeTokenConstraints decodes the constraints encoded by
+// TokenConstraints.Encode. Keys without ConstraintPrefix are ignored.
+func DecodeTokenConstraints(kv map[string]string) (TokenConstraints, error) {
+	var res TokenConstraints
+
+	for k, v := range kv {
+		if !strings.HasPrefix(k, ConstraintPrefix) {
+			continue
+		}
+
+		if err := res.set(k, v); err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
+
+func (c *TokenConstraints) set(key, value string) error {
+	var err error
+
+	switch key {
+	default:
+		return fmt.Errorf("unsupported constraint %s", key)
+	case ConstraintMaxOperations:
+		c.Limit.Operations, err = strconv.ParseUint(value, 10, 64)
+	case ConstraintMaxBytes:
+		c.Limit.Bytes, err = strconv.ParseUint(value, 10, 64)
+	case ConstraintSourceNetworks:
+		c.SourceNetworks, err = parseNetworks(value)
+	case ConstraintNotBefore:
+		c.NotBefore, err = parseUnixTime(value)
+	case ConstraintNotAfter:
+		c.NotAfter, err = parseUnixTime(value)
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid %s constraint: %w", key, err)
+	}
+
+	return nil
+}
+
+// AddTokenConstraints adds the non-empty constraints to the table.
+//
+// Constraints are encoded as the DENY records of all operations and roles
+// placed before the other records. Records have the filters of the
+// acl.FilterObjectContainerID header not equal to the
+// '<key>:<value>' strings, so the nodes not aware of the constraints deny
+// all requests with the token instead of ignoring its limits. The nodes
+// aware of them never apply such records to the requests, see
+// IsConstraintRecord.
+func AddTokenConstraints(table *eaclSDK.Table, c TokenConstraints) {
+	if c.IsEmpty() {
+		return
+	}
+
+	kv := c.Encode()
+	res := eaclSDK.NewTable()
+
+	res.SetVersion(table.Version())
+	if cnr, ok := table.CID(); ok {
+		res.SetCID(cnr)
+	}
+
+	for _, op := range constraintOps {
+		r := eaclSDK.CreateRecord(eaclSDK.ActionDeny, op)
+
+		for _, role := range []eaclSDK.Role{eaclSDK.RoleUser, eaclSDK.RoleOthers} {
+			eaclSDK.AddFormedTarget(r, role)
+		}
+
+		for _, k := range constraintKeys {
+			if v, ok := kv[k]; ok {
+				r.AddFilter(eaclSDK.HeaderFromObject, eaclSDK.MatchStringNotEqual, acl.FilterObjectContainerID, k+":"+v)
+			}
+		}
+
+		res.AddRecord(r)
+	}
+
+	rs := table.Records()
+	for i := range rs {
+		res.AddRecord(&rs[i])
+	}
+
+	*table = *res
+}
+
+// IsConstraintRecord checks whether the record is added by
+// AddTokenConstraints.
+func IsConstraintRecord(r eaclSDK.Record) bool {
+	fs := r.Filters()
+	if r.Action() != eaclSDK.ActionDeny || len(fs) == 0 {
+		return false
+	}
+
+	for i := range fs {
+		if !isConstraintFilter(fs[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isConstraintFilter(f eaclSDK.Filter) bool {
+	return f.From() == eaclSDK.HeaderFromObject && f.Matcher() == eaclSDK.MatchStringNotEqual &&
+		f.Key() == acl.FilterObjectContainerID && strings.HasPrefix(f.Value(), ConstraintPrefix)
+}
+
+// ReadTokenConstraints reads the constraints added to the table by
+// AddTokenConstraints. Constraints of several records are merged, the last
+// value of the same constraint wins.
+func ReadTokenConstraints(table eaclSDK.Table) (TokenConstraints, error) {
+	var res TokenConstraints
+
+	for _, r := range table.Records() {
+		if !IsConstraintRecord(r) {
+			continue
+		}
+
+		for _, f := range r.Filters() {
+			k, v, ok := strings.Cut(f.Value(), ":")
+			if !ok {
+				return res, fmt.Errorf("invalid constraint %s", f.Value())
+			}
+
+			if err := res.set(k, v); err != nil {
+				return res, err
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func parseNetworks(s string) ([]*net.IPNet, error) {
+	ss := strings.Split(s, ",")
+	res := make([]*net.IPNet, len(ss))
+
+	for i := range ss {
+		_, n, err := net.ParseCIDR(strings.TrimSpace(ss[i]))
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = n
+	}
+
+	return res, nil
+}
+
+func parseUnixTime(s string) (time.Time, error) {
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(sec, 0), nil
+}
+
+// CheckRequest checks whether the request from the given address is allowed
+// at the given time.
+func (c TokenConstraints) CheckRequest(now time.Time, src net.IP) error {
+	if !c.NotBefore.IsZero() && now.Before(c.NotBefore) {
+		return fmt.Errorf("token is not valid before %s", c.NotBefore.UTC().Format(time.RFC3339))
+	}
+
+	if !c.NotAfter.IsZero() && now.After(c.NotAfter) {
+		return fmt.Errorf("token is not valid after %s", c.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	if len(c.SourceNetworks) == 0 {
+		return nil
+	}
+
+	if src == nil {
+		return errors.New("unknown request source address")
+	}
+
+	for i := range c.SourceNetworks {
+		if c.SourceNetworks[i].Contains(src) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("requests from %s are not allowed", src)
+}
+
+// Exceeds checks whether the consumption exceeds the limit. Zero limits are
+// never exceeded.
+func (u TokenUsage) Exceeds(limit TokenUsage) bool {
+	return limit.Operations > 0 && u.Operations > limit.Operations ||
+		limit.Bytes > 0 && u.Bytes > limit.Bytes
+}
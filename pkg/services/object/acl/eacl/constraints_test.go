@@ -0,0 +1,138 @@
+package eacl
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenConstraints(t *testing.T) {
+	_, n1, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	_, n2, err := net.ParseCIDR("2001:db8::/32")
+	require.NoError(t, err)
+
+	c := TokenConstraints{
+		Limit:          TokenUsage{Operations: 10, Bytes: 1 << 20},
+		SourceNetworks: []*net.IPNet{n1, n2},
+		NotBefore:      time.Unix(1700000000, 0),
+		NotAfter:       time.Unix(1700003600, 0),
+	}
+
+	table := eaclSDK.NewTable()
+	table.AddRecord(newTestRecord(eaclSDK.ActionAllow, eaclSDK.OperationGet, eaclSDK.RoleOthers))
+
+	AddTokenConstraints(table, TokenConstraints{})
+	require.Len(t, table.Records(), 1)
+
+	AddTokenConstraints(table, c)
+	require.Len(t, table.Records(), 1+len(constraintOps))
+
+	rs := table.Records()
+	for i := range constraintOps {
+		require.True(t, IsConstraintRecord(rs[i]))
+	}
+	require.False(t, IsConstraintRecord(rs[len(rs)-1]), "constraints precede other records")
+
+	res, err := ReadTokenConstraints(*table)
+	require.NoError(t, err)
+	require.Equal(t, c.Limit, res.Limit)
+	require.Equal(t, c.SourceNetworks, res.SourceNetworks)
+	require.True(t, c.NotBefore.Equal(res.NotBefore))
+	require.True(t, c.NotAfter.Equal(res.NotAfter))
+
+	cnr := cidtest.ID()
+	src := StaticHeaderSource{Object: AddressHeaders(cnr, nil)}
+
+	for _, role := range []eaclSDK.Role{eaclSDK.RoleUser, eaclSDK.RoleOthers} {
+		// constraints are never applied to the requests
+		action, record, err := Compile(*table).Match(eaclSDK.OperationGet, role, nil, func() (eaclSDK.TypedHeaderSource, error) {
+			return src, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, eaclSDK.ActionAllow, action)
+		require.NotContains(t, []int{0, 1, 2, 3, 4, 5, 6}, record)
+
+		// but deny them on the nodes not aware of the constraints
+		var unit eaclSDK.ValidationUnit
+		unit.WithContainerID(&cnr).
+			WithOperation(eaclSDK.OperationGet).
+			WithRole(role).
+			WithHeaderSource(src).
+			WithEACLTable(table)
+
+		action, ok := eaclSDK.NewValidator().CalculateAction(&unit)
+		require.True(t, ok)
+		require.Equal(t, eaclSDK.ActionDeny, action)
+	}
+
+	t.Run("encode", func(t *testing.T) {
+		res, err := DecodeTokenConstraints(c.Encode())
+		require.NoError(t, err)
+		require.Equal(t, c.Limit, res.Limit)
+		require.Equal(t, c.SourceNetworks, res.SourceNetworks)
+
+		res, err = DecodeTokenConstraints(map[string]string{"X-Custom": "1"})
+		require.NoError(t, err)
+		require.True(t, res.IsEmpty())
+
+		_, err = DecodeTokenConstraints(map[string]string{ConstraintPrefix + "UNKNOWN": "1"})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		r := eaclSDK.CreateRecord(eaclSDK.ActionDeny, eaclSDK.OperationGet)
+		r.AddFilter(eaclSDK.HeaderFromObject, eaclSDK.MatchStringNotEqual, acl.FilterObjectContainerID, ConstraintSourceNetworks+":10.0.0.1")
+
+		var table eaclSDK.Table
+		table.AddRecord(r)
+
+		_, err := ReadTokenConstraints(table)
+		require.Error(t, err)
+	})
+
+	t.Run("check request", func(t *testing.T) {
+		for _, tc := range []struct {
+			now time.Time
+			src net.IP
+			ok  bool
+		}{
+			{c.NotBefore, net.ParseIP("10.1.2.3"), true},
+			{c.NotAfter, net.ParseIP("2001:db8::1"), true},
+			{c.NotBefore.Add(-time.Second), net.ParseIP("10.1.2.3"), false},
+			{c.NotAfter.Add(time.Second), net.ParseIP("10.1.2.3"), false},
+			{c.NotBefore, net.ParseIP("192.168.0.1"), false},
+			{c.NotBefore, nil, false},
+		} {
+			err := c.CheckRequest(tc.now, tc.src)
+			require.Equal(t, tc.ok, err == nil, "%s from %s: %v", tc.now, tc.src, err)
+		}
+
+		require.NoError(t, TokenConstraints{}.CheckRequest(time.Now(), nil))
+	})
+}
+
+func TestTokenUsage_Exceeds(t *testing.T) {
+	limit := TokenUsage{Operations: 2}
+
+	require.False(t, TokenUsage{Operations: 2, Bytes: 100}.Exceeds(limit))
+	require.True(t, TokenUsage{Operations: 3}.Exceeds(limit))
+
+	limit.Bytes = 100
+	require.True(t, TokenUsage{Bytes: 101}.Exceeds(limit))
+	require.False(t, TokenUsage{}.Exceeds(TokenUsage{}))
+}
+
+func newTestRecord(action eaclSDK.Action, op eaclSDK.Operation, role eaclSDK.Role) *eaclSDK.Record {
+	r := eaclSDK.NewRecord()
+	r.SetAction(action)
+	r.SetOperation(op)
+	eaclSDK.AddFormedTarget(r, role)
+
+	return r
+}
@@ -1,4 +1,4 @@
-package v2
+package eacl
 
 import (
 	"strconv"
@@ -8,8 +8,31 @@ import (
 	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
 
+// StaticHeaderSource is an eaclSDK.TypedHeaderSource with the predefined
+// headers, e.g. for the offline evaluation of the tables.
+type StaticHeaderSource struct {
+	// X-headers of the request.
+	Request []eaclSDK.Header
+
+	// Headers of the requested object, nil if the object is unavailable.
+	Object []eaclSDK.Header
+}
+
+// HeadersOfType implements eaclSDK.TypedHeaderSource.
+func (s StaticHeaderSource) HeadersOfType(typ eaclSDK.FilterHeaderType) ([]eaclSDK.Header, bool) {
+	switch typ {
+	default:
+		return nil, true
+	case eaclSDK.HeaderFromRequest:
+		return s.Request, true
+	case eaclSDK.HeaderFromObject:
+		return s.Object, s.Object != nil
+	}
+}
+
 type sysObjHdr struct {
 	k, v string
 }
@@ -26,7 +49,9 @@ func u64Value(v uint64) string {
 	return strconv.FormatUint(v, 10)
 }
 
-func headersFromObject(obj *object.Object, cnr cid.ID, oid *oid.ID) []eaclSDK.Header {
+// ObjectHeaders returns the eACL headers of the object with the given address
+// including the headers of its parents.
+func ObjectHeaders(obj *object.Object, cnr cid.ID, oid *oid.ID) []eaclSDK.Header {
 	var count int
 	for obj := obj; obj != nil; obj = obj.Parent() {
 		count += 9 + len(obj.Attributes())
@@ -90,3 +115,37 @@ func headersFromObject(obj *object.Object, cnr cid.ID, oid *oid.ID) []eaclSDK.He
 
 	return res
 }
+
+func cidHeader(idCnr cid.ID) sysObjHdr {
+	return sysObjHdr{
+		k: acl.FilterObjectContainerID,
+		v: idCnr.EncodeToString(),
+	}
+}
+
+func oidHeader(obj oid.ID) sysObjHdr {
+	return sysObjHdr{
+		k: acl.FilterObjectID,
+		v: obj.EncodeToString(),
+	}
+}
+
+func ownerIDHeader(ownerID user.ID) sysObjHdr {
+	return sysObjHdr{
+		k: acl.FilterObjectOwnerID,
+		v: ownerID.EncodeToString(),
+	}
+}
+
+// AddressHeaders returns the eACL headers of the object address. Object ID
+// is optional.
+func AddressHeaders(cnr cid.ID, oid *oid.ID) []eaclSDK.Header {
+	hh := make([]eaclSDK.Header, 0, 2)
+	hh = append(hh, cidHeader(cnr))
+
+	if oid != nil {
+		hh = append(hh, oidHeader(*oid))
+	}
+
+	return hh
+}
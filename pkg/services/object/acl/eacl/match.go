@@ -0,0 +1,133 @@
+package eacl
+
+import (
+	"math/big"
+	"strings"
+
+	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+)
+
+// Match is an extended matching operation of the eACL filter.
+//
+// Protocol defines only string equality and inequality, so the extended
+// operations are encoded into the filter value as
+// <ExtendedMatchPrefix><operation>:<operand> (e.g. '__NEOFS__MATCH_NUM_GE:3').
+// Nodes not aware of the extended operations compare such values literally,
+// so the match type of the filter depends on the record action (see
+// EncodeFilter) to fail closed there: filters of the DENY records always match
+// and filters of the ALLOW records never match.
+//
+// The values with ExtendedMatchPrefix are reserved, they are never compared
+// literally. Filters with such values not decoded by DecodeFilter or having
+// the match type not corresponding to the record action are malformed: they
+// always match in the DENY records and never match in the other ones.
+type Match uint8
+
+const (
+	_ Match = iota
+
+	// MatchNumGT is a Match of the integer header values greater than the
+	// operand.
+	MatchNumGT
+
+	// MatchNumGE is a Match of the integer header values greater than or
+	// equal to the operand.
+	MatchNumGE
+
+	// MatchNumLT is a Match of the integer header values less than the
+	// operand.
+	MatchNumLT
+
+	// MatchNumLE is a Match of the integer header values less than or equal
+	// to the operand.
+	MatchNumLE
+
+	// MatchCommonPrefix is a Match of the header values starting with the
+	// operand.
+	MatchCommonPrefix
+)
+
+// ExtendedMatchPrefix is a prefix of the eACL filter values with the extended
+// Match operations.
+const ExtendedMatchPrefix = "__NEOFS__MATCH_"
+
+var matchNames = map[Match]string{
+	MatchNumGT:        "NUM_GT",
+	MatchNumGE:        "NUM_GE",
+	MatchNumLT:        "NUM_LT",
+	MatchNumLE:        "NUM_LE",
+	MatchCommonPrefix: "COMMON_PREFIX",
+}
+
+// String returns the name of the operation used in the encoded filter values.
+func (m Match) String() string {
+	if s, ok := matchNames[m]; ok {
+		return s
+	}
+
+	return "UNKNOWN"
+}
+
+// IsNumeric checks whether the operation compares integers.
+func (m Match) IsNumeric() bool {
+	return m >= MatchNumGT && m <= MatchNumLE
+}
+
+// EncodeFilter returns the match type and the value of the filter with the
+// extended operation in the record with the given action.
+func EncodeFilter(action eaclSDK.Action, m Match, operand string) (eaclSDK.Match, string) {
+	return filterMatchType(action), ExtendedMatchPrefix + m.String() + ":" + operand
+}
+
+// filterMatchType returns the match type of the filters with the extended
+// operations in the records with the given action. Nodes not aware of the
+// extended operations never find the header equal to the encoded value, so
+// the DENY records use inequality to deny the access there.
+func filterMatchType(action eaclSDK.Action) eaclSDK.Match {
+	if action == eaclSDK.ActionDeny {
+		return eaclSDK.MatchStringNotEqual
+	}
+
+	return eaclSDK.MatchStringEqual
+}
+
+// IsExtendedFilter checks whether the filter value is reserved for the
+// extended operations.
+func IsExtendedFilter(f eaclSDK.Filter) bool {
+	return strings.HasPrefix(f.Value(), ExtendedMatchPrefix)
+}
+
+// DecodeFilter returns the extended operation with its operand encoded in the
+// filter by EncodeFilter. The last return value is false if the filter is a
+// plain string one or the encoded value is malformed.
+func DecodeFilter(f eaclSDK.Filter) (Match, string, bool) {
+	if m := f.Matcher(); m != eaclSDK.MatchStringEqual && m != eaclSDK.MatchStringNotEqual || !IsExtendedFilter(f) {
+		return 0, "", false
+	}
+
+	name, operand, ok := strings.Cut(f.Value()[len(ExtendedMatchPrefix):], ":")
+	if !ok {
+		return 0, "", false
+	}
+
+	for m, s := range matchNames {
+		if s == name {
+			if m.IsNumeric() {
+				if _, ok := ParseNumber(operand); !ok {
+					return 0, "", false
+				}
+			}
+
+			return m, operand, true
+		}
+	}
+
+	return 0, "", false
+}
+
+// ParseNumber parses the integer operand or header value of the numeric
+// operations. Decimal integers of any size with an optional sign are
+// supported.
+func ParseNumber(s string) (*big.Int, bool) {
+	return new(big.Int).SetString(s, 10)
+}
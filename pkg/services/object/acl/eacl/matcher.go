@@ -0,0 +1,293 @@
+package eacl
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+
+	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+)
+
+// Matcher is an eACL table compiled for the request matching. Records are
+// indexed by the operation and target, so only the records applicable to the
+// request are checked. Matcher calculates the same action as
+// eaclSDK.Validator does for the source table, except that the filters with
+// the extended Match operations are supported, the filters with the values
+// reserved for them are never compared literally and the records encoding
+// TokenConstraints are skipped.
+//
+// Matcher is immutable and safe for concurrent use.
+type Matcher struct {
+	records []record
+
+	// indices of the records in the table order
+	byRole map[roleTarget][]int
+	byKey  map[keyTarget][]int
+}
+
+type record struct {
+	action eaclSDK.Action
+
+	filters []filter
+}
+
+// filter is an eACL filter with the decoded extended Match.
+type filter struct {
+	from  eaclSDK.FilterHeaderType
+	key   string
+	match eaclSDK.Match
+	value string
+
+	// zero for the plain string filters
+	ext Match
+	// operand of the numeric ext
+	num *big.Int
+
+	// filter with the reserved value is malformed, see Match
+	malformed bool
+}
+
+func compileFilters(action eaclSDK.Action, fs []eaclSDK.Filter) []filter {
+	if len(fs) == 0 {
+		return nil
+	}
+
+	res := make([]filter, len(fs))
+
+	for i := range fs {
+		res[i] = filter{
+			from:  fs[i].From(),
+			key:   fs[i].Key(),
+			match: fs[i].Matcher(),
+			value: fs[i].Value(),
+		}
+
+		if !IsExtendedFilter(fs[i]) {
+			continue
+		}
+
+		ext, operand, ok := DecodeFilter(fs[i])
+		if !ok || fs[i].Matcher() != filterMatchType(action) {
+			res[i].malformed = true
+			continue
+		}
+
+		res[i].ext = ext
+		res[i].value = operand
+
+		if ext.IsNumeric() {
+			res[i].num, _ = ParseNumber(operand)
+		}
+	}
+
+	return res
+}
+
+type roleTarget struct {
+	op   eaclSDK.Operation
+	role eaclSDK.Role
+}
+
+type keyTarget struct {
+	op  eaclSDK.Operation
+	key string
+}
+
+// Compile compiles the eACL table into Matcher.
+func Compile(table eaclSDK.Table) *Matcher {
+	rs := table.Records()
+
+	m := &Matcher{
+		records: make([]record, len(rs)),
+		byRole:  make(map[roleTarget][]int),
+		byKey:   make(map[keyTarget][]int),
+	}
+
+	add := func(list []int, i int) []int {
+		// record may list the same target several times
+		if n := len(list); n > 0 && list[n-1] == i {
+			return list
+		}
+
+		return append(list, i)
+	}
+
+	for i := range rs {
+		if IsConstraintRecord(rs[i]) {
+			// token constraints are never applied to the requests
+			continue
+		}
+
+		m.records[i] = record{
+			action:  rs[i].Action(),
+			filters: compileFilters(rs[i].Action(), rs[i].Filters()),
+		}
+
+		op := rs[i].Operation()
+
+		for _, t := range rs[i].Targets() {
+			if t.Role() == eaclSDK.RoleSystem {
+				// system role access modifications have been deprecated
+				continue
+			}
+
+			if keys := t.BinaryKeys(); len(keys) != 0 {
+				for _, key := range keys {
+					k := keyTarget{op: op, key: string(key)}
+					m.byKey[k] = add(m.byKey[k], i)
+				}
+
+				continue
+			}
+
+			k := roleTarget{op: op, role: t.Role()}
+			m.byRole[k] = add(m.byRole[k], i)
+		}
+	}
+
+	return m
+}
+
+// candidates returns the indices of the records applicable to the request in
+// the table order.
+func (m *Matcher) candidates(op eaclSDK.Operation, role eaclSDK.Role, key []byte) []int {
+	byRole := m.byRole[roleTarget{op: op, role: role}]
+
+	var byKey []int
+	if len(key) != 0 {
+		byKey = m.byKey[keyTarget{op: op, key: string(key)}]
+	}
+
+	if len(byKey) == 0 {
+		return byRole
+	} else if len(byRole) == 0 {
+		return byKey
+	}
+
+	res := make([]int, 0, len(byRole)+len(byKey))
+	res = append(res, byRole...)
+	res = append(res, byKey...)
+
+	sort.Ints(res)
+
+	// remove the records matched by both role and key
+	n := 1
+	for i := 1; i < len(res); i++ {
+		if res[i] != res[n-1] {
+			res[n] = res[i]
+			n++
+		}
+	}
+
+	return res[:n]
+}
+
+// Match calculates the action on the request with the given operation, role
+// and sender key. Headers are requested from the source only if some
+// applicable record has filters. The second return value is the index of the
+// matching record in the table, negative if the action is not produced by the
+// record.
+//
+// If no applicable record matches or some filters can not be checked,
+// ActionAllow is returned with the negative index.
+func (m *Matcher) Match(op eaclSDK.Operation, role eaclSDK.Role, key []byte, hdrSrc func() (eaclSDK.TypedHeaderSource, error)) (eaclSDK.Action, int, error) {
+	var src eaclSDK.TypedHeaderSource
+
+	for _, i := range m.candidates(op, role, key) {
+		r := &m.records[i]
+
+		if len(r.filters) > 0 && src == nil {
+			var err error
+
+			src, err = hdrSrc()
+			if err != nil {
+				return eaclSDK.ActionUnknown, -1, err
+			}
+		}
+
+		switch val := matchFilters(src, r.filters, r.action == eaclSDK.ActionDeny); {
+		case val < 0:
+			// headers of some type could not be composed => allow
+			return eaclSDK.ActionAllow, -1, nil
+		case val == 0:
+			return r.action, i, nil
+		}
+	}
+
+	return eaclSDK.ActionAllow, -1, nil
+}
+
+// matchFilters returns:
+//   - positive value if no matching header is found for at least one filter;
+//   - zero if at least one suitable header is found for all filters;
+//   - negative value if the headers of at least one filter cannot be obtained.
+//
+// Malformed filters match in the DENY records only.
+func matchFilters(src eaclSDK.TypedHeaderSource, filters []filter, deny bool) int {
+	matched := 0
+
+	for i := range filters {
+		f := &filters[i]
+
+		if f.malformed {
+			if deny {
+				matched++
+			}
+
+			continue
+		}
+
+		headers, ok := src.HeadersOfType(f.from)
+		if !ok {
+			return -1
+		}
+
+		for _, h := range headers {
+			if h != nil && h.Key() == f.key && matchHeader(h, f) {
+				matched++
+				break
+			}
+		}
+	}
+
+	return len(filters) - matched
+}
+
+func matchHeader(h eaclSDK.Header, f *filter) bool {
+	if f.ext != 0 {
+		return matchExtended(h.Value(), f)
+	}
+
+	switch f.match {
+	case eaclSDK.MatchStringEqual:
+		return h.Value() == f.value
+	case eaclSDK.MatchStringNotEqual:
+		return h.Value() != f.value
+	default:
+		return false
+	}
+}
+
+func matchExtended(v string, f *filter) bool {
+	if f.ext == MatchCommonPrefix {
+		return strings.HasPrefix(v, f.value)
+	}
+
+	n, ok := ParseNumber(v)
+	if !ok {
+		return false
+	}
+
+	switch c := n.Cmp(f.num); f.ext {
+	case MatchNumGT:
+		return c > 0
+	case MatchNumGE:
+		return c >= 0
+	case MatchNumLT:
+		return c < 0
+	case MatchNumLE:
+		return c <= 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,313 @@
+package eacl
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/stretchr/testify/require"
+)
+
+type testHeader struct {
+	key, value string
+}
+
+func (h testHeader) Key() string {
+	return h.key
+}
+
+func (h testHeader) Value() string {
+	return h.value
+}
+
+func TestMatcher(t *testing.T) {
+	keys := [][]byte{[]byte("key1"), []byte("key2")}
+
+	newRecord := func(action eaclSDK.Action, op eaclSDK.Operation, role eaclSDK.Role, key []byte) *eaclSDK.Record {
+		var tgt eaclSDK.Target
+		if key != nil {
+			tgt.SetBinaryKeys([][]byte{key})
+		} else {
+			tgt.SetRole(role)
+		}
+
+		r := eaclSDK.NewRecord()
+		r.SetAction(action)
+		r.SetOperation(op)
+		r.SetTargets(tgt)
+
+		return r
+	}
+
+	var table eaclSDK.Table
+
+	// key rule preceding the role one
+	table.AddRecord(newRecord(eaclSDK.ActionDeny, eaclSDK.OperationGet, 0, keys[0]))
+	table.AddRecord(newRecord(eaclSDK.ActionAllow, eaclSDK.OperationGet, eaclSDK.RoleOthers, nil))
+
+	// filtered rules
+	r := newRecord(eaclSDK.ActionDeny, eaclSDK.OperationHead, eaclSDK.RoleOthers, nil)
+	r.AddObjectAttributeFilter(eaclSDK.MatchStringEqual, "tag", "secret")
+	table.AddRecord(r)
+
+	r = newRecord(eaclSDK.ActionDeny, eaclSDK.OperationHead, eaclSDK.RoleUser, nil)
+	r.AddFilter(eaclSDK.HeaderFromRequest, eaclSDK.MatchStringNotEqual, "X", "1")
+	table.AddRecord(r)
+
+	// deprecated system role
+	table.AddRecord(newRecord(eaclSDK.ActionDeny, eaclSDK.OperationPut, eaclSDK.RoleSystem, nil))
+
+	// key and role targets in one record
+	r = newRecord(eaclSDK.ActionDeny, eaclSDK.OperationDelete, eaclSDK.RoleUser, nil)
+	var keyTgt eaclSDK.Target
+	keyTgt.SetBinaryKeys(keys)
+	r.SetTargets(append(r.Targets(), keyTgt)...)
+	table.AddRecord(r)
+
+	table.AddRecord(newRecord(eaclSDK.ActionAllow, eaclSDK.OperationDelete, 0, keys[1]))
+
+	m := Compile(table)
+	validator := eaclSDK.NewValidator()
+
+	srcs := []StaticHeaderSource{
+		{},
+		{Object: []eaclSDK.Header{testHeader{"tag", "secret"}}},
+		{Object: []eaclSDK.Header{testHeader{"tag", "public"}}, Request: []eaclSDK.Header{testHeader{"X", "1"}}},
+		{Object: []eaclSDK.Header{}, Request: []eaclSDK.Header{testHeader{"X", "2"}}},
+	}
+
+	ops := []eaclSDK.Operation{
+		eaclSDK.OperationGet,
+		eaclSDK.OperationHead,
+		eaclSDK.OperationPut,
+		eaclSDK.OperationDelete,
+		eaclSDK.OperationSearch,
+	}
+
+	roles := []eaclSDK.Role{eaclSDK.RoleUser, eaclSDK.RoleSystem, eaclSDK.RoleOthers}
+
+	for _, op := range ops {
+		for _, role := range roles {
+			for _, key := range append(keys, []byte("key3")) {
+				for i := range srcs {
+					name := op.String() + "/" + role.String() + "/" + string(key) + "/" + strconv.Itoa(i)
+
+					expAction, expMatched := validator.CalculateAction(new(eaclSDK.ValidationUnit).
+						WithOperation(op).
+						WithRole(role).
+						WithSenderKey(key).
+						WithHeaderSource(srcs[i]).
+						WithEACLTable(&table),
+					)
+
+					action, record, err := m.Match(op, role, key, func() (eaclSDK.TypedHeaderSource, error) {
+						return srcs[i], nil
+					})
+					require.NoError(t, err, name)
+					require.Equal(t, expAction, action, name)
+					require.Equal(t, expMatched, record >= 0, name)
+				}
+			}
+		}
+	}
+
+	t.Run("lazy headers", func(t *testing.T) {
+		errHeaders := errors.New("headers are requested")
+
+		hdrSrc := func() (eaclSDK.TypedHeaderSource, error) {
+			return nil, errHeaders
+		}
+
+		// no filters in the applicable records
+		action, record, err := m.Match(eaclSDK.OperationGet, eaclSDK.RoleOthers, nil, hdrSrc)
+		require.NoError(t, err)
+		require.Equal(t, 1, record)
+		require.Equal(t, eaclSDK.ActionAllow, action)
+
+		_, _, err = m.Match(eaclSDK.OperationHead, eaclSDK.RoleOthers, nil, hdrSrc)
+		require.ErrorIs(t, err, errHeaders)
+	})
+}
+
+func TestMatcherExtended(t *testing.T) {
+	newTable := func(typ eaclSDK.FilterHeaderType, m Match, key, operand string) eaclSDK.Table {
+		r := eaclSDK.NewRecord()
+		r.SetAction(eaclSDK.ActionDeny)
+		r.SetOperation(eaclSDK.OperationGet)
+		eaclSDK.AddFormedTarget(r, eaclSDK.RoleOthers)
+
+		match, value := EncodeFilter(eaclSDK.ActionDeny, m, operand)
+		r.AddFilter(typ, match, key, value)
+
+		var table eaclSDK.Table
+		table.AddRecord(r)
+
+		return table
+	}
+
+	for _, tc := range []struct {
+		m       Match
+		operand string
+		value   string
+		matches bool
+	}{
+		{MatchNumGT, "3", "4", true},
+		{MatchNumGT, "3", "3", false},
+		{MatchNumGE, "3", "3", true},
+		{MatchNumGE, "3", "-10", false},
+		{MatchNumLT, "-1", "-2", true},
+		{MatchNumLT, "10", "9", true},
+		{MatchNumLE, "10", "11", false},
+		{MatchNumLE, "18446744073709551616", "18446744073709551616", true},
+		{MatchNumGT, "3", "not a number", false},
+		// malformed filters of the DENY records always match
+		{MatchNumGT, "not a number", "4", true},
+		{MatchNumGT, "not a number", "not a number", true},
+		{MatchCommonPrefix, "/public/", "/public/file", true},
+		{MatchCommonPrefix, "/public/", "/private/file", false},
+		{MatchCommonPrefix, "", "any", true},
+	} {
+		name := tc.m.String() + "/" + tc.operand + "/" + tc.value
+		exp := eaclSDK.ActionAllow
+		if tc.matches {
+			exp = eaclSDK.ActionDeny
+		}
+
+		for _, typ := range []eaclSDK.FilterHeaderType{eaclSDK.HeaderFromObject, eaclSDK.HeaderFromRequest} {
+			src := StaticHeaderSource{Object: []eaclSDK.Header{}}
+			hdr := testHeader{"Classification", tc.value}
+
+			if typ == eaclSDK.HeaderFromObject {
+				src.Object = append(src.Object, hdr)
+			} else {
+				src.Request = append(src.Request, hdr)
+			}
+
+			action, _, err := Compile(newTable(typ, tc.m, "Classification", tc.operand)).Match(eaclSDK.OperationGet, eaclSDK.RoleOthers, nil,
+				func() (eaclSDK.TypedHeaderSource, error) {
+					return src, nil
+				})
+			require.NoError(t, err, name)
+			require.Equal(t, exp, action, name)
+		}
+	}
+
+	t.Run("encoding", func(t *testing.T) {
+		match, _ := EncodeFilter(eaclSDK.ActionAllow, MatchNumLE, "42")
+		require.Equal(t, eaclSDK.MatchStringEqual, match)
+
+		match, value := EncodeFilter(eaclSDK.ActionDeny, MatchCommonPrefix, "42:1")
+		require.Equal(t, eaclSDK.MatchStringNotEqual, match)
+
+		r := eaclSDK.NewRecord()
+		r.AddObjectAttributeFilter(match, "a", value)
+		r.AddObjectAttributeFilter(eaclSDK.MatchStringEqual, "a", ExtendedMatchPrefix+"NUM_LE:4.2")
+		r.AddObjectAttributeFilter(eaclSDK.MatchStringEqual, "a", ExtendedMatchPrefix+"UNKNOWN:42")
+		r.AddObjectAttributeFilter(eaclSDK.MatchStringEqual, "a", "42")
+
+		m, operand, ok := DecodeFilter(r.Filters()[0])
+		require.True(t, ok)
+		require.Equal(t, MatchCommonPrefix, m)
+		require.Equal(t, "42:1", operand)
+
+		for _, f := range r.Filters()[1:] {
+			_, _, ok = DecodeFilter(f)
+			require.False(t, ok, f.Value())
+		}
+	})
+
+	t.Run("fail closed", func(t *testing.T) {
+		src := StaticHeaderSource{Object: []eaclSDK.Header{testHeader{"a", "1"}}}
+
+		for _, tc := range []struct {
+			name   string
+			action eaclSDK.Action
+			match  eaclSDK.Match
+			value  string
+			exp    eaclSDK.Action
+		}{
+			{"unknown deny", eaclSDK.ActionDeny, eaclSDK.MatchStringNotEqual, ExtendedMatchPrefix + "UNKNOWN:1", eaclSDK.ActionDeny},
+			{"unknown allow", eaclSDK.ActionAllow, eaclSDK.MatchStringEqual, ExtendedMatchPrefix + "UNKNOWN:1", eaclSDK.ActionDeny},
+			{"equal deny", eaclSDK.ActionDeny, eaclSDK.MatchStringEqual, ExtendedMatchPrefix + "NUM_GT:5", eaclSDK.ActionDeny},
+			{"not equal allow", eaclSDK.ActionAllow, eaclSDK.MatchStringNotEqual, ExtendedMatchPrefix + "NUM_LT:5", eaclSDK.ActionDeny},
+			{"valid allow", eaclSDK.ActionAllow, eaclSDK.MatchStringEqual, ExtendedMatchPrefix + "NUM_LT:5", eaclSDK.ActionAllow},
+			{"valid deny", eaclSDK.ActionDeny, eaclSDK.MatchStringNotEqual, ExtendedMatchPrefix + "NUM_GT:5", eaclSDK.ActionAllow},
+		} {
+			r := eaclSDK.NewRecord()
+			r.SetAction(tc.action)
+			r.SetOperation(eaclSDK.OperationGet)
+			eaclSDK.AddFormedTarget(r, eaclSDK.RoleOthers)
+			r.AddObjectAttributeFilter(tc.match, "a", tc.value)
+
+			// access is denied unless the first record allows it
+			deny := eaclSDK.NewRecord()
+			deny.SetAction(eaclSDK.ActionDeny)
+			deny.SetOperation(eaclSDK.OperationGet)
+			eaclSDK.AddFormedTarget(deny, eaclSDK.RoleOthers)
+
+			var table eaclSDK.Table
+			table.AddRecord(r)
+
+			if tc.action == eaclSDK.ActionAllow {
+				table.AddRecord(deny)
+			}
+
+			action, _, err := Compile(table).Match(eaclSDK.OperationGet, eaclSDK.RoleOthers, nil,
+				func() (eaclSDK.TypedHeaderSource, error) {
+					return src, nil
+				})
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.exp, action, tc.name)
+		}
+	})
+}
+
+type testEACLSource struct {
+	tables map[cid.ID]*container.EACL
+}
+
+func (s *testEACLSource) GetEACL(cnr cid.ID) (*container.EACL, error) {
+	eACL, ok := s.tables[cnr]
+	if !ok {
+		return nil, apistatus.ErrEACLNotFound
+	}
+
+	return eACL, nil
+}
+
+func TestCache(t *testing.T) {
+	cnr := cidtest.ID()
+	src := &testEACLSource{tables: make(map[cid.ID]*container.EACL)}
+
+	c := NewCache(src, 1)
+
+	_, err := c.Matcher(cnr)
+	require.ErrorIs(t, err, apistatus.ErrEACLNotFound)
+
+	src.tables[cnr] = &container.EACL{Value: eaclSDK.NewTable()}
+
+	m1, err := c.Matcher(cnr)
+	require.NoError(t, err)
+
+	m2, err := c.Matcher(cnr)
+	require.NoError(t, err)
+	require.Same(t, m1, m2)
+
+	c.Invalidate(cnr)
+
+	m2, err = c.Matcher(cnr)
+	require.NoError(t, err)
+	require.NotSame(t, m1, m2)
+
+	// table changed in the source
+	src.tables[cnr] = &container.EACL{Value: eaclSDK.NewTable()}
+
+	m1, err = c.Matcher(cnr)
+	require.NoError(t, err)
+	require.NotSame(t, m1, m2)
+}
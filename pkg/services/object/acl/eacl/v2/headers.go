@@ -4,15 +4,14 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/nspcc-dev/neofs-api-go/v2/acl"
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	refsV2 "github.com/nspcc-dev/neofs-api-go/v2/refs"
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
-	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
 
 type Option func(*cfg)
@@ -126,14 +125,14 @@ func (h *cfg) readObjectHeaders(dst *headerSource) error {
 				return errMissingOID
 			}
 
-			dst.objectHeaders = addressHeaders(h.cnr, h.obj)
+			dst.objectHeaders = eaclMatcher.AddressHeaders(h.cnr, h.obj)
 		case *objectV2.PutRequest:
 			if v, ok := req.GetBody().GetObjectPart().(*objectV2.PutObjectPartInit); ok {
 				oV2 := new(objectV2.Object)
 				oV2.SetObjectID(v.GetObjectID())
 				oV2.SetHeader(v.GetHeader())
 
-				dst.objectHeaders = headersFromObject(object.NewFromV2(oV2), h.cnr, h.obj)
+				dst.objectHeaders = eaclMatcher.ObjectHeaders(object.NewFromV2(oV2), h.cnr, h.obj)
 			}
 		case *objectV2.SearchRequest:
 			cnrV2 := req.GetBody().GetContainerID()
@@ -145,7 +144,7 @@ func (h *cfg) readObjectHeaders(dst *headerSource) error {
 				}
 			}
 
-			dst.objectHeaders = []eaclSDK.Header{cidHeader(cnr)}
+			dst.objectHeaders = eaclMatcher.AddressHeaders(cnr, nil)
 		}
 	case responseXHeaderSource:
 		switch resp := m.resp.(type) {
@@ -160,7 +159,7 @@ func (h *cfg) readObjectHeaders(dst *headerSource) error {
 				oV2.SetObjectID(v.GetObjectID())
 				oV2.SetHeader(v.GetHeader())
 
-				dst.objectHeaders = headersFromObject(object.NewFromV2(oV2), h.cnr, h.obj)
+				dst.objectHeaders = eaclMatcher.ObjectHeaders(object.NewFromV2(oV2), h.cnr, h.obj)
 			}
 		case *objectV2.HeadResponse:
 			oV2 := new(objectV2.Object)
@@ -186,7 +185,7 @@ func (h *cfg) readObjectHeaders(dst *headerSource) error {
 
 			oV2.SetHeader(hdr)
 
-			dst.objectHeaders = headersFromObject(object.NewFromV2(oV2), h.cnr, h.obj)
+			dst.objectHeaders = eaclMatcher.ObjectHeaders(object.NewFromV2(oV2), h.cnr, h.obj)
 		}
 	}
 
@@ -201,41 +200,9 @@ func (h *cfg) localObjectHeaders(cnr cid.ID, idObj *oid.ID) ([]eaclSDK.Header, b
 
 		obj, err := h.storage.Head(addr)
 		if err == nil {
-			return headersFromObject(obj, cnr, idObj), true
+			return eaclMatcher.ObjectHeaders(obj, cnr, idObj), true
 		}
 	}
 
-	return addressHeaders(cnr, idObj), false
-}
-
-func cidHeader(idCnr cid.ID) sysObjHdr {
-	return sysObjHdr{
-		k: acl.FilterObjectContainerID,
-		v: idCnr.EncodeToString(),
-	}
-}
-
-func oidHeader(obj oid.ID) sysObjHdr {
-	return sysObjHdr{
-		k: acl.FilterObjectID,
-		v: obj.EncodeToString(),
-	}
-}
-
-func ownerIDHeader(ownerID user.ID) sysObjHdr {
-	return sysObjHdr{
-		k: acl.FilterObjectOwnerID,
-		v: ownerID.EncodeToString(),
-	}
-}
-
-func addressHeaders(cnr cid.ID, oid *oid.ID) []eaclSDK.Header {
-	hh := make([]eaclSDK.Header, 0, 2)
-	hh = append(hh, cidHeader(cnr))
-
-	if oid != nil {
-		hh = append(hh, oidHeader(*oid))
-	}
-
-	return hh
+	return eaclMatcher.AddressHeaders(cnr, idObj), false
 }
@@ -0,0 +1,282 @@
+package usage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// PersistentStore is a store of the token consumption kept in the bolt DB,
+// so the consumption survives the node restarts.
+//
+// Consumption is tracked in memory and written to the DB in batches
+// periodically and on Close, so the consumption of the last flush interval
+// may be lost on the node crash.
+type PersistentStore struct {
+	db *bbolt.DB
+
+	l *zap.Logger
+
+	mtx    sync.Mutex
+	tokens map[string]entry
+	dirty  map[string]struct{}
+
+	// serializes the DB writes, so the removed entries are not written back
+	flushMtx sync.Mutex
+
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+}
+
+// Option allows setting optional parameters of the PersistentStore.
+type Option func(*cfg)
+
+type cfg struct {
+	l             *zap.Logger
+	timeout       time.Duration
+	flushInterval time.Duration
+}
+
+func defaultCfg() *cfg {
+	return &cfg{
+		l:             zap.L(),
+		timeout:       time.Second,
+		flushInterval: time.Second,
+	}
+}
+
+// WithLogger returns an option to specify logger.
+func WithLogger(v *zap.Logger) Option {
+	return func(c *cfg) {
+		c.l = v
+	}
+}
+
+// WithTimeout returns option to specify database connection timeout.
+func WithTimeout(v time.Duration) Option {
+	return func(c *cfg) {
+		c.timeout = v
+	}
+}
+
+// WithFlushInterval returns option to specify the interval of writing the
+// consumption to the database.
+func WithFlushInterval(v time.Duration) Option {
+	return func(c *cfg) {
+		c.flushInterval = v
+	}
+}
+
+var usageBucket = []byte("usage")
+
+// entry value is expiration epoch, number of operations and number of bytes,
+// all are little-endian uint64
+const entrySize = 3 * 8
+
+// NewPersistentStore creates, initializes and returns a new PersistentStore
+// instance.
+func NewPersistentStore(path string, opts ...Option) (*PersistentStore, error) {
+	c := defaultCfg()
+
+	for _, o := range opts {
+		o(c)
+	}
+
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{
+		Timeout: c.timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can't open bbolt at %s: %w", path, err)
+	}
+
+	s := &PersistentStore{
+		db:      db,
+		l:       c.l,
+		tokens:  make(map[string]entry),
+		dirty:   make(map[string]struct{}),
+		closeCh: make(chan struct{}),
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(usageBucket)
+		if err != nil {
+			return err
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			if len(v) == entrySize {
+				s.tokens[string(k)] = entry{
+					exp: binary.LittleEndian.Uint64(v),
+					usage: eaclMatcher.TokenUsage{
+						Operations: binary.LittleEndian.Uint64(v[8:]),
+						Bytes:      binary.LittleEndian.Uint64(v[16:]),
+					},
+				}
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("could not init usage bucket: %w", err)
+	}
+
+	s.wg.Add(1)
+	go s.flushLoop(c.flushInterval)
+
+	return s, nil
+}
+
+func (s *PersistentStore) flushLoop(interval time.Duration) {
+	defer s.wg.Done()
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-s.closeCh:
+			return
+		case <-t.C:
+			if err := s.flush(); err != nil {
+				s.l.Error("could not write token consumption", zap.Error(err))
+			}
+		}
+	}
+}
+
+// flush writes the consumption changed since the last flush to the DB.
+func (s *PersistentStore) flush() error {
+	s.flushMtx.Lock()
+	defer s.flushMtx.Unlock()
+
+	s.mtx.Lock()
+	if len(s.dirty) == 0 {
+		s.mtx.Unlock()
+		return nil
+	}
+
+	batch := make(map[string]entry, len(s.dirty))
+	for k := range s.dirty {
+		batch[k] = s.tokens[k]
+	}
+
+	s.dirty = make(map[string]struct{})
+	s.mtx.Unlock()
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(usageBucket)
+
+		for k, e := range batch {
+			v := make([]byte, entrySize)
+			binary.LittleEndian.PutUint64(v, e.exp)
+			binary.LittleEndian.PutUint64(v[8:], e.usage.Operations)
+			binary.LittleEndian.PutUint64(v[16:], e.usage.Bytes)
+
+			if err := b.Put([]byte(k), v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		// retry on the next flush
+		s.mtx.Lock()
+		for k := range batch {
+			if _, ok := s.tokens[k]; ok {
+				s.dirty[k] = struct{}{}
+			}
+		}
+		s.mtx.Unlock()
+	}
+
+	return err
+}
+
+// Consume adds the consumption of the token with the given key expiring after
+// the given epoch. If the total consumption exceeds the limit, it is not
+// applied and eaclMatcher.ErrTokenUsageExceeded is returned.
+func (s *PersistentStore) Consume(key []byte, exp uint64, add, limit eaclMatcher.TokenUsage) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	e := s.tokens[string(key)]
+
+	u, err := consume(e.usage, add, limit)
+	if err != nil {
+		return err
+	}
+
+	s.tokens[string(key)] = entry{exp: exp, usage: u}
+	s.dirty[string(key)] = struct{}{}
+
+	return nil
+}
+
+// RemoveOld removes the consumption of all tokens expired since provided
+// epoch.
+func (s *PersistentStore) RemoveOld(epoch uint64) {
+	s.flushMtx.Lock()
+	defer s.flushMtx.Unlock()
+
+	s.mtx.Lock()
+	for k, e := range s.tokens {
+		if e.exp < epoch {
+			delete(s.tokens, k)
+			delete(s.dirty, k)
+		}
+	}
+	s.mtx.Unlock()
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(usageBucket)
+
+		var expired [][]byte
+
+		err := b.ForEach(func(k, v []byte) error {
+			if len(v) != entrySize || binary.LittleEndian.Uint64(v) < epoch {
+				expired = append(expired, k)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range expired {
+			if err := b.Delete(expired[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.l.Error("could not clean up consumption of the expired tokens",
+			zap.Uint64("epoch", epoch),
+			zap.Error(err),
+		)
+	}
+}
+
+// Close writes the pending consumption and closes database connection.
+func (s *PersistentStore) Close() error {
+	close(s.closeCh)
+	s.wg.Wait()
+
+	err := s.flush()
+	if err != nil {
+		s.l.Error("could not write token consumption", zap.Error(err))
+	}
+
+	return s.db.Close()
+}
@@ -0,0 +1,87 @@
+package usage
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
+	"github.com/stretchr/testify/require"
+)
+
+type store interface {
+	Consume(key []byte, exp uint64, add, limit eaclMatcher.TokenUsage) error
+	RemoveOld(epoch uint64)
+	Close() error
+}
+
+func TestStores(t *testing.T) {
+	persistent, err := NewPersistentStore(filepath.Join(t.TempDir(), "usage"))
+	require.NoError(t, err)
+
+	for name, s := range map[string]store{
+		"temporary":  NewTemporaryStore(),
+		"persistent": persistent,
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() { require.NoError(t, s.Close()) }()
+
+			limit := eaclMatcher.TokenUsage{Operations: 2, Bytes: 10}
+			key1, key2 := []byte("key1"), []byte("key2")
+
+			op := eaclMatcher.TokenUsage{Operations: 1}
+
+			require.NoError(t, s.Consume(key1, 10, op, limit))
+			require.NoError(t, s.Consume(key1, 10, op, limit))
+			require.ErrorIs(t, s.Consume(key1, 10, op, limit), eaclMatcher.ErrTokenUsageExceeded)
+
+			// exceeding consumption is not applied
+			require.ErrorIs(t, s.Consume(key1, 10, eaclMatcher.TokenUsage{Bytes: 11}, limit), eaclMatcher.ErrTokenUsageExceeded)
+			require.NoError(t, s.Consume(key1, 10, eaclMatcher.TokenUsage{Bytes: 10}, limit))
+			require.ErrorIs(t, s.Consume(key1, 10, eaclMatcher.TokenUsage{Bytes: 1}, limit), eaclMatcher.ErrTokenUsageExceeded)
+
+			// tokens are tracked independently
+			require.NoError(t, s.Consume(key2, 20, op, limit))
+			require.NoError(t, s.Consume(key2, 20, op, limit))
+
+			s.RemoveOld(10)
+			require.ErrorIs(t, s.Consume(key1, 10, op, limit), eaclMatcher.ErrTokenUsageExceeded)
+
+			s.RemoveOld(11)
+			require.NoError(t, s.Consume(key1, 10, op, limit))
+			require.ErrorIs(t, s.Consume(key2, 20, op, limit), eaclMatcher.ErrTokenUsageExceeded)
+		})
+	}
+}
+
+func TestPersistentStore_Reopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage")
+
+	s, err := NewPersistentStore(path, WithFlushInterval(time.Hour))
+	require.NoError(t, err)
+
+	limit := eaclMatcher.TokenUsage{Operations: 2}
+	op := eaclMatcher.TokenUsage{Operations: 1}
+	key := []byte("key")
+
+	require.NoError(t, s.Consume(key, 10, op, limit))
+	require.NoError(t, s.Consume(key, 10, op, limit))
+
+	// pending consumption is written on close
+	require.NoError(t, s.Close())
+
+	s, err = NewPersistentStore(path)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, s.Consume(key, 10, op, limit), eaclMatcher.ErrTokenUsageExceeded)
+
+	s.RemoveOld(11)
+	require.NoError(t, s.Close())
+
+	s, err = NewPersistentStore(path)
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, s.Close()) }()
+
+	require.NoError(t, s.Consume(key, 10, op, limit))
+}
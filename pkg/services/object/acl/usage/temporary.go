@@ -0,0 +1,79 @@
+package usage
+
+import (
+	"sync"
+
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
+)
+
+type entry struct {
+	exp uint64
+
+	usage eaclMatcher.TokenUsage
+}
+
+// TemporaryStore is an in-memory store of the token consumption.
+// Must be created only via calling NewTemporaryStore.
+type TemporaryStore struct {
+	mtx sync.Mutex
+
+	tokens map[string]entry
+}
+
+// NewTemporaryStore creates, initializes and returns a new TemporaryStore
+// instance.
+func NewTemporaryStore() *TemporaryStore {
+	return &TemporaryStore{
+		tokens: make(map[string]entry),
+	}
+}
+
+// Consume adds the consumption of the token with the given key expiring after
+// the given epoch. If the total consumption exceeds the limit, it is not
+// applied and eaclMatcher.ErrTokenUsageExceeded is returned.
+func (s *TemporaryStore) Consume(key []byte, exp uint64, add, limit eaclMatcher.TokenUsage) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	e := s.tokens[string(key)]
+
+	u, err := consume(e.usage, add, limit)
+	if err != nil {
+		return err
+	}
+
+	s.tokens[string(key)] = entry{exp: exp, usage: u}
+
+	return nil
+}
+
+// RemoveOld removes the consumption of all tokens expired since provided
+// epoch.
+func (s *TemporaryStore) RemoveOld(epoch uint64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for k, e := range s.tokens {
+		if e.exp < epoch {
+			delete(s.tokens, k)
+		}
+	}
+}
+
+// Close does nothing and returns nil.
+func (s *TemporaryStore) Close() error {
+	return nil
+}
+
+func consume(cur, add, limit eaclMatcher.TokenUsage) (eaclMatcher.TokenUsage, error) {
+	res := eaclMatcher.TokenUsage{
+		Operations: cur.Operations + add.Operations,
+		Bytes:      cur.Bytes + add.Bytes,
+	}
+
+	if res.Exceeds(limit) {
+		return cur, eaclMatcher.ErrTokenUsageExceeded
+	}
+
+	return res, nil
+}
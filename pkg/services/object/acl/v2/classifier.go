@@ -115,6 +115,29 @@ func (c senderClassifier) isContainerKey(
 	return lookupKeyInContainer(nm, owner, idCnr, cnr)
 }
 
+// isNetmapKey checks whether the key belongs to the storage node from the
+// current or previous network map.
+func (c senderClassifier) isNetmapKey(key []byte) (bool, error) {
+	for _, get := range []func(core.Source) (*netmap.NetMap, error){
+		core.GetLatestNetworkMap,
+		core.GetPreviousNetworkMap,
+	} {
+		nm, err := get(c.netmap)
+		if err != nil {
+			return false, err
+		}
+
+		nodes := nm.Nodes()
+		for i := range nodes {
+			if bytes.Equal(nodes[i].PublicKey(), key) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
 func lookupKeyInContainer(
 	nm *netmap.NetMap,
 	owner []byte, idCnr cid.ID,
@@ -0,0 +1,214 @@
+package v2
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	aclV2 "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"google.golang.org/grpc/peer"
+)
+
+// TokenUsageStore tracks the consumption of the bearer and session tokens
+// with the usage limits, see eaclMatcher.TokenConstraints.
+type TokenUsageStore interface {
+	// Consume adds the consumption of the token with the given key expiring
+	// after the given epoch. If the total consumption exceeds the limit, it
+	// must not be applied and eaclMatcher.ErrTokenUsageExceeded must be
+	// returned.
+	Consume(key []byte, exp uint64, add, limit eaclMatcher.TokenUsage) error
+}
+
+// tokenUsage registers the payload transferred with the limited token.
+type tokenUsage struct {
+	store TokenUsageStore
+	info  RequestInfo
+	kind  string
+
+	key   []byte
+	exp   uint64
+	limit eaclMatcher.TokenUsage
+}
+
+// tokenUsages registers the payload transferred with all limited tokens of
+// the request.
+type tokenUsages []*tokenUsage
+
+const accessDeniedConstraintsReasonFmt = "access to operation %s is denied by %s token constraints: %v"
+
+func constraintsErr(info RequestInfo, kind string, err error) error {
+	var errAccessDenied apistatus.ObjectAccessDenied
+	errAccessDenied.WriteReason(fmt.Sprintf(accessDeniedConstraintsReasonFmt, info.operation, kind, err))
+
+	return errAccessDenied
+}
+
+// checkTokenConstraints checks the request against the constraints of the
+// bearer and session tokens applied to it and registers the operation.
+// Returned tokenUsages register the transferred payload.
+func (b Service) checkTokenConstraints(ctx context.Context, info RequestInfo) (tokenUsages, error) {
+	if info.relayed {
+		// checked by the first node
+		return nil, nil
+	}
+
+	var res tokenUsages
+
+	bUsage, err := b.checkBearerConstraints(ctx, info)
+	if err != nil {
+		return nil, err
+	} else if bUsage != nil {
+		res = append(res, bUsage)
+	}
+
+	sUsage, err := b.checkSessionConstraints(ctx, info)
+	if err != nil {
+		return nil, err
+	} else if sUsage != nil {
+		res = append(res, sUsage)
+	}
+
+	return res, nil
+}
+
+// checkBearerConstraints checks the request against the constraints of the
+// bearer token applied to it. Returned tokenUsage is nil if the payload is
+// not limited.
+func (b Service) checkBearerConstraints(ctx context.Context, info RequestInfo) (*tokenUsage, error) {
+	const kind = "bearer"
+
+	tok := info.bearer
+	if tok == nil || !info.basicACL.Extendable() || !info.basicACL.AllowedBearerRules(info.operation) {
+		// token is ignored
+		return nil, nil
+	}
+
+	c, err := eaclMatcher.ReadTokenConstraints(tok.EACLTable())
+	if err != nil {
+		return nil, constraintsErr(info, kind, err)
+	}
+
+	var tokV2 aclV2.BearerToken
+	tok.WriteToV2(&tokV2)
+
+	key := sha256.Sum256(tok.Marshal())
+
+	return b.checkConstraints(ctx, info, kind, c, key[:], tokV2.GetBody().GetLifetime().GetExp())
+}
+
+// checkSessionConstraints checks the request against the constraints of the
+// session created by the node, see session.ServiceExecutor. Returned
+// tokenUsage is nil if the payload is not limited.
+func (b Service) checkSessionConstraints(ctx context.Context, info RequestInfo) (*tokenUsage, error) {
+	const kind = "session"
+
+	tok := info.session
+	if tok == nil || b.sessions == nil {
+		return nil, nil
+	}
+
+	issuer := tok.Issuer()
+	id := tok.ID()
+
+	pTok := b.sessions.Get(issuer, id[:])
+	if pTok == nil {
+		// session is not created by the node
+		return nil, nil
+	}
+
+	c, err := eaclMatcher.DecodeTokenConstraints(pTok.Constraints())
+	if err != nil {
+		return nil, constraintsErr(info, kind, err)
+	}
+
+	h := sha256.New()
+	h.Write(issuer.WalletBytes())
+	h.Write(id[:])
+
+	return b.checkConstraints(ctx, info, kind, c, h.Sum(nil), pTok.ExpiredAt())
+}
+
+func (b Service) checkConstraints(ctx context.Context, info RequestInfo, kind string, c eaclMatcher.TokenConstraints, key []byte, exp uint64) (*tokenUsage, error) {
+	if c.IsEmpty() {
+		return nil, nil
+	}
+
+	if err := c.CheckRequest(time.Now(), sourceIP(ctx)); err != nil {
+		return nil, constraintsErr(info, kind, err)
+	}
+
+	if c.Limit == (eaclMatcher.TokenUsage{}) {
+		return nil, nil
+	}
+
+	u := &tokenUsage{
+		store: b.usage,
+		info:  info,
+		kind:  kind,
+		key:   key,
+		exp:   exp,
+		limit: c.Limit,
+	}
+
+	if err := u.consume(eaclMatcher.TokenUsage{Operations: 1}); err != nil {
+		return nil, err
+	}
+
+	if c.Limit.Bytes == 0 {
+		return nil, nil
+	}
+
+	return u, nil
+}
+
+// consumePayload registers the transferred payload for all tokens.
+func (us tokenUsages) consumePayload(n int) error {
+	if n == 0 {
+		return nil
+	}
+
+	for _, u := range us {
+		if err := u.consume(eaclMatcher.TokenUsage{Bytes: uint64(n)}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *tokenUsage) consume(add eaclMatcher.TokenUsage) error {
+	err := u.store.Consume(u.key, u.exp, add, u.limit)
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, eaclMatcher.ErrTokenUsageExceeded) {
+		return constraintsErr(u.info, u.kind, err)
+	}
+
+	return fmt.Errorf("register %s token usage: %w", u.kind, err)
+}
+
+// sourceIP returns the IP address of the request sender, nil if it is unknown.
+func sourceIP(ctx context.Context) net.IP {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+
+	if addr, ok := p.Addr.(*net.TCPAddr); ok {
+		return addr.IP
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return nil
+	}
+
+	return net.ParseIP(host)
+}
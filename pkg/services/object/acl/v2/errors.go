@@ -1,9 +1,11 @@
 package v2
 
 import (
+	"errors"
 	"fmt"
 
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
 )
 
 const invalidRequestMessage = "malformed request"
@@ -21,17 +23,48 @@ var (
 	errInvalidVerb             = malformedRequestError("session token verb is invalid")
 )
 
+// EACLDeniedError is returned by ACLChecker.CheckEACL when the request is
+// denied by the eACL record.
+type EACLDeniedError struct {
+	// Index of the matching record in the table.
+	Record int
+	// eACL role of the request sender.
+	Role eacl.Role
+	// Whether the table is taken from the bearer token.
+	Bearer bool
+}
+
+func (x EACLDeniedError) Error() string {
+	return "denied by rule"
+}
+
 const accessDeniedACLReasonFmt = "access to operation %s is denied by basic ACL check"
 const accessDeniedEACLReasonFmt = "access to operation %s is denied by extended ACL check: %v"
 
 func basicACLErr(info RequestInfo) error {
+	reason := fmt.Sprintf(accessDeniedACLReasonFmt, info.operation)
+	if info.trace {
+		reason += fmt.Sprintf(" (sender role %s)", info.requestRole)
+	}
+
 	var errAccessDenied apistatus.ObjectAccessDenied
-	errAccessDenied.WriteReason(fmt.Sprintf(accessDeniedACLReasonFmt, info.operation))
+	errAccessDenied.WriteReason(reason)
 
 	return errAccessDenied
 }
 
 func eACLErr(info RequestInfo, err error) error {
+	var denied EACLDeniedError
+	if info.trace && errors.As(err, &denied) {
+		table := "container"
+		if denied.Bearer {
+			table = "bearer token"
+		}
+
+		err = fmt.Errorf("%w #%d of the %s table (sender role %s, eACL role %s)",
+			err, denied.Record, table, info.requestRole, denied.Role)
+	}
+
 	var errAccessDenied apistatus.ObjectAccessDenied
 	errAccessDenied.WriteReason(fmt.Sprintf(accessDeniedEACLReasonFmt, info.operation, err))
 
@@ -4,6 +4,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	objectSvc "github.com/nspcc-dev/neofs-node/pkg/services/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"go.uber.org/zap"
 )
 
@@ -49,3 +50,28 @@ func WithIRFetcher(v InnerRingFetcher) Option {
 		c.irFetcher = v
 	}
 }
+
+// WithRevocationSource returns option to set the source of the revoked tokens.
+// Tokens are not checked for revocation by default.
+func WithRevocationSource(v RevocationSource) Option {
+	return func(c *cfg) {
+		c.revocations = v
+	}
+}
+
+// WithTokenUsageStore returns option to set the store of the bearer token
+// consumption. In-memory store is used by default.
+func WithTokenUsageStore(v TokenUsageStore) Option {
+	return func(c *cfg) {
+		c.usage = v
+	}
+}
+
+// WithSessionSource returns option to set the source of the sessions created
+// by the node, their constraints are enforced. Sessions are not limited by
+// default.
+func WithSessionSource(v util.SessionSource) Option {
+	return func(c *cfg) {
+		c.sessions = v
+	}
+}
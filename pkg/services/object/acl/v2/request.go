@@ -33,7 +33,15 @@ type RequestInfo struct {
 
 	bearer *bearer.Token // bearer token of request
 
+	session *sessionSDK.Object // session token of request
+
 	srcRequest any
+
+	// denial reasons are detailed, see XHeaderACLTrace
+	trace bool
+
+	// request is relayed by the storage node
+	relayed bool
 }
 
 func (r *RequestInfo) SetBasicACL(basicACL acl.Basic) {
@@ -6,9 +6,14 @@ import (
 	"fmt"
 
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	refsV2 "github.com/nspcc-dev/neofs-api-go/v2/refs"
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/acl/usage"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -26,8 +31,11 @@ type Service struct {
 }
 
 type putStreamBasicChecker struct {
+	ctx    context.Context
 	source *Service
 	next   object.PutObjectStream
+
+	usage tokenUsages
 }
 
 type getStreamBasicChecker struct {
@@ -36,6 +44,8 @@ type getStreamBasicChecker struct {
 	object.GetObjectStream
 
 	info RequestInfo
+
+	usage tokenUsages
 }
 
 type rangeStreamBasicChecker struct {
@@ -44,6 +54,8 @@ type rangeStreamBasicChecker struct {
 	object.GetObjectRangeStream
 
 	info RequestInfo
+
+	usage tokenUsages
 }
 
 type searchStreamBasicChecker struct {
@@ -69,11 +81,18 @@ type cfg struct {
 	nm netmap.Source
 
 	next object.ServiceServer
+
+	usage TokenUsageStore
+
+	sessions util.SessionSource
+
+	revocations RevocationSource
 }
 
 func defaultCfg() *cfg {
 	return &cfg{
-		log: zap.L(),
+		log:   zap.L(),
+		usage: usage.NewTemporaryStore(),
 	}
 }
 
@@ -157,17 +176,24 @@ func (b Service) Get(request *objectV2.GetRequest, stream object.GetObjectStream
 		return eACLErr(reqInfo, err)
 	}
 
+	bUsage, err := b.checkTokenConstraints(stream.Context(), reqInfo)
+	if err != nil {
+		return err
+	}
+
 	return b.next.Get(request, &getStreamBasicChecker{
 		GetObjectStream: stream,
 		info:            reqInfo,
 		checker:         b.checker,
+		usage:           bUsage,
 	})
 }
 
 func (b Service) Put(ctx context.Context) (object.PutObjectStream, error) {
 	streamer, err := b.next.Put(ctx)
 
-	return putStreamBasicChecker{
+	return &putStreamBasicChecker{
+		ctx:    ctx,
 		source: &b,
 		next:   streamer,
 	}, err
@@ -186,7 +212,9 @@ func (b Service) Head(
 		return nil, err
 	}
 
-	sTok, err := originalSessionToken(request.GetMetaHeader())
+	meta, vheader := requestOrigin(ctx, request.GetMetaHeader(), request.GetVerificationHeader())
+
+	sTok, err := originalSessionToken(meta)
 	if err != nil {
 		return nil, err
 	}
@@ -198,13 +226,13 @@ func (b Service) Head(
 		}
 	}
 
-	bTok, err := originalBearerToken(request.GetMetaHeader())
+	bTok, err := originalBearerToken(meta)
 	if err != nil {
 		return nil, err
 	}
 
 	req := MetaWithToken{
-		vheader: request.GetVerificationHeader(),
+		vheader: vheader,
 		token:   sTok,
 		bearer:  bTok,
 		src:     request,
@@ -221,6 +249,8 @@ func (b Service) Head(
 		return nil, basicACLErr(reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
 		return nil, eACLErr(reqInfo, err)
+	} else if _, err := b.checkTokenConstraints(ctx, reqInfo); err != nil {
+		return nil, err
 	}
 
 	resp, err := b.next.Head(ctx, request)
@@ -239,7 +269,9 @@ func (b Service) Search(request *objectV2.SearchRequest, stream object.SearchStr
 		return err
 	}
 
-	sTok, err := originalSessionToken(request.GetMetaHeader())
+	meta, vheader := requestOrigin(stream.Context(), request.GetMetaHeader(), request.GetVerificationHeader())
+
+	sTok, err := originalSessionToken(meta)
 	if err != nil {
 		return err
 	}
@@ -251,13 +283,13 @@ func (b Service) Search(request *objectV2.SearchRequest, stream object.SearchStr
 		}
 	}
 
-	bTok, err := originalBearerToken(request.GetMetaHeader())
+	bTok, err := originalBearerToken(meta)
 	if err != nil {
 		return err
 	}
 
 	req := MetaWithToken{
-		vheader: request.GetVerificationHeader(),
+		vheader: vheader,
 		token:   sTok,
 		bearer:  bTok,
 		src:     request,
@@ -272,6 +304,8 @@ func (b Service) Search(request *objectV2.SearchRequest, stream object.SearchStr
 		return basicACLErr(reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
 		return eACLErr(reqInfo, err)
+	} else if _, err := b.checkTokenConstraints(stream.Context(), reqInfo); err != nil {
+		return err
 	}
 
 	return b.next.Search(request, &searchStreamBasicChecker{
@@ -329,6 +363,8 @@ func (b Service) Delete(
 		return nil, basicACLErr(reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
 		return nil, eACLErr(reqInfo, err)
+	} else if _, err := b.checkTokenConstraints(ctx, reqInfo); err != nil {
+		return nil, err
 	}
 
 	return b.next.Delete(ctx, request)
@@ -382,10 +418,16 @@ func (b Service) GetRange(request *objectV2.GetRangeRequest, stream object.GetOb
 		return eACLErr(reqInfo, err)
 	}
 
+	bUsage, err := b.checkTokenConstraints(stream.Context(), reqInfo)
+	if err != nil {
+		return err
+	}
+
 	return b.next.GetRange(request, &rangeStreamBasicChecker{
 		checker:              b.checker,
 		GetObjectRangeStream: stream,
 		info:                 reqInfo,
+		usage:                bUsage,
 	})
 }
 
@@ -437,12 +479,14 @@ func (b Service) GetRangeHash(
 		return nil, basicACLErr(reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
 		return nil, eACLErr(reqInfo, err)
+	} else if _, err := b.checkTokenConstraints(ctx, reqInfo); err != nil {
+		return nil, err
 	}
 
 	return b.next.GetRangeHash(ctx, request)
 }
 
-func (p putStreamBasicChecker) Send(request *objectV2.PutRequest) error {
+func (p *putStreamBasicChecker) Send(request *objectV2.PutRequest) error {
 	body := request.GetBody()
 	if body == nil {
 		return errEmptyBody
@@ -523,20 +567,108 @@ func (p putStreamBasicChecker) Send(request *objectV2.PutRequest) error {
 		} else if err := p.source.checker.CheckEACL(request, reqInfo); err != nil {
 			return eACLErr(reqInfo, err)
 		}
+
+		p.usage, err = p.source.checkTokenConstraints(p.ctx, reqInfo)
+		if err != nil {
+			return err
+		}
+
+		// only container nodes make other nodes pull the replicas
+		if replicationPullRequested(request.GetMetaHeader()) && reqInfo.RequestRole() != acl.RoleContainer {
+			return fmt.Errorf("%s X-header is allowed for the container nodes only", util.XHeaderReplicateFrom)
+		}
+
+		// objects with the system attributes set by the storage nodes are
+		// formed and distributed by the container nodes only
+		if key, ok := nodeAttribute(part.GetHeader()); ok && reqInfo.RequestRole() != acl.RoleContainer {
+			return fmt.Errorf("%s attribute is allowed in the objects from the container nodes only", key)
+		}
+
+		// objects signed by the client are never copied or patched
+		if part.GetSignature() == nil {
+			src, err := originalCopySource(request.GetMetaHeader())
+			if err != nil {
+				return err
+			}
+
+			if src != nil {
+				err = p.source.checkCopySource(request, *src, bTok)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if chunk, ok := part.(*objectV2.PutObjectPartChunk); ok {
+		if err := p.usage.consumePayload(len(chunk.GetChunk())); err != nil {
+			return err
+		}
 	}
 
 	return p.next.Send(request)
 }
 
-func (p putStreamBasicChecker) CloseAndRecv() (*objectV2.PutResponse, error) {
+func (p *putStreamBasicChecker) CloseAndRecv() (*objectV2.PutResponse, error) {
 	return p.next.CloseAndRecv()
 }
 
+// checkCopySource checks that the sender of the PUT request copying or patching
+// the object is allowed to GET the source object.
+func (b Service) checkCopySource(request *objectV2.PutRequest, src oid.Address, bTok *bearer.Token) error {
+	if bTok != nil {
+		if cnr, ok := bTok.EACLTable().CID(); ok && !cnr.Equals(src.Container()) {
+			// token is issued for the destination container
+			bTok = nil
+		}
+	}
+
+	var addrV2 refsV2.Address
+	src.WriteToV2(&addrV2)
+
+	var body objectV2.GetRequestBody
+	body.SetAddress(&addrV2)
+
+	// source object is checked like it is requested by the sender directly,
+	// session token is issued for the destination container, so it is not used
+	var getReq objectV2.GetRequest
+	getReq.SetBody(&body)
+	getReq.SetMetaHeader(request.GetMetaHeader())
+	getReq.SetVerificationHeader(request.GetVerificationHeader())
+
+	req := MetaWithToken{
+		vheader: request.GetVerificationHeader(),
+		bearer:  bTok,
+		src:     &getReq,
+	}
+
+	reqInfo, err := b.findRequestInfo(req, src.Container(), acl.OpObjectGet)
+	if err != nil {
+		return err
+	}
+
+	obj := src.Object()
+	reqInfo.obj = &obj
+
+	if !b.checker.CheckBasicACL(reqInfo) {
+		return basicACLErr(reqInfo)
+	} else if err := b.checker.CheckEACL(&getReq, reqInfo); err != nil {
+		return eACLErr(reqInfo, err)
+	}
+
+	return nil
+}
+
 func (g *getStreamBasicChecker) Send(resp *objectV2.GetResponse) error {
-	if _, ok := resp.GetBody().GetObjectPart().(*objectV2.GetObjectPartInit); ok {
+	switch part := resp.GetBody().GetObjectPart().(type) {
+	case *objectV2.GetObjectPartInit:
 		if err := g.checker.CheckEACL(resp, g.info); err != nil {
 			return eACLErr(g.info, err)
 		}
+	case *objectV2.GetObjectPartChunk:
+		if err := g.usage.consumePayload(len(part.GetChunk())); err != nil {
+			return err
+		}
 	}
 
 	return g.GetObjectStream.Send(resp)
@@ -547,6 +679,12 @@ func (g *rangeStreamBasicChecker) Send(resp *objectV2.GetRangeResponse) error {
 		return eACLErr(g.info, err)
 	}
 
+	if chunk, ok := resp.GetBody().GetRangePart().(*objectV2.GetRangePartChunk); ok {
+		if err := g.usage.consumePayload(len(chunk.GetChunk())); err != nil {
+			return err
+		}
+	}
+
 	return g.GetObjectRangeStream.Send(resp)
 }
 
@@ -582,6 +720,10 @@ func (b Service) findRequestInfo(req MetaWithToken, idCnr cid.ID, op acl.Op) (in
 		}
 	}
 
+	if err := b.checkRevocation(idCnr, req); err != nil {
+		return info, err
+	}
+
 	// find request role and key
 	res, err := b.c.classify(req, idCnr, cnr.Value)
 	if err != nil {
@@ -600,8 +742,51 @@ func (b Service) findRequestInfo(req MetaWithToken, idCnr cid.ID, op acl.Op) (in
 
 	// add bearer token if it is present in request
 	info.bearer = req.bearer
+	info.session = req.token
 
 	info.srcRequest = req.src
+	info.trace = aclTraceRequested(req.src)
+
+	// requests relayed by the storage nodes have been checked against the
+	// token constraints by the first node
+	if req.vheader.GetOrigin() != nil {
+		info.relayed, _ = b.c.isNetmapKey(req.vheader.GetMetaSignature().GetKey())
+	}
 
 	return info, nil
 }
+
+func tokenRevokedErr(kind string) error {
+	var errAccessDenied apistatus.ObjectAccessDenied
+	errAccessDenied.WriteReason(kind + " token is revoked")
+
+	return errAccessDenied
+}
+
+// checkRevocation checks whether the request tokens are revoked in the
+// container.
+func (b Service) checkRevocation(cnr cid.ID, req MetaWithToken) error {
+	if b.revocations == nil {
+		return nil
+	}
+
+	if req.token != nil {
+		revoked, err := b.revocations.IsRevoked(cnr, req.token.Issuer(), objectcore.SessionTokenRevocationID(*req.token))
+		if err != nil {
+			return fmt.Errorf("check session token revocation: %w", err)
+		} else if revoked {
+			return tokenRevokedErr("session")
+		}
+	}
+
+	if req.bearer != nil {
+		revoked, err := b.revocations.IsRevoked(cnr, req.bearer.ResolveIssuer(), objectcore.BearerTokenRevocationID(*req.bearer))
+		if err != nil {
+			return fmt.Errorf("check bearer token revocation: %w", err)
+		} else if revoked {
+			return tokenRevokedErr("bearer")
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package v2
 
 import (
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
 
@@ -26,3 +27,12 @@ type InnerRingFetcher interface {
 	// the actual inner ring.
 	InnerRingKeys() ([][]byte, error)
 }
+
+// RevocationSource is an interface that must provide the tokens revoked in
+// the containers.
+type RevocationSource interface {
+	// IsRevoked must return true if the token with the given revocation ID
+	// (see objectcore.AttributeRevokedToken) issued by the specified user is
+	// revoked in the container.
+	IsRevoked(cnr cid.ID, issuer user.ID, id string) (bool, error)
+}
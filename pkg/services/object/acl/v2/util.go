@@ -1,15 +1,20 @@
 package v2
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	refsV2 "github.com/nspcc-dev/neofs-api-go/v2/refs"
 	sessionV2 "github.com/nspcc-dev/neofs-api-go/v2/session"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -20,6 +25,17 @@ import (
 
 var errMissingContainerID = errors.New("missing container ID")
 
+// requestOrigin returns the meta and verification headers the access is
+// checked against: the headers of the client request the request is
+// synthesized from by the node or the given ones.
+func requestOrigin(ctx context.Context, meta *sessionV2.RequestMetaHeader, vheader *sessionV2.RequestVerificationHeader) (*sessionV2.RequestMetaHeader, *sessionV2.RequestVerificationHeader) {
+	if origin, ok := object.RequestOriginFromContext(ctx); ok {
+		return origin.Meta, origin.Verification
+	}
+
+	return meta, vheader
+}
+
 func getContainerIDFromRequest(req any) (cid.ID, error) {
 	var idV2 *refsV2.ContainerID
 	var id cid.ID
@@ -93,6 +109,85 @@ func originalSessionToken(header *sessionV2.RequestMetaHeader) (*sessionSDK.Obje
 	return &tok, nil
 }
 
+// originalCopySource goes down to original request meta header and reads the
+// address of the copied or patched object from there. Returns nil if the
+// request does not copy or patch objects.
+func originalCopySource(header *sessionV2.RequestMetaHeader) (*oid.Address, error) {
+	for header.GetOrigin() != nil {
+		header = header.GetOrigin()
+	}
+
+	for _, x := range header.GetXHeaders() {
+		if k := x.GetKey(); k != util.XHeaderCopyFrom && k != util.XHeaderPatchFrom {
+			continue
+		}
+
+		var addr oid.Address
+
+		err := addr.DecodeString(x.GetValue())
+		if err != nil {
+			return nil, fmt.Errorf("invalid source object address: %w", err)
+		}
+
+		return &addr, nil
+	}
+
+	return nil, nil
+}
+
+// replicationPullRequested checks whether the request meta header has
+// util.XHeaderReplicateFrom set. Pull requests are sent by the container
+// nodes directly, so the origin headers are not checked.
+func replicationPullRequested(header *sessionV2.RequestMetaHeader) bool {
+	for _, x := range header.GetXHeaders() {
+		if x.GetKey() == util.XHeaderReplicateFrom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nodeAttribute returns the first attribute of the object header or its
+// parent header which is set by the storage nodes only (see
+// objectcore.IsNodeAttribute). Returns false if there are no such attributes.
+func nodeAttribute(hdr *objectV2.Header) (string, bool) {
+	for ; hdr != nil; hdr = hdr.GetSplit().GetParentHeader() {
+		for _, a := range hdr.GetAttributes() {
+			if objectcore.IsNodeAttribute(a.GetKey()) {
+				return a.GetKey(), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// aclTraceRequested checks whether the original request meta header has
+// util.XHeaderACLTrace set.
+func aclTraceRequested(req any) bool {
+	r, ok := req.(interface {
+		GetMetaHeader() *sessionV2.RequestMetaHeader
+	})
+	if !ok {
+		return false
+	}
+
+	header := r.GetMetaHeader()
+	for header.GetOrigin() != nil {
+		header = header.GetOrigin()
+	}
+
+	for _, x := range header.GetXHeaders() {
+		if x.GetKey() == util.XHeaderACLTrace {
+			trace, err := strconv.ParseBool(x.GetValue())
+			return err == nil && trace
+		}
+	}
+
+	return false
+}
+
 // getObjectIDFromRequestBody decodes oid.ID from the common interface of the
 // object reference's holders. Returns an error if object ID is missing in the request.
 func getObjectIDFromRequestBody(body interface{ GetAddress() *refsV2.Address }) (*oid.ID, error) {
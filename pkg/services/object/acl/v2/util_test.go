@@ -1,21 +1,36 @@
 package v2
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/acl/usage"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/session/storage"
 	bearertest "github.com/nspcc-dev/neofs-sdk-go/bearer/test"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	aclsdk "github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	sessionSDK "github.com/nspcc-dev/neofs-sdk-go/session"
 	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
 )
 
 func TestOriginalTokens(t *testing.T) {
@@ -62,6 +77,297 @@ func testGenerateMetaHeader(depth uint32, b *acl.BearerToken, s *session.Token)
 	return metaHeader
 }
 
+func TestOriginalCopySource(t *testing.T) {
+	addr := oidtest.Address()
+
+	var x session.XHeader
+	x.SetKey(util.XHeaderCopyFrom)
+	x.SetValue(addr.EncodeToString())
+
+	var origin session.RequestMetaHeader
+	origin.SetXHeaders([]session.XHeader{x})
+
+	var meta session.RequestMetaHeader
+	meta.SetOrigin(&origin)
+
+	res, err := originalCopySource(&meta)
+	require.NoError(t, err)
+	require.Equal(t, &addr, res)
+
+	res, err = originalCopySource(&origin)
+	require.NoError(t, err)
+	require.Equal(t, &addr, res)
+
+	res, err = originalCopySource(new(session.RequestMetaHeader))
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	x.SetKey(util.XHeaderPatchFrom)
+	origin.SetXHeaders([]session.XHeader{x})
+
+	res, err = originalCopySource(&meta)
+	require.NoError(t, err)
+	require.Equal(t, &addr, res)
+
+	x.SetValue("not an address")
+	origin.SetXHeaders([]session.XHeader{x})
+
+	_, err = originalCopySource(&meta)
+	require.Error(t, err)
+}
+
+func TestReplicationPullRequested(t *testing.T) {
+	var x session.XHeader
+	x.SetKey(util.XHeaderReplicateFrom)
+	x.SetValue("02")
+
+	var meta session.RequestMetaHeader
+	require.False(t, replicationPullRequested(&meta))
+
+	meta.SetXHeaders([]session.XHeader{x})
+	require.True(t, replicationPullRequested(&meta))
+
+	var relayed session.RequestMetaHeader
+	relayed.SetOrigin(&meta)
+	require.False(t, replicationPullRequested(&relayed), "pull requests are not relayed")
+}
+
+func TestNodeAttribute(t *testing.T) {
+	newHeader := func(keys ...string) *objectV2.Header {
+		attrs := make([]objectV2.Attribute, len(keys))
+		for i := range keys {
+			attrs[i].SetKey(keys[i])
+			attrs[i].SetValue("any")
+		}
+
+		var hdr objectV2.Header
+		hdr.SetAttributes(attrs)
+
+		return &hdr
+	}
+
+	_, ok := nodeAttribute(nil)
+	require.False(t, ok)
+
+	_, ok = nodeAttribute(newHeader("key", objectV2.SysAttributeExpEpoch))
+	require.False(t, ok)
+
+	key, ok := nodeAttribute(newHeader("key", ec.AttributeIndex))
+	require.True(t, ok)
+	require.Equal(t, ec.AttributeIndex, key)
+
+	// parent header of the child object
+	var split objectV2.SplitHeader
+	split.SetParentHeader(newHeader(ec.AttributeParent))
+
+	child := newHeader("key")
+	child.SetSplit(&split)
+
+	key, ok = nodeAttribute(child)
+	require.True(t, ok)
+	require.Equal(t, ec.AttributeParent, key)
+}
+
+func TestACLTrace(t *testing.T) {
+	var x session.XHeader
+	x.SetKey(util.XHeaderACLTrace)
+	x.SetValue("true")
+
+	var origin session.RequestMetaHeader
+	origin.SetXHeaders([]session.XHeader{x})
+
+	var meta session.RequestMetaHeader
+	meta.SetOrigin(&origin)
+
+	req := new(objectV2.HeadRequest)
+	req.SetMetaHeader(&meta)
+
+	require.True(t, aclTraceRequested(req))
+	require.False(t, aclTraceRequested(new(objectV2.HeadRequest)))
+	require.False(t, aclTraceRequested(nil))
+
+	info := RequestInfo{
+		operation:   aclsdk.OpObjectHead,
+		requestRole: aclsdk.RoleOthers,
+	}
+
+	denied := EACLDeniedError{Record: 3, Role: eacl.RoleOthers, Bearer: true}
+
+	reason := func(err error) string {
+		var errAccessDenied apistatus.ObjectAccessDenied
+		require.ErrorAs(t, err, &errAccessDenied)
+
+		return errAccessDenied.Reason()
+	}
+
+	require.NotContains(t, reason(eACLErr(info, denied)), "#3")
+	require.NotContains(t, reason(basicACLErr(info)), "OTHERS")
+
+	info.trace = true
+
+	require.Equal(t, "access to operation OBJECT_HEAD is denied by extended ACL check: "+
+		"denied by rule #3 of the bearer token table (sender role OTHERS, eACL role OTHERS)",
+		reason(eACLErr(info, denied)))
+	require.Contains(t, reason(basicACLErr(info)), "sender role OTHERS")
+}
+
+func TestBearerConstraints(t *testing.T) {
+	_, network, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+
+	table := eacl.NewTable()
+	eaclMatcher.AddTokenConstraints(table, eaclMatcher.TokenConstraints{
+		Limit:          eaclMatcher.TokenUsage{Operations: 2, Bytes: 10},
+		SourceNetworks: []*net.IPNet{network},
+		NotAfter:       time.Now().Add(time.Hour),
+	})
+
+	bToken := bearertest.Token(t)
+	bToken.SetEACLTable(*table)
+
+	svc := Service{cfg: &cfg{usage: usage.NewTemporaryStore()}}
+
+	info := RequestInfo{
+		basicACL:  aclsdk.PublicRWExtended,
+		operation: aclsdk.OpObjectGet,
+		bearer:    &bToken,
+	}
+
+	ctxFrom := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 8080},
+		})
+	}
+
+	requireDenied := func(err error) {
+		var errAccessDenied apistatus.ObjectAccessDenied
+		require.ErrorAs(t, err, &errAccessDenied)
+		require.Contains(t, errAccessDenied.Reason(), "bearer token constraints")
+	}
+
+	_, err = svc.checkTokenConstraints(ctxFrom("192.168.1.1"), info)
+	requireDenied(err)
+
+	_, err = svc.checkTokenConstraints(context.Background(), info)
+	requireDenied(err)
+
+	u, err := svc.checkTokenConstraints(ctxFrom("10.1.1.1"), info)
+	require.NoError(t, err)
+	require.Len(t, u, 1)
+
+	require.NoError(t, u.consumePayload(6))
+	requireDenied(u.consumePayload(6))
+	require.NoError(t, u.consumePayload(4))
+
+	_, err = svc.checkTokenConstraints(ctxFrom("10.1.1.1"), info)
+	require.NoError(t, err)
+
+	_, err = svc.checkTokenConstraints(ctxFrom("10.1.1.1"), info)
+	requireDenied(err)
+
+	// relayed requests have been checked by the first node
+	info.relayed = true
+	_, err = svc.checkTokenConstraints(ctxFrom("192.168.1.1"), info)
+	require.NoError(t, err)
+
+	// token is ignored if bearer rules are not allowed
+	info.relayed = false
+	info.basicACL = aclsdk.PublicRW
+	_, err = svc.checkTokenConstraints(ctxFrom("192.168.1.1"), info)
+	require.NoError(t, err)
+}
+
+type testSessions map[string]*storage.PrivateToken
+
+func (x testSessions) Get(owner user.ID, tokenID []byte) *storage.PrivateToken {
+	return x[owner.EncodeToString()+string(tokenID)]
+}
+
+func TestSessionConstraints(t *testing.T) {
+	pk, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	signer := user.NewAutoIDSigner(*pk)
+
+	sToken := sessiontest.ObjectSigned(signer)
+	id := sToken.ID()
+
+	c := eaclMatcher.TokenConstraints{Limit: eaclMatcher.TokenUsage{Operations: 1, Bytes: 10}}
+
+	sessions := testSessions{
+		sToken.Issuer().EncodeToString() + string(id[:]): storage.NewPrivateToken(pk, 10, c.Encode()),
+	}
+
+	svc := Service{cfg: &cfg{usage: usage.NewTemporaryStore(), sessions: sessions}}
+
+	info := RequestInfo{
+		operation: aclsdk.OpObjectGet,
+		session:   &sToken,
+	}
+
+	u, err := svc.checkTokenConstraints(context.Background(), info)
+	require.NoError(t, err)
+	require.Len(t, u, 1)
+
+	var errAccessDenied apistatus.ObjectAccessDenied
+	require.ErrorAs(t, u.consumePayload(11), &errAccessDenied)
+	require.Contains(t, errAccessDenied.Reason(), "session token constraints")
+
+	_, err = svc.checkTokenConstraints(context.Background(), info)
+	require.ErrorAs(t, err, &errAccessDenied)
+
+	// sessions created by other nodes are not limited
+	otherToken := sessiontest.ObjectSigned(signer)
+	info.session = &otherToken
+
+	u, err = svc.checkTokenConstraints(context.Background(), info)
+	require.NoError(t, err)
+	require.Empty(t, u)
+}
+
+type testRevocations map[string]user.ID
+
+func (x testRevocations) IsRevoked(_ cid.ID, issuer user.ID, id string) (bool, error) {
+	owner, ok := x[id]
+	return ok && owner.Equals(issuer), nil
+}
+
+func TestRevocation(t *testing.T) {
+	pk, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	signer := user.NewAutoIDSigner(*pk)
+
+	sToken := sessiontest.ObjectSigned(signer)
+	bToken := bearertest.Token(t)
+	require.NoError(t, bToken.Sign(signer))
+
+	revocations := make(testRevocations)
+	svc := Service{cfg: &cfg{revocations: revocations}}
+	cnr := cidtest.ID()
+
+	req := MetaWithToken{token: &sToken, bearer: &bToken}
+
+	requireRevoked := func(kind string) {
+		var errAccessDenied apistatus.ObjectAccessDenied
+		require.ErrorAs(t, svc.checkRevocation(cnr, req), &errAccessDenied)
+		require.Equal(t, kind+" token is revoked", errAccessDenied.Reason())
+	}
+
+	require.NoError(t, svc.checkRevocation(cnr, req))
+
+	// revocations by the others are ignored
+	revocations[objectcore.SessionTokenRevocationID(sToken)] = usertest.ID(t)
+	revocations[objectcore.BearerTokenRevocationID(bToken)] = usertest.ID(t)
+	require.NoError(t, svc.checkRevocation(cnr, req))
+
+	revocations[objectcore.BearerTokenRevocationID(bToken)] = bToken.ResolveIssuer()
+	requireRevoked("bearer")
+
+	revocations[objectcore.SessionTokenRevocationID(sToken)] = sToken.Issuer()
+	requireRevoked("session")
+
+	// tokens are not checked without the source
+	svc.revocations = nil
+	require.NoError(t, svc.checkRevocation(cnr, req))
+}
+
 func TestIsVerbCompatible(t *testing.T) {
 	// Source: https://nspcc.ru/upload/neofs-spec-latest.pdf#page=28
 	table := map[aclsdk.Op][]sessionSDK.ObjectVerb{
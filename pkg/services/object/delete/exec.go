@@ -2,9 +2,13 @@ package deletesvc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -209,6 +213,175 @@ func (exec *execCtx) supplementBySplitID() bool {
 	}
 }
 
+// excludeShared removes the children listed by the other objects from the
+// members. Children are shared by the objects referencing the removed one, the
+// objects it references and the ones referencing them too, so they are removed
+// with the last object listing them.
+func (exec *execCtx) excludeShared() bool {
+	if exec.splitInfo == nil {
+		return true
+	}
+
+	shared, err := exec.sharedMembers()
+
+	switch {
+	default:
+		exec.status = statusUndefined
+		exec.err = err
+
+		exec.log.Debug("could not collect shared children",
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	case err == nil:
+		exec.status = statusOK
+		exec.err = nil
+
+		if len(shared) == 0 {
+			return true
+		}
+
+		members := exec.tombstone.Members()
+		kept := members[:0]
+
+		for i := range members {
+			if _, ok := shared[members[i]]; !ok {
+				kept = append(kept, members[i])
+			}
+		}
+
+		exec.tombstone.SetMembers(kept)
+
+		return true
+	}
+}
+
+func (exec *execCtx) sharedMembers() (map[oid.ID]struct{}, error) {
+	self := exec.address().Object()
+
+	origins, err := exec.svc.header.sharedOrigins(exec)
+	if err != nil {
+		return nil, fmt.Errorf("read shared objects: %w", err)
+	}
+
+	listing := make(map[oid.ID]struct{})
+
+	for _, id := range append(origins, self) {
+		if id != self {
+			listing[id] = struct{}{}
+		}
+
+		ids, err := exec.svc.searcher.sharingObjects(exec, id)
+		if err != nil {
+			return nil, fmt.Errorf("search for objects sharing children of %s: %w", id, err)
+		}
+
+		for i := range ids {
+			if ids[i] != self {
+				listing[ids[i]] = struct{}{}
+			}
+		}
+	}
+
+	members := make(map[oid.ID]struct{})
+	for _, id := range exec.tombstone.Members() {
+		members[id] = struct{}{}
+	}
+
+	// split chains of the members are read once for all listing objects
+	memberChains := make(map[oid.ID]string)
+	shared := make(map[oid.ID]struct{})
+
+	for id := range listing {
+		link, err := exec.svc.header.linking(exec, id)
+		if err != nil {
+			if isRemoved(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("read linking object of %s: %w", id, err)
+		}
+
+		if link == nil {
+			continue
+		}
+
+		chains, err := listedChains(id, link)
+		if err != nil {
+			exec.log.Debug("ignore children listed by the object",
+				zap.Stringer("id", id),
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		for _, child := range link.Children() {
+			if _, ok := members[child]; !ok {
+				continue
+			}
+
+			chain, ok := memberChains[child]
+			if !ok {
+				splitID, err := exec.svc.header.splitID(exec, child)
+				if err != nil && !isRemoved(err) {
+					return nil, fmt.Errorf("read split ID of %s: %w", child, err)
+				}
+
+				if splitID != nil {
+					chain = splitID.String()
+				}
+
+				memberChains[child] = chain
+			}
+
+			// children of the other chains are listed by the other objects
+			// only by mistake or on purpose, they are not shared
+			if _, ok := chains[chain]; ok {
+				shared[child] = struct{}{}
+			}
+		}
+	}
+
+	return shared, nil
+}
+
+// listedChains returns split IDs of the chains the listed children of which
+// are shared by the object: its own chain and the chains of the objects it
+// references.
+func listedChains(id oid.ID, link *object.Object) (map[string]struct{}, error) {
+	if parID, ok := link.ParentID(); !ok || parID != id {
+		return nil, errors.New("linking object belongs to the other object")
+	}
+
+	par := link.Parent()
+	if par == nil {
+		return nil, errors.New("missing parent header in the linking object")
+	}
+
+	chains, err := objectcore.SharedChains(par)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]struct{}, len(chains)+1)
+
+	if splitID := link.SplitID(); splitID != nil {
+		res[splitID.String()] = struct{}{}
+	}
+
+	for i := range chains {
+		res[chains[i].String()] = struct{}{}
+	}
+
+	return res, nil
+}
+
+func isRemoved(err error) bool {
+	return errors.As(err, new(apistatus.ObjectAlreadyRemoved)) || errors.As(err, new(apistatus.ObjectNotFound))
+}
+
 func (exec *execCtx) addMembers(incoming []oid.ID) {
 	members := exec.tombstone.Members()
 
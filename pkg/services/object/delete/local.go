@@ -54,6 +54,11 @@ func (exec *execCtx) formTombstone() (ok bool) {
 		return
 	}
 
+	ok = exec.excludeShared()
+	if !ok {
+		return
+	}
+
 	exec.log.Debug("members successfully collected")
 
 	ok = exec.initTombstoneObject()
@@ -44,10 +44,23 @@ type cfg struct {
 
 		// must return (nil, nil) for 1st object in chain
 		previous(*execCtx, oid.ID) (*oid.ID, error)
+
+		// must return nil for objects sharing no children
+		sharedOrigins(*execCtx) ([]oid.ID, error)
+
+		// must return the linking object of the split object, nil for the
+		// objects without children
+		linking(*execCtx, oid.ID) (*object.Object, error)
+
+		// must return nil for the objects out of the split chains
+		splitID(*execCtx, oid.ID) (*object.SplitID, error)
 	}
 
 	searcher interface {
 		splitMembers(*execCtx) ([]oid.ID, error)
+
+		// must return objects sharing children of the given one
+		sharingObjects(*execCtx, oid.ID) ([]oid.ID, error)
 	}
 
 	placer interface {
@@ -2,7 +2,9 @@ package deletesvc
 
 import (
 	"errors"
+	"fmt"
 
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
@@ -81,6 +83,56 @@ func (w *headSvcWrapper) previous(exec *execCtx, id oid.ID) (*oid.ID, error) {
 	return nil, nil
 }
 
+func (w *headSvcWrapper) sharedOrigins(exec *execCtx) ([]oid.ID, error) {
+	wr := getsvc.NewSimpleObjectWriter()
+
+	p := getsvc.HeadPrm{}
+	p.SetCommonParameters(exec.commonParameters())
+	p.SetHeaderWriter(wr)
+	p.WithAddress(exec.address())
+
+	err := (*getsvc.Service)(w).Head(exec.context(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	return objectcore.SharedOrigins(wr.Object())
+}
+
+func (w *headSvcWrapper) linking(exec *execCtx, id oid.ID) (*object.Object, error) {
+	_, err := w.headAddress(exec, exec.newAddress(id))
+
+	var errSplitInfo *object.SplitInfoError
+
+	switch {
+	case err == nil:
+		return nil, nil
+	case !errors.As(err, &errSplitInfo):
+		return nil, err
+	}
+
+	link, ok := errSplitInfo.SplitInfo().Link()
+	if !ok {
+		return nil, fmt.Errorf("missing linking object of %s", id)
+	}
+
+	linking, err := w.headAddress(exec, exec.newAddress(link))
+	if err != nil {
+		return nil, fmt.Errorf("read linking object %s: %w", link, err)
+	}
+
+	return linking, nil
+}
+
+func (w *headSvcWrapper) splitID(exec *execCtx, id oid.ID) (*object.SplitID, error) {
+	h, err := w.headAddress(exec, exec.newAddress(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return h.SplitID(), nil
+}
+
 func (w *searchSvcWrapper) splitMembers(exec *execCtx) ([]oid.ID, error) {
 	fs := object.SearchFilters{}
 	if splitID := exec.splitInfo.SplitID(); splitID != nil {
@@ -105,6 +157,27 @@ func (w *searchSvcWrapper) splitMembers(exec *execCtx) ([]oid.ID, error) {
 	return wr.ids, nil
 }
 
+func (w *searchSvcWrapper) sharingObjects(exec *execCtx, id oid.ID) ([]oid.ID, error) {
+	fs := object.SearchFilters{}
+	fs.AddRootFilter()
+	fs.AddFilter(objectcore.SharedAttribute(id), "", object.MatchCommonPrefix)
+
+	wr := new(simpleIDWriter)
+
+	p := searchsvc.Prm{}
+	p.SetWriter(wr)
+	p.SetCommonParameters(exec.commonParameters())
+	p.WithContainerID(exec.containerID())
+	p.WithSearchFilters(fs)
+
+	err := (*searchsvc.Service)(w).Search(exec.context(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	return wr.ids, nil
+}
+
 func (s *simpleIDWriter) WriteIDs(ids []oid.ID) error {
 	s.ids = append(s.ids, ids...)
 
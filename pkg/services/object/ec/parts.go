@@ -0,0 +1,194 @@
+package ecsvc
+
+import (
+	"context"
+	"fmt"
+
+	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	netmapCore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
+	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+type ClientConstructor interface {
+	Get(clientcore.NodeInfo) (clientcore.Client, error)
+}
+
+// RemotePartSource represents utility for getting the parts of the
+// erasure-coded objects from a remote host. Parts are requested on behalf of
+// the local node.
+type RemotePartSource struct {
+	keyStorage *util.KeyStorage
+
+	clientCache ClientConstructor
+}
+
+const remoteOpTTL = 1
+
+// NewRemotePartSource creates, initializes and returns new RemotePartSource
+// instance.
+func NewRemotePartSource(keyStorage *util.KeyStorage, cache ClientConstructor) *RemotePartSource {
+	return &RemotePartSource{
+		keyStorage:  keyStorage,
+		clientCache: cache,
+	}
+}
+
+// remoteClient groups the client of the remote node with its network
+// addresses and the local node signer.
+type remoteClient struct {
+	clientcore.Client
+
+	addrs  network.AddressGroup
+	signer signer.Signer
+}
+
+func (s *RemotePartSource) client(node netmap.NodeInfo) (remoteClient, error) {
+	nodeSigner, err := s.keyStorage.GetSigner(nil)
+	if err != nil {
+		return remoteClient{}, fmt.Errorf("(%T) could not receive private key: %w", s, err)
+	}
+
+	var info clientcore.NodeInfo
+
+	err = clientcore.NodeInfoFromRawNetmapElement(&info, netmapCore.Node(node))
+	if err != nil {
+		return remoteClient{}, fmt.Errorf("parse client node info: %w", err)
+	}
+
+	c, err := s.clientCache.Get(info)
+	if err != nil {
+		return remoteClient{}, fmt.Errorf("(%T) could not create SDK client %s: %w", s, info.AddressGroup(), err)
+	}
+
+	return remoteClient{Client: c, addrs: info.AddressGroup(), signer: nodeSigner}, nil
+}
+
+// Heads requests headers of all parts of the parent object stored on the
+// remote node. Returns empty list if the node has no parts.
+func (s *RemotePartSource) Heads(ctx context.Context, node netmap.NodeInfo, cnr cid.ID, parent oid.ID) ([]*object.Object, error) {
+	c, err := s.client(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var searchPrm internalclient.SearchObjectsPrm
+
+	searchPrm.SetContext(ctx)
+	searchPrm.SetClient(c.Client)
+	searchPrm.SetSigner(c.signer)
+	searchPrm.SetTTL(remoteOpTTL)
+	searchPrm.SetContainerID(cnr)
+	searchPrm.SetFilters(ec.SearchFilters(parent))
+
+	res, err := internalclient.SearchObjects(searchPrm)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not search parts in %s: %w", s, c.addrs, err)
+	}
+
+	ids := res.IDList()
+	hdrs := make([]*object.Object, 0, len(ids))
+
+	for i := range ids {
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(ids[i])
+
+		var headPrm internalclient.HeadObjectPrm
+
+		headPrm.SetContext(ctx)
+		headPrm.SetClient(c.Client)
+		headPrm.SetSigner(c.signer)
+		headPrm.SetTTL(remoteOpTTL)
+		headPrm.SetAddress(addr)
+
+		res, err := internalclient.HeadObject(headPrm)
+		if err != nil {
+			return nil, fmt.Errorf("(%T) could not head part %s from %s: %w", s, ids[i], c.addrs, err)
+		}
+
+		// HEAD response does not carry the object ID
+		hdr := res.Header()
+		hdr.SetID(ids[i])
+
+		hdrs = append(hdrs, hdr)
+	}
+
+	return hdrs, nil
+}
+
+// Get requests the part with the given address stored on the remote node.
+func (s *RemotePartSource) Get(ctx context.Context, node netmap.NodeInfo, addr oid.Address) (*object.Object, error) {
+	c, err := s.client(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.GetObjectPrm
+
+	prm.SetContext(ctx)
+	prm.SetClient(c.Client)
+	prm.SetSigner(c.signer)
+	prm.SetTTL(remoteOpTTL)
+	prm.SetAddress(addr)
+
+	res, err := internalclient.GetObject(prm)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not get part %s from %s: %w", s, addr.Object(), c.addrs, err)
+	}
+
+	return res.Object(), nil
+}
+
+// LocalHeads returns headers of all parts of the parent object stored in the
+// local storage.
+func LocalHeads(e *engine.StorageEngine, cnr cid.ID, parent oid.ID) ([]*object.Object, error) {
+	addrs, err := engine.Select(e, cnr, ec.SearchFilters(parent))
+	if err != nil {
+		return nil, fmt.Errorf("select parts: %w", err)
+	}
+
+	hdrs := make([]*object.Object, 0, len(addrs))
+
+	for i := range addrs {
+		hdr, err := engine.Head(e, addrs[i])
+		if err != nil {
+			return nil, fmt.Errorf("head part %s: %w", addrs[i], err)
+		}
+
+		hdrs = append(hdrs, hdr)
+	}
+
+	return hdrs, nil
+}
+
+// LocalPartSource represents utility for getting the parts of the
+// erasure-coded objects from the local storage.
+type LocalPartSource struct {
+	e *engine.StorageEngine
+}
+
+// NewLocalPartSource creates, initializes and returns new LocalPartSource
+// instance.
+func NewLocalPartSource(e *engine.StorageEngine) *LocalPartSource {
+	return &LocalPartSource{e: e}
+}
+
+// Heads returns headers of all parts of the parent object stored in the
+// local storage.
+func (s *LocalPartSource) Heads(cnr cid.ID, parent oid.ID) ([]*object.Object, error) {
+	return LocalHeads(s.e, cnr, parent)
+}
+
+// Get returns the part with the given address from the local storage.
+func (s *LocalPartSource) Get(addr oid.Address) (*object.Object, error) {
+	return engine.Get(s.e, addr)
+}
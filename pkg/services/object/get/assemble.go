@@ -1,6 +1,8 @@
 package getsvc
 
 import (
+	"context"
+
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -148,6 +150,11 @@ func (exec *execCtx) initFromChild(obj oid.ID) (prev *oid.ID, children []oid.ID)
 func (exec *execCtx) overtakePayloadDirectly(children []oid.ID, rngs []objectSDK.Range, checkRight bool) {
 	withRng := len(rngs) > 0 && exec.ctxRange() != nil
 
+	if exec.svc.assemblyWindow > 1 && len(children) > 1 {
+		exec.overtakePayloadConcurrently(children, rngs, withRng, checkRight)
+		return
+	}
+
 	for i := range children {
 		var r *objectSDK.Range
 		if withRng {
@@ -168,6 +175,66 @@ func (exec *execCtx) overtakePayloadDirectly(children []oid.ID, rngs []objectSDK
 	exec.err = nil
 }
 
+// childResult is a result of the child object fetched ahead.
+type childResult struct {
+	obj *objectSDK.Object
+	st  statusError
+}
+
+// overtakePayloadConcurrently reads up to the configured number of children
+// ahead of the one being written. Payloads are written in the original order,
+// so at most a window of children is kept in memory.
+func (exec *execCtx) overtakePayloadConcurrently(children []oid.ID, rngs []objectSDK.Range, withRng, checkRight bool) {
+	ctx, cancel := context.WithCancel(exec.context())
+	defer cancel()
+
+	// set once to avoid concurrent modification of the shared parameters
+	exec.prm.common = exec.prm.common.WithLocalOnly(false)
+
+	results := make([]chan childResult, len(children))
+
+	fetch := func(i int) {
+		var r *objectSDK.Range
+		if withRng {
+			r = &rngs[i]
+		}
+
+		results[i] = make(chan childResult, 1)
+
+		go func(res chan<- childResult) {
+			obj, st := exec.fetchChild(ctx, children[i], r, !withRng && checkRight)
+			res <- childResult{obj: obj, st: st}
+		}(results[i])
+	}
+
+	next := 0
+	for ; next < len(children) && next < exec.svc.assemblyWindow; next++ {
+		fetch(next)
+	}
+
+	for i := range children {
+		res := <-results[i]
+		results[i] = nil
+
+		exec.statusError = res.st
+		if res.st.status != statusOK {
+			return
+		}
+
+		if next < len(children) {
+			fetch(next)
+			next++
+		}
+
+		if ok := exec.writeObjectPayload(res.obj); !ok {
+			return
+		}
+	}
+
+	exec.status = statusOK
+	exec.err = nil
+}
+
 func (exec *execCtx) overtakePayloadInReverse(prev oid.ID) bool {
 	chain, rngs, ok := exec.buildChainInReverse(prev)
 	if !ok {
@@ -0,0 +1,204 @@
+package getsvc
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// CacheMetrics tracks the statistics of the read object cache.
+type CacheMetrics interface {
+	// AddObjectCacheRequest registers the cache lookup.
+	AddObjectCacheRequest(hit bool)
+	// SetObjectCacheSize sets the total payload size of the cached objects.
+	SetObjectCacheSize(size uint64)
+}
+
+type noopCacheMetrics struct{}
+
+func (noopCacheMetrics) AddObjectCacheRequest(bool) {}
+
+func (noopCacheMetrics) SetObjectCacheSize(uint64) {}
+
+// objectCache is an in-memory LRU cache of the regular objects read by the
+// Service. The cache is limited by the total payload size of the objects.
+type objectCache struct {
+	mtx sync.Mutex
+
+	lru *simplelru.LRU[oid.Address, cachedObject]
+
+	size, capacity uint64
+
+	maxObjectSize uint64
+
+	ttl time.Duration
+
+	metrics CacheMetrics
+}
+
+type cachedObject struct {
+	obj *object.Object
+
+	// 0 if the object does not expire
+	expiration uint64
+
+	stored time.Time
+}
+
+// maxCachedObjects limits the number of small objects in the cache, it is
+// the total payload size that is expected to be the limit in practice.
+const maxCachedObjects = 1 << 20
+
+func newObjectCache(capacity, maxObjectSize uint64, ttl time.Duration) *objectCache {
+	c := &objectCache{
+		capacity:      capacity,
+		maxObjectSize: maxObjectSize,
+		ttl:           ttl,
+		metrics:       noopCacheMetrics{},
+	}
+
+	// error is returned for non-positive sizes only
+	c.lru, _ = simplelru.NewLRU[oid.Address, cachedObject](maxCachedObjects, func(_ oid.Address, v cachedObject) {
+		c.size -= v.obj.PayloadSize()
+	})
+
+	return c
+}
+
+// get returns the cached object. Expired and outdated objects are removed
+// from the cache and not returned.
+func (c *objectCache) get(addr oid.Address, epoch uint64) (*object.Object, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	v, ok := c.lru.Get(addr)
+	if ok && (v.expiration > 0 && v.expiration < epoch || c.ttl > 0 && time.Since(v.stored) > c.ttl) {
+		c.lru.Remove(addr)
+		c.metrics.SetObjectCacheSize(c.size)
+
+		ok = false
+	}
+
+	c.metrics.AddObjectCacheRequest(ok)
+
+	return v.obj, ok
+}
+
+// put saves the fully read object in the cache if it fits the limits.
+func (c *objectCache) put(addr oid.Address, obj *object.Object) {
+	if obj.Type() != object.TypeRegular {
+		return
+	}
+
+	sz := obj.PayloadSize()
+	if sz > c.maxObjectSize || sz > c.capacity {
+		return
+	}
+
+	v := cachedObject{
+		obj:    obj,
+		stored: time.Now(),
+	}
+
+	for _, a := range obj.Attributes() {
+		if a.Key() == object.AttributeExpirationEpoch {
+			v.expiration, _ = strconv.ParseUint(a.Value(), 10, 64)
+			break
+		}
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	// replaced value is not evicted by the LRU
+	if old, ok := c.lru.Peek(addr); ok {
+		c.size -= old.obj.PayloadSize()
+	}
+
+	c.lru.Add(addr, v)
+	c.size += sz
+
+	for c.size > c.capacity {
+		c.lru.RemoveOldest()
+	}
+
+	c.metrics.SetObjectCacheSize(c.size)
+}
+
+// invalidate removes the objects from the cache. Nil list removes all objects
+// of the container.
+func (c *objectCache) invalidate(cnr cid.ID, ids []oid.ID) {
+	var addr oid.Address
+	addr.SetContainer(cnr)
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if ids == nil {
+		for _, a := range c.lru.Keys() {
+			if a.Container() == cnr {
+				c.lru.Remove(a)
+			}
+		}
+	}
+
+	for i := range ids {
+		addr.SetObject(ids[i])
+		c.lru.Remove(addr)
+	}
+
+	c.metrics.SetObjectCacheSize(c.size)
+}
+
+// cachingWriter passes the object to the next writer and collects it to be
+// saved in the cache. Collecting stops if the object is too big.
+type cachingWriter struct {
+	next ObjectWriter
+
+	maxSize uint64
+
+	obj *object.Object
+
+	payload []byte
+}
+
+func (w *cachingWriter) WriteHeader(hdr *object.Object) error {
+	if sz := hdr.PayloadSize(); sz <= w.maxSize && hdr.Type() == object.TypeRegular {
+		w.obj = hdr
+		w.payload = make([]byte, 0, sz)
+	}
+
+	return w.next.WriteHeader(hdr)
+}
+
+func (w *cachingWriter) WriteChunk(p []byte) error {
+	if w.obj != nil {
+		if uint64(len(w.payload)+len(p)) > w.obj.PayloadSize() {
+			w.obj = nil
+			w.payload = nil
+		} else {
+			w.payload = append(w.payload, p...)
+		}
+	}
+
+	return w.next.WriteChunk(p)
+}
+
+// object returns the collected object, nil if the object has not been fully
+// written.
+func (w *cachingWriter) object() *object.Object {
+	if w.obj == nil || uint64(len(w.payload)) != w.obj.PayloadSize() {
+		return nil
+	}
+
+	res := object.New()
+	w.obj.CopyTo(res)
+	res.SetPayload(w.payload)
+
+	return res
+}
@@ -0,0 +1,232 @@
+package getsvc
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testCacheMetrics struct {
+	hits, misses int
+	size         uint64
+}
+
+func (m *testCacheMetrics) AddObjectCacheRequest(hit bool) {
+	if hit {
+		m.hits++
+	} else {
+		m.misses++
+	}
+}
+
+func (m *testCacheMetrics) SetObjectCacheSize(size uint64) {
+	m.size = size
+}
+
+func TestObjectCache(t *testing.T) {
+	newObj := func(addr oid.Address, size int) *objectSDK.Object {
+		return generateObject(addr, nil, make([]byte, size))
+	}
+
+	t.Run("size limits", func(t *testing.T) {
+		m := new(testCacheMetrics)
+		c := newObjectCache(10, 6, 0)
+		c.metrics = m
+
+		addrs := []oid.Address{oidtest.Address(), oidtest.Address(), oidtest.Address()}
+
+		c.put(addrs[0], newObj(addrs[0], 4))
+		c.put(addrs[1], newObj(addrs[1], 7)) // too big
+		require.EqualValues(t, 4, m.size)
+
+		c.put(addrs[1], newObj(addrs[1], 5))
+		require.EqualValues(t, 9, m.size)
+
+		_, ok := c.get(addrs[0], 0)
+		require.True(t, ok)
+
+		// the least recently used object is evicted
+		c.put(addrs[2], newObj(addrs[2], 3))
+		require.EqualValues(t, 7, m.size)
+
+		_, ok = c.get(addrs[1], 0)
+		require.False(t, ok)
+
+		_, ok = c.get(addrs[0], 0)
+		require.True(t, ok)
+
+		require.Equal(t, 2, m.hits)
+		require.Equal(t, 1, m.misses)
+	})
+
+	t.Run("expiration", func(t *testing.T) {
+		c := newObjectCache(10, 10, 0)
+
+		addr := oidtest.Address()
+		obj := newObj(addr, 1)
+
+		var a objectSDK.Attribute
+		a.SetKey(objectSDK.AttributeExpirationEpoch)
+		a.SetValue(strconv.Itoa(10))
+		obj.SetAttributes(a)
+
+		c.put(addr, obj)
+
+		_, ok := c.get(addr, 10)
+		require.True(t, ok)
+
+		_, ok = c.get(addr, 11)
+		require.False(t, ok)
+	})
+
+	t.Run("TTL", func(t *testing.T) {
+		c := newObjectCache(10, 10, time.Millisecond)
+
+		addr := oidtest.Address()
+		c.put(addr, newObj(addr, 1))
+
+		time.Sleep(10 * time.Millisecond)
+
+		_, ok := c.get(addr, 0)
+		require.False(t, ok)
+	})
+
+	t.Run("invalidation", func(t *testing.T) {
+		m := new(testCacheMetrics)
+		c := newObjectCache(10, 10, 0)
+		c.metrics = m
+
+		cnr := cidtest.ID()
+
+		var addr1, addr2 oid.Address
+		addr1.SetContainer(cnr)
+		addr1.SetObject(oidtest.ID())
+		addr2.SetContainer(cnr)
+		addr2.SetObject(oidtest.ID())
+
+		c.put(addr1, newObj(addr1, 1))
+		c.put(addr2, newObj(addr2, 2))
+
+		c.invalidate(cnr, []oid.ID{addr1.Object()})
+		require.EqualValues(t, 2, m.size)
+
+		_, ok := c.get(addr1, 0)
+		require.False(t, ok)
+
+		_, ok = c.get(addr2, 0)
+		require.True(t, ok)
+
+		addr3 := oidtest.Address()
+		c.put(addr3, newObj(addr3, 3))
+
+		c.invalidate(cnr, nil)
+		require.EqualValues(t, 3, m.size)
+
+		_, ok = c.get(addr2, 0)
+		require.False(t, ok)
+
+		_, ok = c.get(addr3, 0)
+		require.True(t, ok)
+	})
+
+	t.Run("non-regular", func(t *testing.T) {
+		c := newObjectCache(10, 10, 0)
+
+		addr := oidtest.Address()
+		obj := newObj(addr, 1)
+		obj.SetType(objectSDK.TypeTombstone)
+
+		c.put(addr, obj)
+
+		_, ok := c.get(addr, 0)
+		require.False(t, ok)
+	})
+}
+
+func TestGetCached(t *testing.T) {
+	ctx := context.Background()
+
+	payload := []byte("Hello, world!")
+
+	addr := oidtest.Address()
+	obj := generateObject(addr, nil, payload)
+
+	storage := newTestStorage()
+	storage.addPhy(addr, obj)
+
+	svc := &Service{cfg: new(cfg)}
+	svc.log = test.NewLogger(false)
+	svc.localStorage = storage
+	svc.currentEpochReceiver = testEpochReceiver(1)
+	svc.cache = newObjectCache(1024, 1024, 0)
+
+	w := NewSimpleObjectWriter()
+
+	p := Prm{}
+	p.SetObjectWriter(w)
+	p.SetCommonParameters(new(util.CommonPrm))
+	p.WithAddress(addr)
+
+	require.NoError(t, svc.Get(ctx, p))
+	require.Equal(t, obj, w.Object())
+
+	// object is served from the cache only now
+	delete(storage.phy, addr.EncodeToString())
+
+	t.Run("GET", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+		p.SetObjectWriter(w)
+
+		require.NoError(t, svc.Get(ctx, p))
+		require.Equal(t, payload, w.Object().Payload())
+
+		id, _ := w.Object().ID()
+		require.Equal(t, addr.Object(), id)
+	})
+
+	t.Run("RANGE", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+
+		r := objectSDK.NewRange()
+		r.SetOffset(7)
+		r.SetLength(5)
+
+		rp := RangePrm{}
+		rp.SetChunkWriter(w)
+		rp.SetCommonParameters(new(util.CommonPrm))
+		rp.WithAddress(addr)
+		rp.SetRange(r)
+
+		require.NoError(t, svc.GetRange(ctx, rp))
+		require.Equal(t, payload[7:12], w.Object().Payload())
+	})
+
+	t.Run("HEAD", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+
+		hp := HeadPrm{}
+		hp.SetHeaderWriter(w)
+		hp.SetCommonParameters(new(util.CommonPrm))
+		hp.WithAddress(addr)
+
+		require.NoError(t, svc.Head(ctx, hp))
+		require.Equal(t, obj.CutPayload(), w.Object())
+	})
+
+	t.Run("local", func(t *testing.T) {
+		lp := p
+		lp.SetObjectWriter(NewSimpleObjectWriter())
+		lp.SetCommonParameters(new(util.CommonPrm).WithLocalOnly(true))
+
+		require.Error(t, svc.Get(ctx, lp))
+	})
+}
@@ -0,0 +1,391 @@
+package getsvc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ecPart is a found part of the erasure-coded object.
+type ecPart struct {
+	hdr *objectSDK.Object
+	// node is an index of the node storing the part in the parts placement,
+	// negative for the local storage.
+	node int
+}
+
+// ecCollection is a state of the erasure-coded object parts collection.
+type ecCollection struct {
+	addr  oid.Address
+	rule  ec.Rule
+	nodes []netmap.NodeInfo
+
+	// verified header of the parent object, nil until any part is found
+	parent *objectSDK.Object
+
+	// candidates of the parts with verified headers by the part indices,
+	// indices without candidates are missing
+	found map[int][]ecPart
+
+	// parts read and verified by the part indices
+	read map[int]*objectSDK.Object
+
+	// nodes requested for the parts
+	requested []bool
+}
+
+func (exec *execCtx) canAssembleEC() bool {
+	return exec.svc.ecPlacement != nil && exec.svc.ecParts != nil && exec.svc.localECParts != nil &&
+		!exec.isRaw() && !exec.isLocal()
+}
+
+// executeEC tries to restore the requested object from the parts stored on
+// the container nodes if the object is erasure-coded. Part headers are
+// collected first: a single part is enough for HEAD requests, ranges are read
+// from the data parts directly and only GET requests (or ranges with
+// unavailable data parts) fetch and decode Rule.DataParts full parts. Parts
+// are used only after their headers are verified against the parent header
+// and their payloads against the part headers. Invalid parts are replaced by
+// the ones from the other nodes.
+func (exec *execCtx) executeEC() {
+	if !exec.canAssembleEC() || !exec.initEpoch() {
+		return
+	}
+
+	addr := exec.address()
+
+	rule, nodes, err := exec.svc.ecPlacement.GenerateECPlacement(addr.Container(), addr.Object(), exec.curProcEpoch)
+	if err != nil {
+		exec.log.Debug("could not build placement of the object parts",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	} else if rule.IsZero() {
+		return
+	}
+
+	exec.log.Debug("trying to restore erasure-coded object...",
+		zap.Stringer("rule", rule),
+	)
+
+	need := rule.DataParts
+	if exec.headOnly() {
+		need = 1
+	}
+
+	c := &ecCollection{
+		addr:      addr,
+		rule:      rule,
+		nodes:     nodes,
+		found:     make(map[int][]ecPart, rule.Total()),
+		read:      make(map[int]*objectSDK.Object, rule.DataParts),
+		requested: make([]bool, len(nodes)),
+	}
+
+	if local, err := exec.svc.localECParts.Heads(addr.Container(), addr.Object()); err != nil {
+		exec.log.Debug("could not get local parts of the object",
+			zap.String("error", err.Error()),
+		)
+	} else {
+		exec.collectECHeaders(c, local, -1)
+	}
+
+	exec.requestECParts(c, need)
+
+	if !exec.contextAlive() {
+		return
+	}
+
+	if len(c.found) < need {
+		exec.log.Debug("not enough parts to restore the object",
+			zap.Int("collected", len(c.found)),
+			zap.Int("required", need),
+		)
+
+		return
+	}
+
+	obj := c.parent
+
+	if !exec.headOnly() {
+		if rng := exec.ctxRange(); rng != nil {
+			from := rng.GetOffset()
+			to := from + rng.GetLength()
+
+			if pLen := obj.PayloadSize(); to < from || pLen < from || pLen < to {
+				exec.status = statusOutOfRange
+				exec.err = new(apistatus.ObjectOutOfRange)
+
+				return
+			}
+
+			obj, err = exec.ecRange(c, from, rng.GetLength())
+		} else {
+			obj, err = exec.ecDecode(c)
+		}
+	}
+
+	if err != nil {
+		if !exec.contextAlive() {
+			return
+		}
+
+		exec.status = statusUndefined
+		exec.err = err
+
+		exec.log.Debug("could not restore erasure-coded object",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	exec.collectedObject = obj
+	exec.writeCollectedObject()
+}
+
+// collectECHeaders adds the valid parts of the requested object to the
+// candidates.
+func (exec *execCtx) collectECHeaders(c *ecCollection, hdrs []*objectSDK.Object, node int) {
+	for i := range hdrs {
+		info, parent, err := ec.VerifyPart(hdrs[i])
+		if err != nil {
+			exec.log.Debug("skip invalid part of the object",
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		if cnr, _ := parent.ContainerID(); cnr != c.addr.Container() || info.Parent != c.addr.Object() || info.Rule != c.rule {
+			continue
+		}
+
+		if c.parent == nil {
+			c.parent = parent
+		}
+
+		c.found[info.Index] = append(c.found[info.Index], ecPart{hdr: hdrs[i], node: node})
+	}
+}
+
+// requestECParts requests the part headers from the nodes until parts with
+// need distinct indices are found. Returns false if there are no more nodes
+// to request.
+func (exec *execCtx) requestECParts(c *ecCollection, need int) bool {
+	var requested bool
+
+	// i-th part is expected on the i-th node
+	for i := 0; len(c.found) < need && i < len(c.nodes); i++ {
+		if _, ok := c.found[i]; ok || c.requested[i] {
+			continue
+		}
+
+		if !exec.contextAlive() {
+			return false
+		}
+
+		c.requested[i] = true
+		requested = true
+
+		hdrs, err := exec.svc.ecParts.Heads(exec.context(), c.nodes[i], c.addr.Container(), c.addr.Object())
+		if err != nil {
+			exec.log.Debug("could not get parts of the object from the node",
+				zap.String("node", netmap.StringifyPublicKey(c.nodes[i])),
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		exec.collectECHeaders(c, hdrs, i)
+	}
+
+	return requested
+}
+
+// contextAlive checks whether the request context is not done and logs the
+// interruption otherwise.
+func (exec *execCtx) contextAlive() bool {
+	select {
+	case <-exec.context().Done():
+		exec.log.Debug("interrupt parts collection by context",
+			zap.String("error", exec.context().Err().Error()),
+		)
+
+		return false
+	default:
+		return true
+	}
+}
+
+// ecRange reads the requested range of the parent payload from the found
+// data parts. Data parts are read in full, so their payloads are verified
+// before use. If some of the needed data parts is unavailable, the whole
+// object is decoded.
+func (exec *execCtx) ecRange(c *ecCollection, off, ln uint64) (*objectSDK.Object, error) {
+	payload := make([]byte, 0, ln)
+
+	for _, r := range c.rule.DataRanges(c.parent.PayloadSize(), off, ln) {
+		part, err := exec.ecPartObject(c, r.Index)
+		if err != nil {
+			exec.log.Debug("could not read the data part, decoding the object...",
+				zap.Int("part", r.Index),
+				zap.String("error", err.Error()),
+			)
+
+			obj, err := exec.ecDecode(c)
+			if err != nil {
+				return nil, err
+			}
+
+			return payloadOnlyObject(obj.Payload()[off : off+ln]), nil
+		}
+
+		payload = append(payload, part.Payload()[r.Offset:r.Offset+r.Length]...)
+	}
+
+	return payloadOnlyObject(payload), nil
+}
+
+// ecDecode reads Rule.DataParts full parts among the found ones and restores
+// the parent object from them. More nodes are requested if the found parts
+// are not enough.
+func (exec *execCtx) ecDecode(c *ecCollection) (*objectSDK.Object, error) {
+	for {
+		// data parts go first, so no actual decoding is needed if they are
+		// available
+		for i := 0; len(c.read) < c.rule.DataParts && i < c.rule.Total(); i++ {
+			if _, ok := c.read[i]; ok {
+				continue
+			}
+
+			if _, ok := c.found[i]; !ok {
+				continue
+			}
+
+			if _, err := exec.ecPartObject(c, i); err != nil {
+				exec.log.Debug("could not get the object part",
+					zap.Int("part", i),
+					zap.String("error", err.Error()),
+				)
+			}
+		}
+
+		if len(c.read) >= c.rule.DataParts || !exec.requestECParts(c, c.rule.DataParts) {
+			break
+		}
+	}
+
+	if !exec.contextAlive() {
+		return nil, exec.context().Err()
+	}
+
+	parts := make([]*objectSDK.Object, 0, len(c.read))
+	for _, part := range c.read {
+		parts = append(parts, part)
+	}
+
+	if len(parts) < c.rule.DataParts {
+		return nil, fmt.Errorf("%w: %d < %d", ec.ErrNotEnoughParts, len(parts), c.rule.DataParts)
+	}
+
+	return ec.Decode(parts)
+}
+
+func ecPartAddress(p ecPart) (oid.Address, error) {
+	var res oid.Address
+
+	id, ok := p.hdr.ID()
+	if !ok {
+		return res, errors.New("missing part ID")
+	}
+
+	cnr, _ := p.hdr.ContainerID()
+
+	res.SetContainer(cnr)
+	res.SetObject(id)
+
+	return res, nil
+}
+
+// ecPartObject returns the full part with the given index read from the first
+// of the candidates which returns the part matching its verified header.
+// Failed candidates are dropped.
+func (exec *execCtx) ecPartObject(c *ecCollection, idx int) (*objectSDK.Object, error) {
+	if part, ok := c.read[idx]; ok {
+		return part, nil
+	}
+
+	err := errors.New("part is unavailable")
+
+	for len(c.found[idx]) > 0 {
+		if !exec.contextAlive() {
+			return nil, exec.context().Err()
+		}
+
+		p := c.found[idx][0]
+
+		var part *objectSDK.Object
+
+		part, err = exec.readECPart(c.nodes, p)
+		if err == nil {
+			c.read[idx] = part
+			return part, nil
+		}
+
+		exec.log.Debug("could not read the part candidate",
+			zap.Int("part", idx),
+			zap.Int("node", p.node),
+			zap.String("error", err.Error()),
+		)
+
+		c.found[idx] = c.found[idx][1:]
+	}
+
+	delete(c.found, idx)
+
+	return nil, err
+}
+
+// readECPart reads the part and checks that it matches the part header and
+// its payload is not corrupted.
+func (exec *execCtx) readECPart(nodes []netmap.NodeInfo, p ecPart) (*objectSDK.Object, error) {
+	addr, err := ecPartAddress(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var part *objectSDK.Object
+
+	if p.node < 0 {
+		part, err = exec.svc.localECParts.Get(addr)
+	} else {
+		part, err = exec.svc.ecParts.Get(exec.context(), nodes[p.node], addr)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if id, ok := part.ID(); !ok || id != addr.Object() {
+		return nil, errors.New("part ID mismatch")
+	}
+
+	if err = part.CheckVerificationFields(); err != nil {
+		return nil, fmt.Errorf("verify part: %w", err)
+	}
+
+	if uint64(len(part.Payload())) != part.PayloadSize() {
+		return nil, fmt.Errorf("part payload length %d differs from the declared %d", len(part.Payload()), part.PayloadSize())
+	}
+
+	return part, nil
+}
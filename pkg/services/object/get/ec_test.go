@@ -0,0 +1,298 @@
+package getsvc
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testECPlacement struct {
+	rule  ec.Rule
+	nodes []netmap.NodeInfo
+}
+
+func (p *testECPlacement) GenerateECPlacement(cid.ID, oid.ID, uint64) (ec.Rule, []netmap.NodeInfo, error) {
+	return p.rule, p.nodes, nil
+}
+
+type testECParts struct {
+	parts map[string][]*objectSDK.Object
+	// number of the full parts requested
+	gets int
+}
+
+func findTestPart(parts []*objectSDK.Object, addr oid.Address) (*objectSDK.Object, error) {
+	for i := range parts {
+		if id, _ := parts[i].ID(); id == addr.Object() {
+			return parts[i], nil
+		}
+	}
+
+	return nil, errors.New("part not found")
+}
+
+func (s *testECParts) node(node netmap.NodeInfo) ([]*objectSDK.Object, error) {
+	parts, ok := s.parts[string(node.PublicKey())]
+	if !ok {
+		return nil, errors.New("node is unavailable")
+	}
+
+	return parts, nil
+}
+
+func (s *testECParts) Heads(_ context.Context, node netmap.NodeInfo, _ cid.ID, _ oid.ID) ([]*objectSDK.Object, error) {
+	parts, err := s.node(node)
+	if err != nil {
+		return nil, err
+	}
+
+	hdrs := make([]*objectSDK.Object, len(parts))
+	for i := range parts {
+		hdrs[i] = parts[i].CutPayload()
+	}
+
+	return hdrs, nil
+}
+
+func (s *testECParts) Get(_ context.Context, node netmap.NodeInfo, addr oid.Address) (*objectSDK.Object, error) {
+	parts, err := s.node(node)
+	if err != nil {
+		return nil, err
+	}
+
+	s.gets++
+
+	return findTestPart(parts, addr)
+}
+
+type testLocalECParts []*objectSDK.Object
+
+func (s testLocalECParts) Heads(cid.ID, oid.ID) ([]*objectSDK.Object, error) {
+	hdrs := make([]*objectSDK.Object, len(s))
+	for i := range s {
+		hdrs[i] = s[i].CutPayload()
+	}
+
+	return hdrs, nil
+}
+
+func (s testLocalECParts) Get(addr oid.Address) (*objectSDK.Object, error) {
+	return findTestPart(s, addr)
+}
+
+func TestGetErasureCoded(t *testing.T) {
+	ctx := context.Background()
+	rule := ec.Rule{DataParts: 2, ParityParts: 1}
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(netmaptest.PlacementPolicy())
+
+	var idCnr cid.ID
+	cnr.CalculateID(&idCnr)
+
+	payload := make([]byte, 100)
+	_, _ = rand.Read(payload)
+
+	parent := objectSDK.New()
+	parent.SetContainerID(idCnr)
+	owner := usertest.ID(t)
+	parent.SetOwnerID(&owner)
+	parent.SetPayload(payload)
+	parent.SetPayloadSize(uint64(len(payload)))
+	require.NoError(t, parent.SetVerificationFields(neofscryptotest.RandomSigner(t)))
+
+	parentID, _ := parent.ID()
+
+	var addr oid.Address
+	addr.SetContainer(idCnr)
+	addr.SetObject(parentID)
+
+	parts, err := ec.Encode(rule, parent, neofscryptotest.RandomSigner(t))
+	require.NoError(t, err)
+
+	nodes := make([]netmap.NodeInfo, rule.Total())
+	for i := range nodes {
+		nodes[i].SetPublicKey([]byte{byte(i)})
+	}
+
+	newSvc := func(local testLocalECParts, remote *testECParts) *Service {
+		svc := &Service{cfg: new(cfg)}
+		svc.log = test.NewLogger(false)
+		svc.localStorage = newTestStorage()
+		svc.assembly = true
+		svc.traverserGenerator = &testTraverserGenerator{
+			c: cnr,
+			b: map[uint64]placement.Builder{},
+		}
+		svc.clientCache = &testClientCache{}
+		svc.currentEpochReceiver = testEpochReceiver(1)
+		svc.ecPlacement = &testECPlacement{rule: rule, nodes: nodes}
+		svc.ecParts = remote
+		svc.localECParts = local
+
+		return svc
+	}
+
+	newCommonPrm := func() commonPrm {
+		var p commonPrm
+		p.common = new(util.CommonPrm).WithLocalOnly(false)
+		p.WithAddress(addr)
+		return p
+	}
+
+	t.Run("get", func(t *testing.T) {
+		// the 2nd node is unavailable, so the parity part is used
+		svc := newSvc(parts[:1], &testECParts{parts: map[string][]*objectSDK.Object{
+			string(nodes[2].PublicKey()): parts[2:],
+		}})
+
+		w := NewSimpleObjectWriter()
+
+		var p Prm
+		p.commonPrm = newCommonPrm()
+		p.SetObjectWriter(w)
+
+		require.NoError(t, svc.Get(ctx, p))
+		require.Equal(t, payload, w.Object().Payload())
+		require.Equal(t, parent.CutPayload(), w.Object().CutPayload())
+	})
+
+	t.Run("range", func(t *testing.T) {
+		remote := &testECParts{parts: map[string][]*objectSDK.Object{
+			string(nodes[0].PublicKey()): parts[:1],
+			string(nodes[1].PublicKey()): parts[1:2],
+		}}
+		svc := newSvc(nil, remote)
+
+		newRngPrm := func(off, ln uint64) (RangePrm, *SimpleObjectWriter) {
+			w := NewSimpleObjectWriter()
+
+			var p RangePrm
+			p.commonPrm = newCommonPrm()
+			p.SetChunkWriter(w)
+
+			rng := objectSDK.NewRange()
+			rng.SetOffset(off)
+			rng.SetLength(ln)
+			p.SetRange(rng)
+
+			return p, w
+		}
+
+		p, w := newRngPrm(10, 20)
+		require.NoError(t, svc.GetRange(ctx, p))
+		require.Equal(t, payload[10:30], w.Object().Payload())
+
+		// the range crosses the data parts bound
+		p, w = newRngPrm(40, 20)
+		require.NoError(t, svc.GetRange(ctx, p))
+		require.Equal(t, payload[40:60], w.Object().Payload())
+
+		require.Equal(t, 3, remote.gets, "ranges must be read from the data parts holding them")
+
+		// the 1st data part is unavailable, so the object is decoded
+		svc = newSvc(nil, &testECParts{parts: map[string][]*objectSDK.Object{
+			string(nodes[1].PublicKey()): parts[1:2],
+			string(nodes[2].PublicKey()): parts[2:],
+		}})
+
+		p, w = newRngPrm(10, 20)
+		require.NoError(t, svc.GetRange(ctx, p))
+		require.Equal(t, payload[10:30], w.Object().Payload())
+
+		p, _ = newRngPrm(90, 20)
+		require.Error(t, svc.GetRange(ctx, p))
+	})
+
+	t.Run("corrupted part", func(t *testing.T) {
+		// header copy not sharing the fields with the original part
+		b, err := parts[0].Marshal()
+		require.NoError(t, err)
+
+		corrupted := objectSDK.New()
+		require.NoError(t, corrupted.Unmarshal(b))
+
+		payload := corrupted.Payload()
+		payload[0]++
+		corrupted.SetPayload(payload)
+
+		// corrupted local data part is detected, so the object is decoded
+		// from the rest parts
+		svc := newSvc(testLocalECParts{corrupted}, &testECParts{parts: map[string][]*objectSDK.Object{
+			string(nodes[1].PublicKey()): parts[1:2],
+			string(nodes[2].PublicKey()): parts[2:],
+		}})
+
+		w := NewSimpleObjectWriter()
+
+		var p RangePrm
+		p.commonPrm = newCommonPrm()
+		p.SetChunkWriter(w)
+
+		rng := objectSDK.NewRange()
+		rng.SetOffset(0)
+		rng.SetLength(10)
+		p.SetRange(rng)
+
+		require.NoError(t, svc.GetRange(ctx, p))
+		require.Equal(t, parent.Payload()[:10], w.Object().Payload())
+	})
+
+	t.Run("invalid header", func(t *testing.T) {
+		// part of another object claiming the requested parent is ignored
+		other, err := ec.Encode(rule, parent, neofscryptotest.RandomSigner(t))
+		require.NoError(t, err)
+
+		other[1].SetCreationEpoch(other[1].CreationEpoch() + 1)
+
+		svc := newSvc(nil, &testECParts{parts: map[string][]*objectSDK.Object{
+			string(nodes[1].PublicKey()): other[1:2],
+		}})
+
+		var p HeadPrm
+		p.commonPrm = newCommonPrm()
+		p.SetHeaderWriter(NewSimpleObjectWriter())
+
+		require.Error(t, svc.Head(ctx, p))
+	})
+
+	t.Run("head", func(t *testing.T) {
+		svc := newSvc(nil, &testECParts{parts: map[string][]*objectSDK.Object{
+			string(nodes[1].PublicKey()): parts[1:2],
+		}})
+
+		w := NewSimpleObjectWriter()
+
+		var p HeadPrm
+		p.commonPrm = newCommonPrm()
+		p.SetHeaderWriter(w)
+
+		require.NoError(t, svc.Head(ctx, p))
+		require.Equal(t, parent.CutPayload(), w.Object())
+	})
+
+	t.Run("not enough parts", func(t *testing.T) {
+		svc := newSvc(parts[:1], &testECParts{})
+
+		var p Prm
+		p.commonPrm = newCommonPrm()
+		p.SetObjectWriter(NewSimpleObjectWriter())
+
+		require.Error(t, svc.Get(ctx, p))
+	})
+}
@@ -2,13 +2,13 @@ package getsvc
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -36,6 +36,9 @@ type execCtx struct {
 
 	collectedObject *objectSDK.Object
 
+	// set if the collected object has been read from the local storage
+	localRead bool
+
 	curOff uint64
 
 	head bool
@@ -51,6 +54,8 @@ const (
 	statusINHUMED
 	statusVIRTUAL
 	statusOutOfRange
+	statusCorrupted
+	statusRedirect
 )
 
 func headOnly() execOption {
@@ -114,11 +119,11 @@ func (exec execCtx) isChild(obj *objectSDK.Object) bool {
 	return par == nil || equalAddresses(exec.address(), object.AddressOf(par))
 }
 
-func (exec execCtx) key() (*ecdsa.PrivateKey, error) {
-	if exec.prm.signerKey != nil {
-		// the key has already been requested and
+func (exec execCtx) signer() (signer.Signer, error) {
+	if exec.prm.signer != nil {
+		// the signer has already been requested and
 		// cached in the previous operations
-		return exec.prm.signerKey, nil
+		return exec.prm.signer, nil
 	}
 
 	var sessionInfo *util.SessionInfo
@@ -130,7 +135,7 @@ func (exec execCtx) key() (*ecdsa.PrivateKey, error) {
 		}
 	}
 
-	return exec.svc.keyStore.GetKey(sessionInfo)
+	return exec.svc.keyStore.GetSigner(sessionInfo)
 }
 
 func (exec *execCtx) canAssemble() bool {
@@ -206,29 +211,52 @@ func (exec *execCtx) generateTraverser(addr oid.Address) (*placement.Traverser,
 }
 
 func (exec *execCtx) getChild(id oid.ID, rng *objectSDK.Range, withHdr bool) (*objectSDK.Object, bool) {
+	exec.prm.common = exec.prm.common.WithLocalOnly(false)
+
+	child, st := exec.fetchChild(exec.context(), id, rng, withHdr)
+
+	exec.statusError = st
+
+	return child, st.status == statusOK
+}
+
+// fetchChild reads the child object without modifying the execution context,
+// so it can be called concurrently. Local-only flag must be reset by the
+// caller in advance.
+func (exec *execCtx) fetchChild(ctx context.Context, id oid.ID, rng *objectSDK.Range, withHdr bool) (*objectSDK.Object, statusError) {
 	w := NewSimpleObjectWriter()
 
+	// execCtx methods with value receivers are not used here: they copy the
+	// status being modified by the caller concurrently
+	parAddr := exec.prm.addr
+
 	p := exec.prm
-	p.common = p.common.WithLocalOnly(false)
 	p.objWriter = w
 	p.SetRange(rng)
 
-	p.addr.SetContainer(exec.containerID())
+	// the payload is verified against the parent checksums by the caller
+	p.skipPayloadVerification = true
+	// children are not cached not to evict the objects requested directly
+	p.skipCache = true
+	// children are read by the node itself
+	p.redirect = false
+
+	p.addr.SetContainer(parAddr.Container())
 	p.addr.SetObject(id)
 
-	exec.statusError = exec.svc.get(exec.context(), p.commonPrm, withPayloadRange(rng))
+	st := exec.svc.get(ctx, p.commonPrm, withPayloadRange(rng))
 
 	child := w.Object()
-	ok := exec.status == statusOK
 
-	if ok && withHdr && !exec.isChild(child) {
-		exec.status = statusUndefined
-		exec.err = errors.New("wrong child header")
+	if st.status == statusOK && withHdr && child.Parent() != nil &&
+		!equalAddresses(parAddr, object.AddressOf(child.Parent())) {
+		st.status = statusUndefined
+		st.err = errors.New("wrong child header")
 
 		exec.log.Debug("parent address in child object differs")
 	}
 
-	return child, ok
+	return child, st
 }
 
 func (exec *execCtx) headChild(id oid.ID) (*objectSDK.Object, bool) {
@@ -336,6 +364,15 @@ func (exec *execCtx) writeObjectPayload(obj *objectSDK.Object) bool {
 	err := exec.prm.objWriter.WriteChunk(obj.Payload())
 
 	switch {
+	case isPayloadCorruption(err):
+		// header has already been written, so other sources are not tried
+		exec.status = statusCorrupted
+		exec.err = err
+
+		exec.log.Error("payload of the read object is corrupted",
+			zap.Stringer("address", exec.address()),
+			zap.String("error", err.Error()),
+		)
 	default:
 		exec.status = statusUndefined
 		exec.err = err
@@ -3,7 +3,9 @@ package getsvc
 import (
 	"context"
 
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
 )
@@ -85,8 +87,52 @@ func (s *Service) get(ctx context.Context, prm commonPrm, opts ...execOption) st
 
 	exec.setLogger(s.log)
 
+	useCache := s.cache != nil && !prm.skipCache && !prm.raw && !exec.isLocal()
+
+	if useCache && exec.executeCached() {
+		return exec.statusError
+	}
+
+	var collector *cachingWriter
+
+	if useCache && !exec.headOnly() && exec.ctxRange() == nil {
+		collector = &cachingWriter{next: exec.prm.objWriter, maxSize: s.cache.maxObjectSize}
+		exec.prm.objWriter = collector
+	}
+
+	var verifier *verifyingWriter
+
+	if s.verifyPayload && !prm.skipPayloadVerification && !exec.headOnly() && exec.ctxRange() == nil {
+		verifier = &verifyingWriter{next: exec.prm.objWriter}
+		exec.prm.objWriter = verifier
+	}
+
 	exec.execute()
 
+	if verifier != nil && exec.status == statusOK {
+		if err := verifier.complete(); err != nil {
+			exec.status = statusCorrupted
+			exec.err = err
+
+			exec.log.Error("payload of the read object is corrupted",
+				zap.String("error", err.Error()),
+			)
+		}
+	}
+
+	if exec.status == statusCorrupted && exec.localRead && s.corruptionHandler != nil {
+		s.corruptionHandler(objectcore.AddressWithType{
+			Address: prm.addr,
+			Type:    exec.collectedObject.Type(),
+		})
+	}
+
+	if collector != nil && exec.status == statusOK {
+		if obj := collector.object(); obj != nil {
+			s.cache.put(prm.addr, obj)
+		}
+	}
+
 	return exec.statusError
 }
 
@@ -111,14 +157,68 @@ func (exec *execCtx) analyzeStatus(execCnr bool) {
 		exec.assemble()
 	case statusOutOfRange:
 		exec.log.Debug("requested range is out of object bounds")
+	case statusCorrupted:
+		exec.log.Debug("requested object payload is corrupted")
+	case statusRedirect:
+		exec.log.Debug("requested object is stored on other nodes")
 	default:
 		exec.log.Debug("operation finished with error",
 			zap.String("error", exec.err.Error()),
 		)
 
+		if execCnr && exec.canRedirect() && exec.executeRedirect() {
+			return
+		}
+
 		if execCnr {
 			exec.executeOnContainer()
+
+			if exec.status == statusUndefined {
+				exec.executeEC()
+			}
+
 			exec.analyzeStatus(false)
 		}
 	}
 }
+
+// executeCached serves the request from the object cache. Returns false if
+// the object is not cached.
+func (exec *execCtx) executeCached() bool {
+	epoch, err := exec.svc.currentEpochReceiver.currentEpoch()
+	if err != nil {
+		exec.log.Debug("could not get current epoch to check cached object",
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	}
+
+	obj, ok := exec.svc.cache.get(exec.address(), epoch)
+	if !ok {
+		return false
+	}
+
+	exec.log.Debug("serving request from cache...")
+
+	if rng := exec.ctxRange(); rng != nil {
+		from := rng.GetOffset()
+		to := from + rng.GetLength()
+
+		if pLen := obj.PayloadSize(); to < from || pLen < from || pLen < to {
+			exec.status = statusOutOfRange
+			exec.err = new(apistatus.ObjectOutOfRange)
+
+			return true
+		}
+
+		exec.writeObjectPayload(payloadOnlyObject(obj.Payload()[from:to]))
+
+		return true
+	}
+
+	exec.collectedObject = obj
+	exec.writeCollectedObject()
+
+	return true
+}
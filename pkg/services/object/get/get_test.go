@@ -15,6 +15,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -1244,3 +1245,256 @@ func TestGetFromPastEpoch(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, obj.CutPayload(), w.Object())
 }
+
+func TestGetAssemblyConcurrency(t *testing.T) {
+	ctx := context.Background()
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(netmaptest.PlacementPolicy())
+
+	var idCnr cid.ID
+	cnr.CalculateID(&idCnr)
+
+	addr := oidtest.Address()
+	addr.SetContainer(idCnr)
+
+	const childNum = 10
+
+	children, childIDs, payload := generateChain(childNum, idCnr)
+
+	srcObj := generateObject(addr, nil, payload)
+	srcObj.SetPayloadSize(uint64(len(payload)))
+
+	var cs checksum.Checksum
+	checksum.Calculate(&cs, checksum.SHA256, payload)
+	srcObj.SetPayloadChecksum(cs)
+
+	children[len(children)-1].SetParent(srcObj)
+
+	splitInfo := objectSDK.NewSplitInfo()
+	splitInfo.SetLink(oidtest.ID())
+
+	var linkAddr oid.Address
+	linkAddr.SetContainer(idCnr)
+	idLink, _ := splitInfo.Link()
+	linkAddr.SetObject(idLink)
+
+	linkingObj := generateObject(linkAddr, nil, nil, childIDs...)
+	linkingObj.SetParentID(addr.Object())
+	linkingObj.SetParent(srcObj)
+
+	ns, as := testNodeMatrix(t, []int{1})
+
+	newSvc := func(failed int) *Service {
+		c := newTestClient()
+		c.addResult(addr, nil, objectSDK.NewSplitInfoError(splitInfo))
+		c.addResult(linkAddr, linkingObj, nil)
+
+		builder := &testPlacementBuilder{
+			vectors: map[string][][]netmap.NodeInfo{
+				addr.EncodeToString():     ns,
+				linkAddr.EncodeToString(): ns,
+			},
+		}
+
+		for i := range children {
+			var childAddr oid.Address
+			childAddr.SetContainer(idCnr)
+			childAddr.SetObject(childIDs[i])
+
+			if i == failed {
+				c.addResult(childAddr, nil, apistatus.ObjectNotFound{})
+			} else {
+				c.addResult(childAddr, children[i], nil)
+			}
+
+			builder.vectors[childAddr.EncodeToString()] = ns
+		}
+
+		svc := &Service{cfg: new(cfg)}
+		svc.log = test.NewLogger(false)
+		svc.localStorage = newTestStorage()
+		svc.assembly = true
+		svc.assemblyWindow = 3
+
+		const curEpoch = 13
+
+		svc.traverserGenerator = &testTraverserGenerator{
+			c: cnr,
+			b: map[uint64]placement.Builder{
+				curEpoch: builder,
+			},
+		}
+		svc.clientCache = &testClientCache{
+			clients: map[string]*testClient{
+				as[0][0]: c,
+			},
+		}
+		svc.currentEpochReceiver = testEpochReceiver(curEpoch)
+
+		return svc
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		svc := newSvc(-1)
+
+		w := NewSimpleObjectWriter()
+
+		p := Prm{}
+		p.SetObjectWriter(w)
+		p.SetCommonParameters(new(util.CommonPrm))
+		p.WithAddress(addr)
+
+		require.NoError(t, svc.Get(ctx, p))
+		require.Equal(t, srcObj, w.Object())
+
+		off, ln := uint64(5), uint64(len(payload)-15)
+
+		r := objectSDK.NewRange()
+		r.SetOffset(off)
+		r.SetLength(ln)
+
+		w = NewSimpleObjectWriter()
+
+		rp := RangePrm{}
+		rp.SetChunkWriter(w)
+		rp.SetCommonParameters(new(util.CommonPrm))
+		rp.WithAddress(addr)
+		rp.SetRange(r)
+
+		require.NoError(t, svc.GetRange(ctx, rp))
+		require.Equal(t, payload[off:off+ln], w.Object().Payload())
+	})
+
+	t.Run("child failure", func(t *testing.T) {
+		svc := newSvc(childNum / 2)
+
+		p := Prm{}
+		p.SetObjectWriter(NewSimpleObjectWriter())
+		p.SetCommonParameters(new(util.CommonPrm))
+		p.WithAddress(addr)
+
+		require.ErrorAs(t, svc.Get(ctx, p), new(apistatus.ObjectNotFound))
+	})
+
+	t.Run("corrupted child", func(t *testing.T) {
+		svc := newSvc(-1)
+		svc.verifyPayload = true
+
+		corrupted := objectSDK.New()
+		children[childNum/2].CopyTo(corrupted)
+		corrupted.Payload()[0]++
+
+		var childAddr oid.Address
+		childAddr.SetContainer(idCnr)
+		childAddr.SetObject(childIDs[childNum/2])
+
+		svc.clientCache.(*testClientCache).clients[as[0][0]].addResult(childAddr, corrupted, nil)
+
+		w := NewSimpleObjectWriter()
+
+		p := Prm{}
+		p.SetObjectWriter(w)
+		p.SetCommonParameters(new(util.CommonPrm))
+		p.WithAddress(addr)
+
+		require.ErrorAs(t, svc.Get(ctx, p), new(PayloadCorruptionError))
+		require.Less(t, len(w.Object().Payload()), len(payload))
+	})
+}
+
+func TestPayloadCorruptionError(t *testing.T) {
+	st := apistatus.ErrorToV2(fmt.Errorf("wrapped: %w", PayloadCorruptionError{reason: "test"}))
+
+	code := st.Code()
+	require.True(t, code.EqualNumber(2048+uint32(util.StatusPayloadCorrupted)))
+	require.Equal(t, "payload corruption: test", st.Message())
+}
+
+func TestGetPayloadVerification(t *testing.T) {
+	ctx := context.Background()
+
+	payload := make([]byte, 10)
+	rand.Read(payload)
+
+	addr := oidtest.Address()
+	obj := generateObject(addr, nil, payload)
+
+	var cs checksum.Checksum
+	checksum.Calculate(&cs, checksum.SHA256, payload)
+	obj.SetPayloadChecksum(cs)
+
+	checksum.Calculate(&cs, checksum.TZ, payload)
+	obj.SetPayloadHomomorphicHash(cs)
+
+	corrupted := objectSDK.New()
+	obj.CopyTo(corrupted)
+	corrupted.Payload()[0]++
+
+	newSvc := func(obj *objectSDK.Object) *Service {
+		storage := newTestStorage()
+		storage.addPhy(addr, obj)
+
+		svc := &Service{cfg: new(cfg)}
+		svc.log = test.NewLogger(false)
+		svc.localStorage = storage
+		svc.verifyPayload = true
+
+		return svc
+	}
+
+	newPrm := func(w ObjectWriter) Prm {
+		p := Prm{}
+		p.SetObjectWriter(w)
+		p.WithAddress(addr)
+		p.common = new(util.CommonPrm).WithLocalOnly(true)
+
+		return p
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+
+		require.NoError(t, newSvc(obj).Get(ctx, newPrm(w)))
+		require.Equal(t, obj, w.Object())
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+
+		var reported []object.AddressWithType
+
+		svc := newSvc(corrupted)
+		svc.corruptionHandler = func(a object.AddressWithType) { reported = append(reported, a) }
+
+		require.ErrorAs(t, svc.Get(ctx, newPrm(w)), new(PayloadCorruptionError))
+		require.Empty(t, w.Object().Payload())
+		require.Equal(t, []object.AddressWithType{{Address: addr, Type: objectSDK.TypeRegular}}, reported)
+	})
+
+	t.Run("trusted", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+
+		p := newPrm(w)
+		p.SkipPayloadVerification()
+
+		require.NoError(t, newSvc(corrupted).Get(ctx, p))
+		require.Equal(t, corrupted.Payload(), w.Object().Payload())
+	})
+
+	t.Run("range", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+
+		r := objectSDK.NewRange()
+		r.SetLength(5)
+
+		p := RangePrm{}
+		p.SetChunkWriter(w)
+		p.WithAddress(addr)
+		p.SetRange(r)
+		p.common = new(util.CommonPrm).WithLocalOnly(true)
+
+		require.NoError(t, newSvc(corrupted).GetRange(ctx, p))
+		require.Equal(t, corrupted.Payload()[:5], w.Object().Payload())
+	})
+}
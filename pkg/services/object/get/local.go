@@ -28,6 +28,7 @@ func (exec *execCtx) executeLocal() {
 	case err == nil:
 		exec.status = statusOK
 		exec.err = nil
+		exec.localRead = true
 		exec.writeCollectedObject()
 	case errors.As(err, &errRemoved):
 		exec.status = statusINHUMED
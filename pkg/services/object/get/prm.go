@@ -2,13 +2,13 @@ package getsvc
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"hash"
 
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
@@ -62,7 +62,9 @@ type RangeHashPrm struct {
 	forwardedRangeHashResponse [][]byte
 }
 
-type RequestForwarder func(context.Context, coreclient.NodeInfo, coreclient.MultiAddressClient) (*object.Object, error)
+// RequestForwarder forwards the request to the remote node. The object
+// received in response is written to the passed writer.
+type RequestForwarder func(context.Context, coreclient.NodeInfo, coreclient.MultiAddressClient, ObjectWriter) (*object.Object, error)
 type RangeRequestForwarder func(context.Context, coreclient.NodeInfo, coreclient.MultiAddressClient) ([][]byte, error)
 
 // HeadPrm groups parameters of Head service call.
@@ -82,10 +84,16 @@ type commonPrm struct {
 	forwarder      RequestForwarder
 	rangeForwarder RangeRequestForwarder
 
-	// signerKey is a cached key that should be used for spawned
+	skipPayloadVerification bool
+
+	skipCache bool
+
+	redirect bool
+
+	// signer is a cached signer that should be used for spawned
 	// requests (if any), could be nil if incoming request handling
-	// routine does not include any key fetching operations
-	signerKey *ecdsa.PrivateKey
+	// routine does not include any signer fetching operations
+	signer signer.Signer
 }
 
 // ChunkWriter is an interface of target component
@@ -155,9 +163,22 @@ func (p *commonPrm) WithRawFlag(raw bool) {
 	p.raw = raw
 }
 
-// WithCachedSignerKey sets optional key for all further requests.
-func (p *commonPrm) WithCachedSignerKey(signerKey *ecdsa.PrivateKey) {
-	p.signerKey = signerKey
+// SkipPayloadVerification marks the read as trusted, so the payload is not
+// checked against the object checksums even if the verification is enabled
+// in the Service.
+func (p *commonPrm) SkipPayloadVerification() {
+	p.skipPayloadVerification = true
+}
+
+// AllowRedirect makes the Service to return RedirectError instead of reading
+// the object from the container nodes if it is not stored locally.
+func (p *commonPrm) AllowRedirect() {
+	p.redirect = true
+}
+
+// WithCachedSigner sets optional signer for all further requests.
+func (p *commonPrm) WithCachedSigner(s signer.Signer) {
+	p.signer = s
 }
 
 // SetHeaderWriter sets target component to write the object header.
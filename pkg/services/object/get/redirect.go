@@ -0,0 +1,202 @@
+package getsvc
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"go.uber.org/zap"
+)
+
+// RedirectDetailID is an ID of the util.StatusRedirect status details carrying
+// the container node the object should be requested from. The detail value
+// is a binary NeoFS API netmap.NodeInfo message.
+const RedirectDetailID = 0
+
+// nodeFailurePeriod is a time the remote node is considered unhealthy after
+// the failed request.
+const nodeFailurePeriod = time.Minute
+
+// maxNodeFailures limits the number of the failed nodes tracked at once.
+const maxNodeFailures = 1024
+
+// RedirectError is returned for the requests allowing redirection (see
+// commonPrm.AllowRedirect) when the object is not stored on the local node.
+// It lists the container nodes the object should be requested from directly
+// ordered by their placement priority, the nodes failed recently go last.
+//
+// It is transmitted to the clients as the util.StatusRedirect status with one
+// RedirectDetailID detail per node.
+type RedirectError struct {
+	nodes []netmap.NodeInfo
+}
+
+func (x RedirectError) Error() string {
+	return "object is stored on other nodes"
+}
+
+// Nodes returns the container nodes to request the object from.
+func (x RedirectError) Nodes() []netmap.NodeInfo {
+	return x.nodes
+}
+
+// ErrorToV2 implements apistatus.StatusV2 interface.
+func (x RedirectError) ErrorToV2() *status.Status {
+	st := util.NewStatus(util.StatusRedirect, x.Error())
+
+	for i := range x.nodes {
+		var d status.Detail
+		d.SetID(RedirectDetailID)
+		d.SetValue(x.nodes[i].Marshal())
+
+		st.AppendDetails(d)
+	}
+
+	return st
+}
+
+// nodeHealth tracks the failures of the requests to the remote nodes. Up to
+// maxNodeFailures nodes are tracked, the failures expire after
+// nodeFailurePeriod. Zero value is ready to use.
+type nodeHealth struct {
+	mtx sync.Mutex
+
+	// last failures by binary public keys
+	failures map[string]time.Time
+}
+
+func (h *nodeHealth) reportFailure(key []byte) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.failures == nil {
+		h.failures = make(map[string]time.Time)
+	}
+
+	if _, ok := h.failures[string(key)]; !ok && len(h.failures) >= maxNodeFailures {
+		h.dropExpired()
+
+		if len(h.failures) >= maxNodeFailures {
+			h.dropOldest()
+		}
+	}
+
+	h.failures[string(key)] = time.Now()
+}
+
+func (h *nodeHealth) dropExpired() {
+	for k, t := range h.failures {
+		if time.Since(t) >= nodeFailurePeriod {
+			delete(h.failures, k)
+		}
+	}
+}
+
+func (h *nodeHealth) dropOldest() {
+	var (
+		oldest string
+		at     time.Time
+	)
+
+	for k, t := range h.failures {
+		if at.IsZero() || t.Before(at) {
+			oldest, at = k, t
+		}
+	}
+
+	delete(h.failures, oldest)
+}
+
+func (h *nodeHealth) reportSuccess(key []byte) {
+	h.mtx.Lock()
+	delete(h.failures, string(key))
+	h.mtx.Unlock()
+}
+
+func (h *nodeHealth) healthy(key []byte) bool {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	t, ok := h.failures[string(key)]
+	if ok && time.Since(t) >= nodeFailurePeriod {
+		delete(h.failures, string(key))
+		return true
+	}
+
+	return !ok
+}
+
+func (exec *execCtx) canRedirect() bool {
+	return exec.prm.redirect && !exec.isLocal() && !exec.headOnly() && exec.prmRangeHash == nil
+}
+
+// executeRedirect sets RedirectError with the container nodes of the object
+// in the current epoch. Returns false if there are no such nodes.
+func (exec *execCtx) executeRedirect() bool {
+	if !exec.initEpoch() {
+		return false
+	}
+
+	traverser, ok := exec.generateTraverser(exec.address())
+	if !ok {
+		return false
+	}
+
+	var nodes, failed []netmap.NodeInfo
+
+	for {
+		addrs := traverser.Next()
+		if len(addrs) == 0 {
+			break
+		}
+
+		for i := range addrs {
+			if exec.svc.netmapKeys != nil && exec.svc.netmapKeys.IsLocalKey(addrs[i].PublicKey()) {
+				// object is not stored locally
+				continue
+			}
+
+			if exec.svc.health.healthy(addrs[i].PublicKey()) {
+				nodes = append(nodes, redirectNodeInfo(addrs[i]))
+			} else {
+				failed = append(failed, redirectNodeInfo(addrs[i]))
+			}
+		}
+	}
+
+	nodes = append(nodes, failed...)
+	if len(nodes) == 0 {
+		return false
+	}
+
+	exec.log.Debug("redirecting request to the container nodes",
+		zap.Int("nodes", len(nodes)),
+	)
+
+	exec.status = statusRedirect
+	exec.err = RedirectError{nodes: nodes}
+
+	return true
+}
+
+func redirectNodeInfo(n placement.Node) netmap.NodeInfo {
+	var res netmap.NodeInfo
+	res.SetPublicKey(n.PublicKey())
+	network.WriteToNodeInfo(n.Addresses(), &res)
+
+	var ext []string
+	n.ExternalAddresses().IterateAddresses(func(a network.Address) bool {
+		ext = append(ext, a.String())
+		return false
+	})
+
+	if len(ext) > 0 {
+		res.SetExternalAddresses(ext...)
+	}
+
+	return res
+}
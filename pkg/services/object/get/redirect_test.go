@@ -0,0 +1,204 @@
+package getsvc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRedirect(t *testing.T) {
+	ctx := context.Background()
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(netmaptest.PlacementPolicy())
+
+	var idCnr cid.ID
+	cnr.CalculateID(&idCnr)
+
+	addr := oidtest.Address()
+	addr.SetContainer(idCnr)
+
+	ns, as := testNodeMatrix(t, []int{2, 1})
+	for i := range ns {
+		for j := range ns[i] {
+			ns[i][j].SetPublicKey([]byte(as[i][j]))
+		}
+	}
+
+	obj := generateObject(addr, nil, []byte("Hello, world!"))
+
+	c := newTestClient()
+	c.addResult(addr, obj, nil)
+
+	failed := newTestClient()
+	failed.addResult(addr, nil, errors.New("any error"))
+
+	const curEpoch = 13
+
+	svc := &Service{cfg: new(cfg)}
+	svc.log = test.NewLogger(false)
+	svc.localStorage = newTestStorage()
+	svc.traverserGenerator = &testTraverserGenerator{
+		c: cnr,
+		b: map[uint64]placement.Builder{
+			curEpoch: &testPlacementBuilder{
+				vectors: map[string][][]netmap.NodeInfo{
+					addr.EncodeToString(): ns,
+				},
+			},
+		},
+	}
+	svc.clientCache = &testClientCache{
+		clients: map[string]*testClient{
+			as[0][0]: failed,
+			as[0][1]: c,
+			as[1][0]: c,
+		},
+	}
+	svc.currentEpochReceiver = testEpochReceiver(curEpoch)
+
+	newPrm := func(redirect bool) Prm {
+		p := Prm{}
+		p.SetObjectWriter(NewSimpleObjectWriter())
+		p.SetCommonParameters(new(util.CommonPrm).WithLocalOnly(false))
+		p.WithAddress(addr)
+
+		if redirect {
+			p.AllowRedirect()
+		}
+
+		return p
+	}
+
+	checkRedirect := func(t *testing.T, err error, expected ...netmap.NodeInfo) {
+		var redirect RedirectError
+		require.ErrorAs(t, err, &redirect)
+		require.Len(t, redirect.Nodes(), len(expected))
+
+		for i := range expected {
+			require.Equal(t, expected[i].PublicKey(), redirect.Nodes()[i].PublicKey())
+			require.Equal(t, expected[i].NumberOfNetworkEndpoints(), redirect.Nodes()[i].NumberOfNetworkEndpoints())
+		}
+
+		var keys [][]byte
+
+		apistatus.ErrorToV2(err).IterateDetails(func(d *status.Detail) bool {
+			require.EqualValues(t, RedirectDetailID, d.ID())
+
+			var ni netmap.NodeInfo
+			require.NoError(t, ni.Unmarshal(d.Value()))
+
+			keys = append(keys, ni.PublicKey())
+
+			return false
+		})
+
+		require.Len(t, keys, len(expected))
+		for i := range keys {
+			require.Equal(t, expected[i].PublicKey(), keys[i])
+		}
+	}
+
+	t.Run("redirect", func(t *testing.T) {
+		err := svc.Get(ctx, newPrm(true))
+		checkRedirect(t, err, ns[0][0], ns[0][1], ns[1][0])
+
+		code := apistatus.ErrorToV2(err).Code()
+		require.True(t, code.EqualNumber(2048+uint32(util.StatusRedirect)))
+	})
+
+	t.Run("legacy", func(t *testing.T) {
+		// the first node fails, so it goes last in the next redirect
+		require.NoError(t, svc.Get(ctx, newPrm(false)))
+
+		checkRedirect(t, svc.Get(ctx, newPrm(true)), ns[0][1], ns[1][0], ns[0][0])
+	})
+
+	t.Run("local", func(t *testing.T) {
+		p := newPrm(true)
+		p.SetCommonParameters(new(util.CommonPrm).WithLocalOnly(true))
+
+		require.ErrorIs(t, svc.Get(ctx, p), apistatus.ErrObjectNotFound)
+	})
+
+	t.Run("head", func(t *testing.T) {
+		p := HeadPrm{}
+		p.SetHeaderWriter(NewSimpleObjectWriter())
+		p.SetCommonParameters(new(util.CommonPrm).WithLocalOnly(false))
+		p.WithAddress(addr)
+		p.AllowRedirect()
+
+		require.NoError(t, svc.Head(ctx, p))
+	})
+
+	t.Run("local key", func(t *testing.T) {
+		svc := *svc
+		svc.cfg = &cfg{
+			log:                  svc.log,
+			localStorage:         svc.localStorage,
+			traverserGenerator:   svc.traverserGenerator,
+			clientCache:          svc.clientCache,
+			currentEpochReceiver: svc.currentEpochReceiver,
+			netmapKeys:           testNetmapKeys{string(ns[0][1].PublicKey()): {}},
+		}
+
+		checkRedirect(t, svc.Get(ctx, newPrm(true)), ns[0][0], ns[1][0])
+	})
+
+	t.Run("stored locally", func(t *testing.T) {
+		storage := newTestStorage()
+		storage.addPhy(addr, obj)
+
+		svc := *svc
+		svc.cfg = &cfg{
+			log:                  svc.log,
+			localStorage:         storage,
+			traverserGenerator:   svc.traverserGenerator,
+			clientCache:          svc.clientCache,
+			currentEpochReceiver: svc.currentEpochReceiver,
+		}
+
+		require.NoError(t, svc.Get(ctx, newPrm(true)))
+	})
+}
+
+type testNetmapKeys map[string]struct{}
+
+func (x testNetmapKeys) IsLocalKey(key []byte) bool {
+	_, ok := x[string(key)]
+	return ok
+}
+
+func TestNodeHealth(t *testing.T) {
+	var h nodeHealth
+
+	for i := 0; i < maxNodeFailures+10; i++ {
+		h.reportFailure([]byte(strconv.Itoa(i)))
+	}
+
+	require.Len(t, h.failures, maxNodeFailures)
+	require.False(t, h.healthy([]byte(strconv.Itoa(maxNodeFailures+9))))
+
+	for k := range h.failures {
+		h.failures[k] = time.Now().Add(-nodeFailurePeriod)
+	}
+
+	h.reportFailure([]byte("new"))
+
+	require.Len(t, h.failures, 1)
+	require.False(t, h.healthy([]byte("new")))
+}
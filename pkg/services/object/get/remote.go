@@ -14,14 +14,29 @@ func (exec *execCtx) processNode(info client.NodeInfo) bool {
 
 	client, ok := exec.remoteClient(info)
 	if !ok {
+		exec.svc.health.reportFailure(info.PublicKey())
 		return true
 	}
 
 	obj, err := client.getObject(exec, info)
+	if err == nil || errors.Is(err, apistatus.ErrObjectNotFound) {
+		exec.svc.health.reportSuccess(info.PublicKey())
+	} else {
+		exec.svc.health.reportFailure(info.PublicKey())
+	}
 
 	var errSplitInfo *objectSDK.SplitInfoError
 
 	switch {
+	case isPayloadCorruption(err):
+		// forwarded object has already been partially written, so other
+		// nodes are not tried
+		exec.status = statusCorrupted
+		exec.err = err
+
+		exec.log.Error("payload of the forwarded object is corrupted",
+			zap.String("error", err.Error()),
+		)
 	default:
 		exec.status = statusUndefined
 		exec.err = apistatus.ErrObjectNotFound
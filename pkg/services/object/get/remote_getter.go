@@ -0,0 +1,273 @@
+package getsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	netmapCore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/rate"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// RemoteGetter represents utility for getting the stored objects from a
+// remote host.
+type RemoteGetter struct {
+	keyStorage *util.KeyStorage
+
+	clientCache ClientConstructor
+}
+
+// RemoteGetPrm groups remote get operation parameters.
+type RemoteGetPrm struct {
+	addr oid.Address
+
+	node netmap.NodeInfo
+
+	limiter *rate.Limiter
+}
+
+const remoteOpTTL = 1
+
+// NewRemoteGetter creates, initializes and returns new RemoteGetter instance.
+func NewRemoteGetter(keyStorage *util.KeyStorage, cache ClientConstructor) *RemoteGetter {
+	return &RemoteGetter{
+		keyStorage:  keyStorage,
+		clientCache: cache,
+	}
+}
+
+// WithNodeInfo sets information about the remote node.
+func (p *RemoteGetPrm) WithNodeInfo(v netmap.NodeInfo) *RemoteGetPrm {
+	if p != nil {
+		p.node = v
+	}
+
+	return p
+}
+
+// WithObjectAddress sets object address.
+func (p *RemoteGetPrm) WithObjectAddress(v oid.Address) *RemoteGetPrm {
+	if p != nil {
+		p.addr = v
+	}
+
+	return p
+}
+
+// WithPayloadLimiter sets limiter of the payload transmission rate. Limited
+// payload is read in chunks.
+func (p *RemoteGetPrm) WithPayloadLimiter(v *rate.Limiter) *RemoteGetPrm {
+	if p != nil {
+		p.limiter = v
+	}
+
+	return p
+}
+
+// Get requests the object stored on the remote node on behalf of the local
+// node. Returned object is verified: its ID, signature and payload checksum
+// are checked.
+func (g *RemoteGetter) Get(ctx context.Context, prm *RemoteGetPrm) (*object.Object, error) {
+	getPrm, info, err := g.prepare(ctx, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := internalclient.GetObject(getPrm)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not get object from %s: %w", g, info.AddressGroup(), err)
+	}
+
+	obj := res.Object()
+
+	if err := g.checkHeader(obj, prm.addr, info); err != nil {
+		return nil, err
+	}
+
+	if err := obj.VerifyPayloadChecksum(); err != nil {
+		return nil, fmt.Errorf("(%T) invalid object received from %s: %w", g, info.AddressGroup(), err)
+	}
+
+	return obj, nil
+}
+
+// ReadNearest streams the object from the nearest of the given nodes on
+// behalf of the local node: all nodes are probed with HEAD requests at once
+// and the object is read from the first responded one. If reading fails
+// before the header is written, the next responded node is used. The header
+// is verified before being written to w, the payload chunks are written as
+// they are received, so the payload checksum must be verified by w.
+func (g *RemoteGetter) ReadNearest(ctx context.Context, nodes []netmap.NodeInfo, prm *RemoteGetPrm, w ObjectWriter) error {
+	if len(nodes) == 0 {
+		return errors.New("no nodes to read the object from")
+	}
+
+	probeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered not to block the probes after return
+	responded := make(chan netmap.NodeInfo, len(nodes))
+
+	for i := range nodes {
+		go func(node netmap.NodeInfo) {
+			if g.probe(probeCtx, node, prm.addr) != nil {
+				node = netmap.NodeInfo{}
+			}
+
+			responded <- node
+		}(nodes[i])
+	}
+
+	var firstErr error
+
+	for range nodes {
+		var node netmap.NodeInfo
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case node = <-responded:
+		}
+
+		if node.PublicKey() == nil {
+			continue
+		}
+
+		var hdrWritten bool
+
+		err := g.read(ctx, node, prm, &headerTracker{ObjectWriter: w, written: &hdrWritten})
+		if err == nil || hdrWritten {
+			return err
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if firstErr == nil {
+		firstErr = errors.New("object is not found on the nodes")
+	}
+
+	return firstErr
+}
+
+// headerTracker tracks whether the header has been written to the underlying
+// writer.
+type headerTracker struct {
+	ObjectWriter
+
+	written *bool
+}
+
+func (t *headerTracker) WriteHeader(hdr *object.Object) error {
+	*t.written = true
+	return t.ObjectWriter.WriteHeader(hdr)
+}
+
+func (g *RemoteGetter) probe(ctx context.Context, node netmap.NodeInfo, addr oid.Address) error {
+	signer, err := g.keyStorage.GetSigner(nil)
+	if err != nil {
+		return err
+	}
+
+	var info clientcore.NodeInfo
+
+	err = clientcore.NodeInfoFromRawNetmapElement(&info, netmapCore.Node(node))
+	if err != nil {
+		return err
+	}
+
+	c, err := g.clientCache.Get(info)
+	if err != nil {
+		return err
+	}
+
+	var headPrm internalclient.HeadObjectPrm
+
+	headPrm.SetContext(ctx)
+	headPrm.SetClient(c)
+	headPrm.SetSigner(signer)
+	headPrm.SetAddress(addr)
+	headPrm.SetTTL(remoteOpTTL)
+	headPrm.SetRawFlag()
+
+	_, err = internalclient.HeadObject(headPrm)
+
+	return err
+}
+
+func (g *RemoteGetter) read(ctx context.Context, node netmap.NodeInfo, prm *RemoteGetPrm, w ObjectWriter) error {
+	nodePrm := *prm
+	nodePrm.node = node
+
+	getPrm, info, err := g.prepare(ctx, &nodePrm)
+	if err != nil {
+		return err
+	}
+
+	err = internalclient.ReadObject(getPrm, func(hdr *object.Object) error {
+		if err := g.checkHeader(hdr, prm.addr, info); err != nil {
+			return err
+		}
+
+		return w.WriteHeader(hdr)
+	}, w.WriteChunk)
+	if err != nil {
+		return fmt.Errorf("(%T) could not read object from %s: %w", g, info.AddressGroup(), err)
+	}
+
+	return nil
+}
+
+func (g *RemoteGetter) prepare(ctx context.Context, prm *RemoteGetPrm) (internalclient.GetObjectPrm, clientcore.NodeInfo, error) {
+	var (
+		getPrm internalclient.GetObjectPrm
+		info   clientcore.NodeInfo
+	)
+
+	signer, err := g.keyStorage.GetSigner(nil)
+	if err != nil {
+		return getPrm, info, fmt.Errorf("(%T) could not receive private key: %w", g, err)
+	}
+
+	err = clientcore.NodeInfoFromRawNetmapElement(&info, netmapCore.Node(prm.node))
+	if err != nil {
+		return getPrm, info, fmt.Errorf("parse client node info: %w", err)
+	}
+
+	c, err := g.clientCache.Get(info)
+	if err != nil {
+		return getPrm, info, fmt.Errorf("(%T) could not create SDK client %s: %w", g, info.AddressGroup(), err)
+	}
+
+	getPrm.SetContext(ctx)
+	getPrm.SetClient(c)
+	getPrm.SetSigner(signer)
+	getPrm.SetAddress(prm.addr)
+	getPrm.SetTTL(remoteOpTTL)
+	getPrm.SetRawFlag()
+	getPrm.SetPayloadLimiter(prm.limiter)
+
+	return getPrm, info, nil
+}
+
+// checkHeader checks that the received header is the header of the requested
+// object and verifies its ID and signature.
+func (g *RemoteGetter) checkHeader(hdr *object.Object, addr oid.Address, info clientcore.NodeInfo) error {
+	if id, ok := hdr.ID(); !ok || id != addr.Object() {
+		return fmt.Errorf("(%T) wrong object received from %s", g, info.AddressGroup())
+	}
+
+	if err := hdr.CheckHeaderVerificationFields(); err != nil {
+		return fmt.Errorf("(%T) invalid object received from %s: %w", g, info.AddressGroup(), err)
+	}
+
+	return nil
+}
@@ -1,12 +1,19 @@
 package getsvc
 
 import (
+	"context"
+	"time"
+
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	ecsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/ec"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
@@ -17,6 +24,10 @@ type Service struct {
 	*cfg
 }
 
+// DefaultAssemblyConcurrency is a default number of child objects fetched
+// concurrently while assembling the large object.
+const DefaultAssemblyConcurrency = 4
+
 // Option is a Service's constructor option.
 type Option func(*cfg)
 
@@ -27,6 +38,14 @@ type getClient interface {
 type cfg struct {
 	assembly bool
 
+	assemblyWindow int
+
+	verifyPayload bool
+
+	cache *objectCache
+
+	cacheMetrics CacheMetrics
+
 	log *zap.Logger
 
 	localStorage interface {
@@ -45,15 +64,36 @@ type cfg struct {
 		currentEpoch() (uint64, error)
 	}
 
+	ecPlacement interface {
+		GenerateECPlacement(cid.ID, oid.ID, uint64) (ec.Rule, []netmapSDK.NodeInfo, error)
+	}
+
+	ecParts interface {
+		Heads(context.Context, netmapSDK.NodeInfo, cid.ID, oid.ID) ([]*object.Object, error)
+		Get(context.Context, netmapSDK.NodeInfo, oid.Address) (*object.Object, error)
+	}
+
+	localECParts interface {
+		Heads(cid.ID, oid.ID) ([]*object.Object, error)
+		Get(oid.Address) (*object.Object, error)
+	}
+
 	keyStore *util.KeyStorage
+
+	netmapKeys netmap.AnnouncedKeys
+
+	health nodeHealth
+
+	corruptionHandler func(objectcore.AddressWithType)
 }
 
 func defaultCfg() *cfg {
 	return &cfg{
-		assembly:     true,
-		log:          zap.L(),
-		localStorage: new(storageEngineWrapper),
-		clientCache:  new(clientCacheWrapper),
+		assembly:       true,
+		assemblyWindow: DefaultAssemblyConcurrency,
+		log:            zap.L(),
+		localStorage:   new(storageEngineWrapper),
+		clientCache:    new(clientCacheWrapper),
 	}
 }
 
@@ -66,6 +106,10 @@ func New(opts ...Option) *Service {
 		opts[i](c)
 	}
 
+	if c.cache != nil && c.cacheMetrics != nil {
+		c.cache.metrics = c.cacheMetrics
+	}
+
 	return &Service{
 		cfg: c,
 	}
@@ -85,11 +129,63 @@ func WithoutAssembly() Option {
 	}
 }
 
+// WithAssemblyConcurrency returns option to set the number of child objects
+// fetched concurrently while assembling the large object. Values less than 2
+// make the children to be read one by one.
+func WithAssemblyConcurrency(n int) Option {
+	return func(c *cfg) {
+		c.assemblyWindow = n
+	}
+}
+
+// WithPayloadVerification returns option to check the payload of the read
+// objects against their checksums while it is streamed. Payload ranges are
+// not verified.
+func WithPayloadVerification(v bool) Option {
+	return func(c *cfg) {
+		c.verifyPayload = v
+	}
+}
+
+// WithCorruptionHandler returns option to set the handler of the locally
+// stored objects found corrupted by the payload verification.
+func WithCorruptionHandler(f func(objectcore.AddressWithType)) Option {
+	return func(c *cfg) {
+		c.corruptionHandler = f
+	}
+}
+
+// WithObjectCache returns option to cache the read regular objects in memory.
+// Total payload size of the cached objects is limited by size, bigger objects
+// than maxObjectSize are not cached. Cached objects are considered outdated
+// after ttl, zero ttl means no limit. Zero size disables the cache.
+//
+// Raw and local-only requests do not use the cache. Cached objects are removed
+// by InvalidateObjects.
+func WithObjectCache(size, maxObjectSize uint64, ttl time.Duration) Option {
+	return func(c *cfg) {
+		if size > 0 {
+			c.cache = newObjectCache(size, maxObjectSize, ttl)
+		} else {
+			c.cache = nil
+		}
+	}
+}
+
+// WithCacheMetrics returns option to set the statistics register of the
+// object cache.
+func WithCacheMetrics(m CacheMetrics) Option {
+	return func(c *cfg) {
+		c.cacheMetrics = m
+	}
+}
+
 // WithLocalStorageEngine returns option to set local storage
 // instance.
 func WithLocalStorageEngine(e *engine.StorageEngine) Option {
 	return func(c *cfg) {
 		c.localStorage.(*storageEngineWrapper).engine = e
+		c.localECParts = ecsvc.NewLocalPartSource(e)
 	}
 }
 
@@ -104,11 +200,28 @@ func WithClientConstructor(v ClientConstructor) Option {
 	}
 }
 
+// WithNetmapKeys returns option to set the source of the local node keys
+// which are excluded from the redirect targets.
+func WithNetmapKeys(v netmap.AnnouncedKeys) Option {
+	return func(c *cfg) {
+		c.netmapKeys = v
+	}
+}
+
 // WithTraverserGenerator returns option to set generator of
 // placement traverser to get the objects from containers.
 func WithTraverserGenerator(t *util.TraverserGenerator) Option {
 	return func(c *cfg) {
 		c.traverserGenerator = t
+		c.ecPlacement = t
+	}
+}
+
+// WithECPartSource returns option to set source of the erasure-coded object
+// parts stored on the remote nodes.
+func WithECPartSource(s *ecsvc.RemotePartSource) Option {
+	return func(c *cfg) {
+		c.ecParts = s
 	}
 }
 
@@ -129,3 +242,12 @@ func WithKeyStorage(store *util.KeyStorage) Option {
 		c.keyStore = store
 	}
 }
+
+// InvalidateObjects removes the objects from the cache of the read objects.
+// It must be called when the objects are removed. Nil list removes all objects
+// of the container.
+func (s *Service) InvalidateObjects(cnr cid.ID, ids []oid.ID) {
+	if s.cache != nil {
+		s.cache.invalidate(cnr, ids)
+	}
+}
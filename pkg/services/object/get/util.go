@@ -1,7 +1,6 @@
 package getsvc
 
 import (
-	"crypto/ecdsa"
 	"errors"
 	"io"
 
@@ -10,6 +9,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 )
@@ -88,10 +88,10 @@ func (c *clientCacheWrapper) get(info coreclient.NodeInfo) (getClient, error) {
 
 func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*object.Object, error) {
 	if exec.isForwardingEnabled() {
-		return exec.prm.forwarder(exec.ctx, info, c.client)
+		return exec.prm.forwarder(exec.ctx, info, c.client, exec.prm.objWriter)
 	}
 
-	key, err := exec.key()
+	reqSigner, err := exec.signer()
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +104,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 		prm.SetTTL(exec.prm.common.TTL())
 		prm.SetNetmapEpoch(exec.curProcEpoch)
 		prm.SetAddress(exec.address())
-		prm.SetPrivateKey(key)
+		prm.SetSigner(reqSigner)
 		prm.SetSessionToken(exec.prm.common.SessionToken())
 		prm.SetBearerToken(exec.prm.common.BearerToken())
 		prm.SetXHeaders(exec.prm.common.XHeaders())
@@ -136,7 +136,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 		prm.SetTTL(exec.prm.common.TTL())
 		prm.SetNetmapEpoch(exec.curProcEpoch)
 		prm.SetAddress(exec.address())
-		prm.SetPrivateKey(key)
+		prm.SetSigner(reqSigner)
 		prm.SetSessionToken(exec.prm.common.SessionToken())
 		prm.SetBearerToken(exec.prm.common.BearerToken())
 		prm.SetXHeaders(exec.prm.common.XHeaders())
@@ -151,7 +151,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 			if errors.Is(err, apistatus.ErrObjectAccessDenied) {
 				// Current spec allows other storage node to deny access,
 				// fallback to GET here.
-				obj, err := c.get(exec, key)
+				obj, err := c.get(exec, reqSigner)
 				if err != nil {
 					return nil, err
 				}
@@ -172,10 +172,10 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 		return payloadOnlyObject(res.PayloadRange()), nil
 	}
 
-	return c.get(exec, key)
+	return c.get(exec, reqSigner)
 }
 
-func (c *clientWrapper) get(exec *execCtx, key *ecdsa.PrivateKey) (*object.Object, error) {
+func (c *clientWrapper) get(exec *execCtx, reqSigner signer.Signer) (*object.Object, error) {
 	var prm internalclient.GetObjectPrm
 
 	prm.SetContext(exec.context())
@@ -183,7 +183,7 @@ func (c *clientWrapper) get(exec *execCtx, key *ecdsa.PrivateKey) (*object.Objec
 	prm.SetTTL(exec.prm.common.TTL())
 	prm.SetNetmapEpoch(exec.curProcEpoch)
 	prm.SetAddress(exec.address())
-	prm.SetPrivateKey(key)
+	prm.SetSigner(reqSigner)
 	prm.SetSessionToken(exec.prm.common.SessionToken())
 	prm.SetBearerToken(exec.prm.common.BearerToken())
 	prm.SetXHeaders(exec.prm.common.XHeaders())
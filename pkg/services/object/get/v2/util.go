@@ -2,7 +2,6 @@ package getsvc
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -24,6 +23,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/util"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -65,16 +65,20 @@ func (s *Service) toPrm(req *objectV2.GetRequest, stream objectSvc.GetObjectStre
 	p.WithRawFlag(body.GetRaw())
 	p.SetObjectWriter(streamWrapper)
 
+	if commonPrm.Redirect() {
+		p.AllowRedirect()
+	}
+
 	if !commonPrm.LocalOnly() {
 		var onceResign sync.Once
 
 		var onceHeaderSending sync.Once
 		var globalProgress int
 
-		p.SetRequestForwarder(groupAddressRequestForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte) (*object.Object, error) {
+		p.SetRequestForwarder(objectRequestForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte, w getsvc.ObjectWriter) (*object.Object, error) {
 			var err error
 
-			key, err := s.keyStorage.GetKey(nil)
+			nodeSigner, err := s.keyStorage.GetSigner(nil)
 			if err != nil {
 				return nil, err
 			}
@@ -90,7 +94,7 @@ func (s *Service) toPrm(req *objectV2.GetRequest, stream objectSvc.GetObjectStre
 
 				req.SetMetaHeader(metaHdr)
 
-				err = signature.SignServiceMessage(key, req)
+				err = svcutil.SignForwardedRequest(nodeSigner, req)
 			})
 
 			if err != nil {
@@ -163,7 +167,7 @@ func (s *Service) toPrm(req *objectV2.GetRequest, stream objectSvc.GetObjectStre
 					obj.SetHeader(v.GetHeader())
 
 					onceHeaderSending.Do(func() {
-						err = streamWrapper.WriteHeader(object.NewFromV2(obj))
+						err = w.WriteHeader(object.NewFromV2(obj))
 					})
 					if err != nil {
 						return nil, fmt.Errorf("could not write object header in Get forwarder: %w", err)
@@ -181,7 +185,7 @@ func (s *Service) toPrm(req *objectV2.GetRequest, stream objectSvc.GetObjectStre
 						continue
 					}
 
-					if err = streamWrapper.WriteChunk(chunk); err != nil {
+					if err = w.WriteChunk(chunk); err != nil {
 						return nil, fmt.Errorf("could not write object chunk in Get forwarder: %w", err)
 					}
 
@@ -232,6 +236,10 @@ func (s *Service) toRangePrm(req *objectV2.GetRangeRequest, stream objectSvc.Get
 	p.SetChunkWriter(streamWrapper)
 	p.SetRange(object.NewRangeFromV2(body.GetRange()))
 
+	if commonPrm.Redirect() {
+		p.AllowRedirect()
+	}
+
 	err = p.Validate()
 	if err != nil {
 		return nil, fmt.Errorf("request params validation: %w", err)
@@ -241,12 +249,12 @@ func (s *Service) toRangePrm(req *objectV2.GetRangeRequest, stream objectSvc.Get
 		var onceResign sync.Once
 		var globalProgress int
 
-		key, err := s.keyStorage.GetKey(nil)
+		nodeSigner, err := s.keyStorage.GetSigner(nil)
 		if err != nil {
 			return nil, err
 		}
 
-		p.SetRequestForwarder(groupAddressRequestForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte) (*object.Object, error) {
+		p.SetRequestForwarder(objectRequestForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte, w getsvc.ObjectWriter) (*object.Object, error) {
 			var err error
 
 			// once compose and resign forwarding request
@@ -260,7 +268,7 @@ func (s *Service) toRangePrm(req *objectV2.GetRangeRequest, stream objectSvc.Get
 
 				req.SetMetaHeader(metaHdr)
 
-				err = signature.SignServiceMessage(key, req)
+				err = svcutil.SignForwardedRequest(nodeSigner, req)
 			})
 
 			if err != nil {
@@ -321,7 +329,7 @@ func (s *Service) toRangePrm(req *objectV2.GetRangeRequest, stream objectSvc.Get
 						continue
 					}
 
-					if err = streamWrapper.WriteChunk(chunk); err != nil {
+					if err = w.WriteChunk(chunk); err != nil {
 						return nil, fmt.Errorf("could not write object chunk in GetRange forwarder: %w", err)
 					}
 
@@ -367,20 +375,20 @@ func (s *Service) toHashRangePrm(req *objectV2.GetRangeHashRequest) (*getsvc.Ran
 	p.WithAddress(addr)
 
 	if tok := commonPrm.SessionToken(); tok != nil {
-		signerKey, err := s.keyStorage.GetKey(&util.SessionInfo{
+		signer, err := s.keyStorage.GetSigner(&util.SessionInfo{
 			ID:    tok.ID(),
 			Owner: tok.Issuer(),
 		})
 		if err != nil && errors.As(err, new(apistatus.SessionTokenNotFound)) {
 			commonPrm.ForgetTokens()
-			signerKey, err = s.keyStorage.GetKey(nil)
+			signer, err = s.keyStorage.GetSigner(nil)
 		}
 
 		if err != nil {
 			return nil, fmt.Errorf("fetching session key: %w", err)
 		}
 
-		p.WithCachedSignerKey(signerKey)
+		p.WithCachedSigner(signer)
 	}
 
 	rngsV2 := body.GetRanges()
@@ -408,9 +416,8 @@ func (s *Service) toHashRangePrm(req *objectV2.GetRangeHashRequest) (*getsvc.Ran
 
 	if !commonPrm.LocalOnly() {
 		var onceResign sync.Once
-		var key *ecdsa.PrivateKey
 
-		key, err = s.keyStorage.GetKey(nil)
+		nodeSigner, err := s.keyStorage.GetSigner(nil)
 		if err != nil {
 			return nil, err
 		}
@@ -429,7 +436,7 @@ func (s *Service) toHashRangePrm(req *objectV2.GetRangeHashRequest) (*getsvc.Ran
 
 				req.SetMetaHeader(metaHdr)
 
-				err = signature.SignServiceMessage(key, req)
+				err = svcutil.SignForwardedRequest(nodeSigner, req)
 			})
 			if err != nil {
 				return nil, err
@@ -516,10 +523,10 @@ func (s *Service) toHeadPrm(_ context.Context, req *objectV2.HeadRequest, resp *
 	if !commonPrm.LocalOnly() {
 		var onceResign sync.Once
 
-		p.SetRequestForwarder(groupAddressRequestForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte) (*object.Object, error) {
+		p.SetRequestForwarder(objectRequestForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte, _ getsvc.ObjectWriter) (*object.Object, error) {
 			var err error
 
-			key, err := s.keyStorage.GetKey(nil)
+			nodeSigner, err := s.keyStorage.GetSigner(nil)
 			if err != nil {
 				return nil, err
 			}
@@ -535,7 +542,7 @@ func (s *Service) toHeadPrm(_ context.Context, req *objectV2.HeadRequest, resp *
 
 				req.SetMetaHeader(metaHdr)
 
-				err = signature.SignServiceMessage(key, req)
+				err = svcutil.SignForwardedRequest(nodeSigner, req)
 			})
 
 			if err != nil {
@@ -742,6 +749,16 @@ func groupAddressRequestForwarder[V any](f func(context.Context, network.Address
 	}
 }
 
+// objectRequestForwarder is groupAddressRequestForwarder passing the writer of
+// the forwarded object to f.
+func objectRequestForwarder(f func(context.Context, network.Address, client.MultiAddressClient, []byte, getsvc.ObjectWriter) (*object.Object, error)) getsvc.RequestForwarder {
+	return func(ctx context.Context, info client.NodeInfo, c client.MultiAddressClient, w getsvc.ObjectWriter) (*object.Object, error) {
+		return groupAddressRequestForwarder(func(ctx context.Context, addr network.Address, c client.MultiAddressClient, pubkey []byte) (*object.Object, error) {
+			return f(ctx, addr, c, pubkey, w)
+		})(ctx, info, c)
+	}
+}
+
 func writeCurrentVersion(metaHdr *session.RequestMetaHeader) {
 	versionV2 := new(refs.Version)
 
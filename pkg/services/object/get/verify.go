@@ -0,0 +1,151 @@
+package getsvc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// PayloadCorruptionError is returned when the payload of the read object does
+// not match its size or checksums, the stream is interrupted before the last
+// chunk is written in this case.
+//
+// It is transmitted to the clients as the util.StatusPayloadCorrupted status.
+type PayloadCorruptionError struct {
+	reason string
+}
+
+func (x PayloadCorruptionError) Error() string {
+	return "payload corruption: " + x.reason
+}
+
+// ErrorToV2 implements apistatus.StatusV2 interface.
+func (x PayloadCorruptionError) ErrorToV2() *status.Status {
+	return util.NewStatus(util.StatusPayloadCorrupted, x.Error())
+}
+
+// verifyingWriter passes the object to the next writer and incrementally
+// checks the payload against the size and the checksums from the header.
+type verifyingWriter struct {
+	next ObjectWriter
+
+	// set when the header is written
+	hdrWritten bool
+
+	size, written uint64
+
+	hashes []payloadHash
+}
+
+type payloadHash struct {
+	typ      checksum.Type
+	h        hash.Hash
+	expected []byte
+}
+
+func (w *verifyingWriter) WriteHeader(hdr *object.Object) error {
+	w.hdrWritten = true
+	w.size = hdr.PayloadSize()
+
+	if cs, ok := hdr.PayloadChecksum(); ok {
+		w.addHash(cs)
+	}
+
+	if cs, ok := hdr.PayloadHomomorphicHash(); ok {
+		w.addHash(cs)
+	}
+
+	return w.next.WriteHeader(hdr)
+}
+
+func (w *verifyingWriter) addHash(cs checksum.Checksum) {
+	var h hash.Hash
+
+	switch cs.Type() {
+	case checksum.SHA256:
+		h = sha256.New()
+	case checksum.TZ:
+		h = tz.New()
+	default:
+		return
+	}
+
+	w.hashes = append(w.hashes, payloadHash{
+		typ:      cs.Type(),
+		h:        h,
+		expected: cs.Value(),
+	})
+}
+
+func (w *verifyingWriter) WriteChunk(p []byte) error {
+	if !w.hdrWritten {
+		// header is not written for the payload ranges
+		return w.next.WriteChunk(p)
+	}
+
+	w.written += uint64(len(p))
+	if w.written > w.size {
+		return PayloadCorruptionError{
+			reason: fmt.Sprintf("payload exceeds declared size %d", w.size),
+		}
+	}
+
+	for i := range w.hashes {
+		w.hashes[i].h.Write(p)
+	}
+
+	if w.written == w.size {
+		if err := w.verify(); err != nil {
+			return err
+		}
+	}
+
+	return w.next.WriteChunk(p)
+}
+
+func (w *verifyingWriter) verify() error {
+	for i := range w.hashes {
+		if !bytes.Equal(w.hashes[i].h.Sum(nil), w.hashes[i].expected) {
+			return PayloadCorruptionError{
+				reason: fmt.Sprintf("%s checksum mismatch", w.hashes[i].typ),
+			}
+		}
+	}
+
+	return nil
+}
+
+// complete checks that the whole declared payload has been written. Empty
+// payloads are verified here since no chunks are written for them.
+func (w *verifyingWriter) complete() error {
+	if !w.hdrWritten {
+		// nothing has been read, forwarded objects are written through the
+		// verifier too
+		return nil
+	}
+
+	if w.written != w.size {
+		return PayloadCorruptionError{
+			reason: fmt.Sprintf("payload size %d differs from declared %d", w.written, w.size),
+		}
+	}
+
+	if w.size == 0 {
+		return w.verify()
+	}
+
+	return nil
+}
+
+func isPayloadCorruption(err error) bool {
+	var e PayloadCorruptionError
+	return errors.As(err, &e)
+}
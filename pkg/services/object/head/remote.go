@@ -67,7 +67,7 @@ func (p *RemoteHeadPrm) WithObjectAddress(v oid.Address) *RemoteHeadPrm {
 //   - [apistatus.ErrObjectNotFound] error if the requested object is missing
 //   - [apistatus.ErrNodeUnderMaintenance] error if remote node is currently under maintenance
 func (h *RemoteHeader) Head(ctx context.Context, prm *RemoteHeadPrm) (*object.Object, error) {
-	key, err := h.keyStorage.GetKey(nil)
+	signer, err := h.keyStorage.GetSigner(nil)
 	if err != nil {
 		return nil, fmt.Errorf("(%T) could not receive private key: %w", h, err)
 	}
@@ -88,7 +88,7 @@ func (h *RemoteHeader) Head(ctx context.Context, prm *RemoteHeadPrm) (*object.Ob
 
 	headPrm.SetContext(ctx)
 	headPrm.SetClient(c)
-	headPrm.SetPrivateKey(key)
+	headPrm.SetSigner(signer)
 	headPrm.SetAddress(prm.commonHeadPrm.addr)
 	headPrm.SetTTL(remoteOpTTL)
 
@@ -0,0 +1,310 @@
+package object
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-node/pkg/services/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+const (
+	// XHeaderHeadBatch is an X-header of the GET request turning it into the
+	// batch of HEAD requests. The value is a comma-separated list of object
+	// IDs from the container of the request address.
+	XHeaderHeadBatch = "__NEOFS__HEAD_BATCH"
+
+	// XHeaderModifiedSince is an X-header of the batched HEAD request making
+	// the node omit the headers of the objects created no later than the
+	// specified epoch.
+	XHeaderModifiedSince = "__NEOFS__MODIFIED_SINCE"
+
+	// XHeaderBatchItem is an X-header of the batched HEAD response message
+	// carrying the ID of the object the message relates to.
+	XHeaderBatchItem = "__NEOFS__BATCH_ITEM"
+
+	// DefaultHeadBatchSize is a default maximum number of objects in the
+	// batched HEAD request.
+	DefaultHeadBatchSize = 1000
+
+	// number of the batch items processed concurrently.
+	headBatchConcurrency = 16
+)
+
+// HeadBatcher is a ServiceServer serving the batched HEAD requests.
+//
+// NeoFS API has no dedicated RPC for them, so the batch is transmitted as a
+// GET request with XHeaderHeadBatch. The object from the request address
+// followed by the listed ones are requested from the next ServiceServer as
+// separate HEAD requests signed by the node, so they are valid when forwarded
+// to the other nodes. The original request is passed in the context (see
+// RequestOrigin), so the access to each object is checked against its sender
+// and tokens exactly like on the plain HEAD. One response message per object
+// is sent in the request order. The message carries XHeaderBatchItem with the object ID,
+// the status of the HEAD operation and the object header or the split
+// information. Header is omitted for the objects not modified since the epoch
+// from XHeaderModifiedSince if it is set.
+//
+// Batches are requested by the clients aware of them only: plain GET requests
+// never carry XHeaderHeadBatch, so they are passed to the next ServiceServer
+// as is like all other requests. Nodes unaware of the batches serve such a
+// request as the plain GET of the request address, so the aware clients
+// distinguish the batch responses by XHeaderBatchItem. Batches are accounted
+// as the HEAD requests by MetricCollector (see IsHeadBatchRequest).
+type HeadBatcher struct {
+	next ServiceServer
+
+	signer signer.Signer
+
+	maxSize int
+}
+
+// NewHeadBatcher constructs HeadBatcher accepting up to maxSize objects in
+// one batch and signing the HEAD requests via the node signer. Non-positive
+// maxSize is replaced with DefaultHeadBatchSize.
+func NewHeadBatcher(next ServiceServer, s signer.Signer, maxSize int) *HeadBatcher {
+	if maxSize <= 0 {
+		maxSize = DefaultHeadBatchSize
+	}
+
+	return &HeadBatcher{
+		next:    next,
+		signer:  s,
+		maxSize: maxSize,
+	}
+}
+
+// IsHeadBatchRequest checks whether the GET request is the batch of HEAD
+// requests served by HeadBatcher.
+func IsHeadBatchRequest(req *object.GetRequest) bool {
+	for _, x := range req.GetMetaHeader().GetXHeaders() {
+		if x.GetKey() == XHeaderHeadBatch {
+			return true
+		}
+	}
+
+	return false
+}
+
+type headBatch struct {
+	cnr refs.ContainerID
+
+	ids []oid.ID
+
+	// zero if the condition is not set
+	modifiedSince uint64
+}
+
+func (b *HeadBatcher) readBatch(req *object.GetRequest) (*headBatch, error) {
+	var (
+		list, since string
+		found       bool
+	)
+
+	for _, x := range req.GetMetaHeader().GetXHeaders() {
+		switch x.GetKey() {
+		case XHeaderHeadBatch:
+			list, found = x.GetValue(), true
+		case XHeaderModifiedSince:
+			since = x.GetValue()
+		}
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	addr := req.GetBody().GetAddress()
+
+	cnr := addr.GetContainerID()
+	if cnr == nil {
+		return nil, errors.New("missing container ID")
+	}
+
+	var (
+		res headBatch
+		id  oid.ID
+	)
+
+	res.cnr = *cnr
+
+	if v2 := addr.GetObjectID(); v2 != nil {
+		if err := id.ReadFromV2(*v2); err != nil {
+			return nil, fmt.Errorf("invalid object ID: %w", err)
+		}
+
+		res.ids = append(res.ids, id)
+	}
+
+	if list != "" {
+		for _, s := range strings.Split(list, ",") {
+			if err := id.DecodeString(s); err != nil {
+				return nil, fmt.Errorf("invalid object ID in %s header: %w", XHeaderHeadBatch, err)
+			}
+
+			res.ids = append(res.ids, id)
+		}
+	}
+
+	if len(res.ids) > b.maxSize {
+		return nil, fmt.Errorf("too many objects in the HEAD batch: %d > %d", len(res.ids), b.maxSize)
+	}
+
+	if since != "" {
+		var err error
+
+		res.modifiedSince, err = strconv.ParseUint(since, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s header: %w", XHeaderModifiedSince, err)
+		}
+	}
+
+	return &res, nil
+}
+
+func (b *HeadBatcher) Get(req *object.GetRequest, stream GetObjectStream) error {
+	batch, err := b.readBatch(req)
+	if err != nil {
+		return err
+	} else if batch == nil {
+		return b.next.Get(req, stream)
+	}
+
+	// batch headers must not affect the HEAD requests, e.g. when they are
+	// forwarded to the other nodes
+	meta := synthesizedMeta(req.GetMetaHeader(), func(k string) bool {
+		return k != XHeaderHeadBatch && k != XHeaderModifiedSince
+	})
+
+	ctx := WithRequestOrigin(stream.Context(), RequestOrigin{
+		Meta:         req.GetMetaHeader(),
+		Verification: req.GetVerificationHeader(),
+	})
+
+	var (
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, headBatchConcurrency)
+		resp = make([]*object.GetResponse, len(batch.ids))
+	)
+
+	for i := range batch.ids {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			resp[i] = b.headItem(ctx, req, meta, batch, batch.ids[i])
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i := range resp {
+		if err := stream.Send(resp[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *HeadBatcher) headItem(ctx context.Context, req *object.GetRequest, meta *session.RequestMetaHeader, batch *headBatch, id oid.ID) *object.GetResponse {
+	var idV2 refs.ObjectID
+	id.WriteToV2(&idV2)
+
+	var addr refs.Address
+	addr.SetContainerID(&batch.cnr)
+	addr.SetObjectID(&idV2)
+
+	body := new(object.HeadRequestBody)
+	body.SetAddress(&addr)
+	body.SetRaw(req.GetBody().GetRaw())
+
+	headReq := new(object.HeadRequest)
+	headReq.SetBody(body)
+	headReq.SetMetaHeader(meta)
+
+	var headResp *object.HeadResponse
+
+	err := util.SignRequest(b.signer, headReq, body)
+	if err == nil {
+		headResp, err = b.next.Head(ctx, headReq)
+	}
+
+	var respMeta *session.ResponseMetaHeader
+	if headResp != nil {
+		respMeta = headResp.GetMetaHeader()
+	}
+
+	if respMeta == nil {
+		respMeta = new(session.ResponseMetaHeader)
+	}
+
+	var x session.XHeader
+	x.SetKey(XHeaderBatchItem)
+	x.SetValue(id.EncodeToString())
+
+	respMeta.SetXHeaders(append(respMeta.GetXHeaders(), x))
+	respMeta.SetStatus(apistatus.ErrorToV2(err))
+
+	respBody := new(object.GetResponseBody)
+
+	if err == nil {
+		switch v := headResp.GetBody().GetHeaderPart().(type) {
+		case *object.HeaderWithSignature:
+			if batch.modifiedSince == 0 || v.GetHeader().GetCreationEpoch() > batch.modifiedSince {
+				init := new(object.GetObjectPartInit)
+				init.SetObjectID(&idV2)
+				init.SetSignature(v.GetSignature())
+				init.SetHeader(v.GetHeader())
+
+				respBody.SetObjectPart(init)
+			}
+		case *object.SplitInfo:
+			respBody.SetObjectPart(v)
+		}
+	}
+
+	resp := new(object.GetResponse)
+	resp.SetBody(respBody)
+	resp.SetMetaHeader(respMeta)
+
+	return resp
+}
+
+func (b *HeadBatcher) Put(ctx context.Context) (PutObjectStream, error) {
+	return b.next.Put(ctx)
+}
+
+func (b *HeadBatcher) Head(ctx context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	return b.next.Head(ctx, req)
+}
+
+func (b *HeadBatcher) Search(req *object.SearchRequest, stream SearchStream) error {
+	return b.next.Search(req, stream)
+}
+
+func (b *HeadBatcher) Delete(ctx context.Context, req *object.DeleteRequest) (*object.DeleteResponse, error) {
+	return b.next.Delete(ctx, req)
+}
+
+func (b *HeadBatcher) GetRange(req *object.GetRangeRequest, stream GetObjectRangeStream) error {
+	return b.next.GetRange(req, stream)
+}
+
+func (b *HeadBatcher) GetRangeHash(ctx context.Context, req *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error) {
+	return b.next.GetRangeHash(ctx, req)
+}
@@ -0,0 +1,245 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testHeadServer struct {
+	ServiceServer
+
+	// created epochs of the stored objects
+	objs map[oid.ID]uint64
+
+	// plain GET requests
+	gets []*object.GetRequest
+}
+
+func (s *testHeadServer) Get(req *object.GetRequest, _ GetObjectStream) error {
+	s.gets = append(s.gets, req)
+	return nil
+}
+
+func (s *testHeadServer) Head(ctx context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	for _, x := range req.GetMetaHeader().GetXHeaders() {
+		if x.GetKey() == XHeaderHeadBatch {
+			panic("batch header is passed to the HEAD request")
+		}
+	}
+
+	if err := signature.VerifyServiceMessage(req); err != nil {
+		panic(fmt.Sprintf("invalid HEAD request signature: %v", err))
+	}
+
+	if _, ok := RequestOriginFromContext(ctx); !ok {
+		panic("missing origin of the HEAD request")
+	}
+
+	var id oid.ID
+	if err := id.ReadFromV2(*req.GetBody().GetAddress().GetObjectID()); err != nil {
+		return nil, err
+	}
+
+	epoch, ok := s.objs[id]
+	if !ok {
+		return nil, apistatus.ObjectNotFound{}
+	}
+
+	hdr := new(object.Header)
+	hdr.SetCreationEpoch(epoch)
+
+	hws := new(object.HeaderWithSignature)
+	hws.SetHeader(hdr)
+
+	body := new(object.HeadResponseBody)
+	body.SetHeaderPart(hws)
+
+	resp := new(object.HeadResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func testSigner(t *testing.T) signer.Signer {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	return signer.FromPrivateKey(key)
+}
+
+type testGetStream struct {
+	GetObjectStream
+
+	resp []*object.GetResponse
+}
+
+func (s *testGetStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *testGetStream) Send(resp *object.GetResponse) error {
+	s.resp = append(s.resp, resp)
+	return nil
+}
+
+// newGetRequest returns GET request of the object from the random container
+// with the given X-headers. Object ID is omitted if id is nil.
+func newGetRequest(id *oid.ID, xs ...string) *object.GetRequest {
+	var cnr refs.ContainerID
+	cidtest.ID().WriteToV2(&cnr)
+
+	var addr refs.Address
+	addr.SetContainerID(&cnr)
+
+	if id != nil {
+		var idV2 refs.ObjectID
+		id.WriteToV2(&idV2)
+		addr.SetObjectID(&idV2)
+	}
+
+	body := new(object.GetRequestBody)
+	body.SetAddress(&addr)
+
+	hdrs := make([]session.XHeader, len(xs)/2)
+	for i := range hdrs {
+		hdrs[i].SetKey(xs[2*i])
+		hdrs[i].SetValue(xs[2*i+1])
+	}
+
+	meta := new(session.RequestMetaHeader)
+	meta.SetXHeaders(hdrs)
+
+	req := new(object.GetRequest)
+	req.SetBody(body)
+	req.SetMetaHeader(meta)
+
+	return req
+}
+
+func TestHeadBatcher(t *testing.T) {
+	ids := []oid.ID{oidtest.ID(), oidtest.ID(), oidtest.ID()}
+
+	next := &testHeadServer{objs: map[oid.ID]uint64{
+		ids[0]: 10,
+		ids[1]: 20,
+	}}
+
+	newRequest := func(xs ...string) *object.GetRequest {
+		return newGetRequest(&ids[0], xs...)
+	}
+
+	batch := ids[1].EncodeToString() + "," + ids[2].EncodeToString()
+
+	checkItem := func(t *testing.T, resp *object.GetResponse, id oid.ID, code status.Code, withHeader bool) {
+		xs := resp.GetMetaHeader().GetXHeaders()
+		require.Len(t, xs, 1)
+		require.Equal(t, XHeaderBatchItem, xs[0].GetKey())
+		require.Equal(t, id.EncodeToString(), xs[0].GetValue())
+
+		require.Equal(t, code, resp.GetMetaHeader().GetStatus().Code())
+
+		init, ok := resp.GetBody().GetObjectPart().(*object.GetObjectPartInit)
+		require.Equal(t, withHeader, ok)
+
+		if ok {
+			var actual oid.ID
+			require.NoError(t, actual.ReadFromV2(*init.GetObjectID()))
+			require.Equal(t, id, actual)
+		}
+	}
+
+	t.Run("batch", func(t *testing.T) {
+		stream := new(testGetStream)
+
+		require.NoError(t, NewHeadBatcher(next, testSigner(t), 3).Get(newRequest(XHeaderHeadBatch, batch), stream))
+		require.Len(t, stream.resp, 3)
+
+		checkItem(t, stream.resp[0], ids[0], status.OK, true)
+		checkItem(t, stream.resp[1], ids[1], status.OK, true)
+		checkItem(t, stream.resp[2], ids[2], 2049, false) // object not found
+	})
+
+	t.Run("modified since", func(t *testing.T) {
+		stream := new(testGetStream)
+
+		req := newRequest(XHeaderHeadBatch, batch, XHeaderModifiedSince, "10")
+
+		require.NoError(t, NewHeadBatcher(next, testSigner(t), 0).Get(req, stream))
+		require.Len(t, stream.resp, 3)
+
+		checkItem(t, stream.resp[0], ids[0], status.OK, false)
+		checkItem(t, stream.resp[1], ids[1], status.OK, true)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		err := NewHeadBatcher(next, testSigner(t), 2).Get(newRequest(XHeaderHeadBatch, batch), new(testGetStream))
+		require.ErrorContains(t, err, "too many objects")
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		req := newRequest(XHeaderHeadBatch, strings.Repeat("0", 10))
+		require.Error(t, NewHeadBatcher(next, testSigner(t), 0).Get(req, new(testGetStream)))
+	})
+
+	t.Run("plain GET", func(t *testing.T) {
+		next.gets = nil
+
+		// only the batch header turns GET into the batch
+		for _, req := range []*object.GetRequest{
+			newRequest(),
+			newRequest("key", "value"),
+			newRequest(XHeaderModifiedSince, "10"),
+		} {
+			require.False(t, IsHeadBatchRequest(req))
+
+			stream := new(testGetStream)
+			require.NoError(t, NewHeadBatcher(next, testSigner(t), 0).Get(req, stream))
+			require.Empty(t, stream.resp)
+			require.Same(t, req, next.gets[len(next.gets)-1])
+		}
+
+		require.Len(t, next.gets, 3)
+		require.True(t, IsHeadBatchRequest(newRequest(XHeaderHeadBatch, batch)))
+	})
+}
+
+type testMetrics struct {
+	MetricRegister
+
+	gets, heads int
+}
+
+func (m *testMetrics) IncGetReqCounter(bool)            { m.gets++ }
+func (m *testMetrics) AddGetReqDuration(time.Duration)  {}
+func (m *testMetrics) IncHeadReqCounter(bool)           { m.heads++ }
+func (m *testMetrics) AddHeadReqDuration(time.Duration) {}
+
+func TestMetricCollector_HeadBatch(t *testing.T) {
+	metrics := new(testMetrics)
+	next := &testHeadServer{objs: make(map[oid.ID]uint64)}
+	svc := NewMetricCollector(NewHeadBatcher(next, testSigner(t), 0), metrics)
+
+	require.NoError(t, svc.Get(newGetRequest(nil, XHeaderHeadBatch, oidtest.ID().EncodeToString()), new(testGetStream)))
+	require.Zero(t, metrics.gets)
+	require.Equal(t, 1, metrics.heads)
+
+	require.NoError(t, svc.Get(newGetRequest(nil), new(testGetStream)))
+	require.Equal(t, 1, metrics.gets)
+	require.Equal(t, 1, metrics.heads)
+}
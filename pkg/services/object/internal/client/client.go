@@ -3,16 +3,17 @@ package internal
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
-	"errors"
 	"fmt"
 	"io"
 
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/util/rate"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
@@ -33,6 +34,8 @@ type commonPrm struct {
 	local bool
 
 	xHeaders []string
+
+	limiter *rate.Limiter
 }
 
 // SetClient sets base client for NeoFS API communication.
@@ -49,11 +52,11 @@ func (x *commonPrm) SetContext(ctx context.Context) {
 	x.ctx = ctx
 }
 
-// SetPrivateKey sets private key to sign the request(s).
+// SetSigner sets signer of the request(s).
 //
 // Required parameter.
-func (x *commonPrm) SetPrivateKey(key *ecdsa.PrivateKey) {
-	x.signer = user.NewAutoIDSigner(*key)
+func (x *commonPrm) SetSigner(s signer.Signer) {
+	x.signer = signer.User(s, neofscrypto.ECDSA_SHA512)
 }
 
 // SetSessionToken sets token of the session within which request should be sent.
@@ -82,6 +85,17 @@ func (x *commonPrm) SetXHeaders(hs []string) {
 	x.xHeaders = hs
 }
 
+// SetPayloadLimiter sets limiter of the object payload transmission rate.
+//
+// By default payload is transmitted without any limits.
+func (x *commonPrm) SetPayloadLimiter(l *rate.Limiter) {
+	x.limiter = l
+}
+
+// payloadChunkSize is a size of the payload chunks read from the object
+// streams and transmitted with the rate limiter.
+const payloadChunkSize = 256 << 10
+
 type readPrmCommon struct {
 	commonPrm
 }
@@ -139,6 +153,37 @@ func (x GetObjectRes) Object() *object.Object {
 //
 // GetObject ignores the provided session if it is not related to the requested object.
 func GetObject(prm GetObjectPrm) (*GetObjectRes, error) {
+	var (
+		obj *object.Object
+		buf []byte
+	)
+
+	err := ReadObject(prm, func(hdr *object.Object) error {
+		obj = hdr
+		buf = make([]byte, 0, hdr.PayloadSize())
+		return nil
+	}, func(chunk []byte) error {
+		buf = append(buf, chunk...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	obj.SetPayload(buf)
+
+	return &GetObjectRes{
+		obj: obj,
+	}, nil
+}
+
+// ReadObject reads the object by address like GetObject, but passes the
+// header and then the payload chunks to the handlers as they are received
+// instead of buffering the object. Chunks are not reused, so they may be
+// retained by the handler. Handler errors are returned as is.
+//
+// Client, context and key must be set.
+func ReadObject(prm GetObjectPrm, hdrHandler func(*object.Object) error, chunkHandler func([]byte) error) error {
 	// here we ignore session if it is opened for other object since such
 	// request will almost definitely fail. The case can occur, for example,
 	// when session is bound to the parent object and child object is requested.
@@ -158,21 +203,41 @@ func GetObject(prm GetObjectPrm) (*GetObjectRes, error) {
 
 	obj, rdr, err := prm.cli.ObjectGetInit(prm.ctx, prm.cnr, prm.obj, prm.signer, prm.cliPrm)
 	if err != nil {
-		return nil, fmt.Errorf("init object reading: %w", err)
+		return fmt.Errorf("init object reading: %w", err)
 	}
 
-	buf := make([]byte, obj.PayloadSize())
+	defer rdr.Close()
 
-	_, err = rdr.Read(buf)
-	if err != nil && !errors.Is(err, io.EOF) {
-		return nil, fmt.Errorf("read payload: %w", err)
+	if err = hdrHandler(&obj); err != nil {
+		return err
 	}
 
-	obj.SetPayload(buf)
+	for left := obj.PayloadSize(); left > 0; {
+		n := uint64(payloadChunkSize)
+		if n > left {
+			n = left
+		}
 
-	return &GetObjectRes{
-		obj: &obj,
-	}, nil
+		if prm.limiter != nil {
+			if err = prm.limiter.WaitN(prm.ctx, int(n)); err != nil {
+				return fmt.Errorf("wait for payload bandwidth: %w", err)
+			}
+		}
+
+		chunk := make([]byte, n)
+
+		if _, err = io.ReadFull(rdr, chunk); err != nil {
+			return fmt.Errorf("read payload: %w", err)
+		}
+
+		if err = chunkHandler(chunk); err != nil {
+			return err
+		}
+
+		left -= n
+	}
+
+	return nil
 }
 
 // HeadObjectPrm groups parameters of HeadObject operation.
@@ -342,7 +407,7 @@ func PayloadRange(prm PayloadRangePrm) (*PayloadRangeRes, error) {
 		ln = maxInitialBufferSize
 	}
 
-	w := bytes.NewBuffer(make([]byte, ln))
+	w := bytes.NewBuffer(make([]byte, 0, ln))
 	_, err = io.CopyN(w, rdr, int64(prm.ln))
 	if err != nil {
 		return nil, fmt.Errorf("read payload: %w", err)
@@ -383,6 +448,51 @@ func (x PutObjectRes) ID() oid.ID {
 //
 // Returns any error which prevented the operation from completing correctly in error return.
 func PutObject(prm PutObjectPrm) (*PutObjectRes, error) {
+	stream, err := InitPutObject(prm)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := prm.obj.Payload()
+
+	if prm.limiter != nil {
+		for len(payload) > 0 {
+			chunk := payload
+			if len(chunk) > payloadChunkSize {
+				chunk = chunk[:payloadChunkSize]
+			}
+
+			if err = prm.limiter.WaitN(prm.ctx, len(chunk)); err != nil {
+				return nil, fmt.Errorf("wait for payload bandwidth: %w", err)
+			}
+
+			if err = stream.Write(chunk); err != nil {
+				return nil, err
+			}
+
+			payload = payload[len(chunk):]
+		}
+	} else if err = stream.Write(payload); err != nil {
+		return nil, err
+	}
+
+	return stream.Close()
+}
+
+// PutObjectStream is an object stream to the local storage of the remote
+// node.
+type PutObjectStream struct {
+	cli coreclient.Client
+
+	w client.ObjectWriter
+}
+
+// InitPutObject opens the stream of the object to the local storage of the
+// remote node and sends the object header. The payload of the object set in
+// the parameters is ignored, it should be written into the stream.
+//
+// Client, context and key must be set.
+func InitPutObject(prm PutObjectPrm) (*PutObjectStream, error) {
 	var prmCli client.PrmObjectPutInit
 
 	prmCli.MarkLocal()
@@ -402,19 +512,32 @@ func PutObject(prm PutObjectPrm) (*PutObjectRes, error) {
 		return nil, fmt.Errorf("init object writing on client: %w", err)
 	}
 
-	_, err = w.Write(prm.obj.Payload())
-	if err != nil {
-		return nil, fmt.Errorf("write object payload into stream: %w", err)
+	return &PutObjectStream{
+		cli: prm.cli,
+		w:   w,
+	}, nil
+}
+
+// Write sends the next chunk of the object payload.
+func (x *PutObjectStream) Write(chunk []byte) error {
+	if _, err := x.w.Write(chunk); err != nil {
+		return fmt.Errorf("write object payload into stream: %w", err)
 	}
 
-	err = w.Close()
+	return nil
+}
+
+// Close finishes the object stream and returns the result of the remote
+// node.
+func (x *PutObjectStream) Close() (*PutObjectRes, error) {
+	err := x.w.Close()
 	if err != nil {
-		ReportError(prm.cli, err)
+		ReportError(x.cli, err)
 		return nil, fmt.Errorf("finish object stream: %w", err)
 	}
 
 	return &PutObjectRes{
-		id: w.GetResult().StoredObjectID(),
+		id: x.w.GetResult().StoredObjectID(),
 	}, nil
 }
 
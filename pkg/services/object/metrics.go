@@ -57,6 +57,18 @@ func NewMetricCollector(next ServiceServer, register MetricRegister) *MetricColl
 
 func (m MetricCollector) Get(req *object.GetRequest, stream GetObjectStream) (err error) {
 	t := time.Now()
+
+	if IsHeadBatchRequest(req) {
+		// batches of HEAD requests transmit no payload and do not affect the
+		// GET statistics
+		defer func() {
+			m.metrics.IncHeadReqCounter(err == nil)
+			m.metrics.AddHeadReqDuration(time.Since(t))
+		}()
+
+		return m.next.Get(req, stream)
+	}
+
 	defer func() {
 		m.metrics.IncGetReqCounter(err == nil)
 		m.metrics.AddGetReqDuration(time.Since(t))
@@ -0,0 +1,62 @@
+package object
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+)
+
+// RequestOrigin is the client request the node synthesizes the other requests
+// from, e.g. the HEAD requests of the batch. Synthesized requests are signed
+// by the node, so they pass the signature checks when forwarded to the other
+// nodes, while the access is checked against the sender and the tokens of the
+// original request.
+type RequestOrigin struct {
+	Meta *session.RequestMetaHeader
+
+	Verification *session.RequestVerificationHeader
+}
+
+type requestOriginKey struct{}
+
+// WithRequestOrigin returns the context of the request synthesized from the
+// given one.
+func WithRequestOrigin(ctx context.Context, origin RequestOrigin) context.Context {
+	return context.WithValue(ctx, requestOriginKey{}, origin)
+}
+
+// RequestOriginFromContext returns the client request the request with the
+// given context is synthesized from. Returns false for the client requests.
+// Only the services of the node set the origin, it can not be passed through
+// the network.
+func RequestOriginFromContext(ctx context.Context) (RequestOrigin, bool) {
+	v, ok := ctx.Value(requestOriginKey{}).(RequestOrigin)
+	return v, ok
+}
+
+// synthesizedMeta returns the meta header of the request synthesized by the
+// node from the original one. Tokens are dropped since the synthesized
+// request is sent on behalf of the node, the X-headers accepted by the filter
+// are kept.
+func synthesizedMeta(orig *session.RequestMetaHeader, keepXHeader func(string) bool) *session.RequestMetaHeader {
+	meta := new(session.RequestMetaHeader)
+	if orig == nil {
+		return meta
+	}
+
+	meta.SetVersion(orig.GetVersion())
+	meta.SetEpoch(orig.GetEpoch())
+	meta.SetTTL(orig.GetTTL())
+	meta.SetNetworkMagic(orig.GetNetworkMagic())
+
+	var xs []session.XHeader
+	for _, x := range orig.GetXHeaders() {
+		if keepXHeader(x.GetKey()) {
+			xs = append(xs, x)
+		}
+	}
+
+	meta.SetXHeaders(xs)
+
+	return meta
+}
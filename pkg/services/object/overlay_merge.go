@@ -0,0 +1,263 @@
+package object
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// XHeaderMergeOverlays is an X-header of the HEAD request making the node
+// merge the attributes of the object metadata overlays into the returned
+// header. Boolean value is expected.
+const XHeaderMergeOverlays = "__NEOFS__MERGE_OVERLAYS"
+
+// OverlayMerger is a ServiceServer merging the metadata overlays (see
+// objectcore.AttributeOverlayTarget) into the object headers on the HEAD
+// requests with XHeaderMergeOverlays.
+//
+// Overlays of the requested object are searched and read from the next
+// ServiceServer by the requests synthesized from the original one and signed
+// by the node, the access is checked against the original request (see
+// RequestOrigin), so the sender must have access to search and read the
+// objects in the container. Overlays removed concurrently are skipped. The
+// merged header is not the header of the stored object, so its ID is
+// recalculated and signed by the node.
+//
+// All other requests are passed to the next ServiceServer as is.
+type OverlayMerger struct {
+	next ServiceServer
+
+	signer signer.Signer
+}
+
+// NewOverlayMerger constructs OverlayMerger signing the synthesized requests
+// and the merged headers via the node signer.
+func NewOverlayMerger(next ServiceServer, s signer.Signer) *OverlayMerger {
+	return &OverlayMerger{
+		next:   next,
+		signer: s,
+	}
+}
+
+func readMergeOverlays(meta *session.RequestMetaHeader) (bool, error) {
+	for _, x := range meta.GetXHeaders() {
+		if x.GetKey() != XHeaderMergeOverlays {
+			continue
+		}
+
+		merge, err := strconv.ParseBool(x.GetValue())
+		if err != nil {
+			return false, fmt.Errorf("invalid %s header: %w", XHeaderMergeOverlays, err)
+		}
+
+		return merge, nil
+	}
+
+	return false, nil
+}
+
+func (m *OverlayMerger) Head(ctx context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	merge, err := readMergeOverlays(req.GetMetaHeader())
+	if err != nil {
+		return nil, err
+	} else if !merge {
+		return m.next.Head(ctx, req)
+	}
+
+	// merge header must not affect the requests, e.g. when they are forwarded
+	// to the other nodes
+	meta := synthesizedMeta(req.GetMetaHeader(), func(k string) bool {
+		return k != XHeaderMergeOverlays
+	})
+
+	if _, ok := RequestOriginFromContext(ctx); !ok {
+		ctx = WithRequestOrigin(ctx, RequestOrigin{
+			Meta:         req.GetMetaHeader(),
+			Verification: req.GetVerificationHeader(),
+		})
+	}
+
+	headReq := new(object.HeadRequest)
+	headReq.SetBody(req.GetBody())
+	headReq.SetMetaHeader(meta)
+
+	if err := util.SignRequest(m.signer, headReq, req.GetBody()); err != nil {
+		return nil, fmt.Errorf("sign object HEAD request: %w", err)
+	}
+
+	resp, err := m.next.Head(ctx, headReq)
+	if err != nil {
+		return nil, err
+	}
+
+	hws, ok := resp.GetBody().GetHeaderPart().(*object.HeaderWithSignature)
+	if !ok {
+		return resp, nil
+	}
+
+	addr := req.GetBody().GetAddress()
+
+	overlays, err := m.overlays(ctx, meta, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(overlays) == 0 {
+		return resp, nil
+	}
+
+	// header may be shared by the next server, so it is copied
+	v2 := *hws.GetHeader()
+
+	obj := new(object.Object)
+	obj.SetHeader(&v2)
+
+	hdr := objectSDK.NewFromV2(obj)
+	objectcore.MergeOverlays(hdr, overlays)
+
+	if err := hdr.CalculateAndSetID(); err != nil {
+		return nil, fmt.Errorf("calculate ID of the merged header: %w", err)
+	}
+
+	if err := hdr.Sign(signer.SDK(m.signer, neofscrypto.ECDSA_SHA512)); err != nil {
+		return nil, fmt.Errorf("sign merged header: %w", err)
+	}
+
+	var sig refs.Signature
+	hdr.Signature().WriteToV2(&sig)
+
+	hws.SetHeader(hdr.ToV2().GetHeader())
+	hws.SetSignature(&sig)
+
+	return resp, nil
+}
+
+// overlays returns the headers of the overlays of the object with the given
+// address. Requests are sent with the given meta header signed by the node.
+func (m *OverlayMerger) overlays(ctx context.Context, meta *session.RequestMetaHeader, addr *refs.Address) ([]*objectSDK.Object, error) {
+	idV2 := addr.GetObjectID()
+	if idV2 == nil {
+		return nil, errors.New("missing object ID")
+	}
+
+	var id oid.ID
+	if err := id.ReadFromV2(*idV2); err != nil {
+		return nil, fmt.Errorf("invalid object ID: %w", err)
+	}
+
+	var f object.SearchFilter
+	f.SetKey(objectcore.AttributeOverlayTarget)
+	f.SetValue(id.EncodeToString())
+	f.SetMatchType(object.MatchStringEqual)
+
+	searchBody := new(object.SearchRequestBody)
+	searchBody.SetContainerID(addr.GetContainerID())
+	searchBody.SetVersion(1)
+	searchBody.SetFilters([]object.SearchFilter{f})
+
+	searchReq := new(object.SearchRequest)
+	searchReq.SetBody(searchBody)
+	searchReq.SetMetaHeader(meta)
+
+	if err := util.SignRequest(m.signer, searchReq, searchBody); err != nil {
+		return nil, fmt.Errorf("sign overlay SEARCH request: %w", err)
+	}
+
+	stream := &searchIDCollector{ctx: ctx}
+
+	if err := m.next.Search(searchReq, stream); err != nil {
+		return nil, fmt.Errorf("search overlays: %w", err)
+	}
+
+	res := make([]*objectSDK.Object, 0, len(stream.ids))
+
+	for i := range stream.ids {
+		var overlayAddr refs.Address
+		overlayAddr.SetContainerID(addr.GetContainerID())
+		overlayAddr.SetObjectID(&stream.ids[i])
+
+		body := new(object.HeadRequestBody)
+		body.SetAddress(&overlayAddr)
+
+		headReq := new(object.HeadRequest)
+		headReq.SetBody(body)
+		headReq.SetMetaHeader(meta)
+
+		if err := util.SignRequest(m.signer, headReq, body); err != nil {
+			return nil, fmt.Errorf("sign overlay HEAD request: %w", err)
+		}
+
+		resp, err := m.next.Head(ctx, headReq)
+		if err != nil {
+			if errors.As(err, new(apistatus.ObjectNotFound)) || errors.As(err, new(apistatus.ObjectAlreadyRemoved)) {
+				continue
+			}
+
+			return nil, fmt.Errorf("read overlay header: %w", err)
+		}
+
+		hws, ok := resp.GetBody().GetHeaderPart().(*object.HeaderWithSignature)
+		if !ok {
+			continue
+		}
+
+		obj := new(object.Object)
+		obj.SetHeader(hws.GetHeader())
+
+		res = append(res, objectSDK.NewFromV2(obj))
+	}
+
+	return res, nil
+}
+
+// searchIDCollector is a SearchStream collecting the found object IDs.
+type searchIDCollector struct {
+	ctx context.Context
+
+	ids []refs.ObjectID
+}
+
+func (s *searchIDCollector) Context() context.Context {
+	return s.ctx
+}
+
+func (s *searchIDCollector) Send(resp *object.SearchResponse) error {
+	s.ids = append(s.ids, resp.GetBody().GetIDList()...)
+	return nil
+}
+
+func (m *OverlayMerger) Get(req *object.GetRequest, stream GetObjectStream) error {
+	return m.next.Get(req, stream)
+}
+
+func (m *OverlayMerger) Put(ctx context.Context) (PutObjectStream, error) {
+	return m.next.Put(ctx)
+}
+
+func (m *OverlayMerger) Search(req *object.SearchRequest, stream SearchStream) error {
+	return m.next.Search(req, stream)
+}
+
+func (m *OverlayMerger) Delete(ctx context.Context, req *object.DeleteRequest) (*object.DeleteResponse, error) {
+	return m.next.Delete(ctx, req)
+}
+
+func (m *OverlayMerger) GetRange(req *object.GetRangeRequest, stream GetObjectRangeStream) error {
+	return m.next.GetRange(req, stream)
+}
+
+func (m *OverlayMerger) GetRangeHash(ctx context.Context, req *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error) {
+	return m.next.GetRangeHash(ctx, req)
+}
@@ -0,0 +1,224 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testOverlayServer struct {
+	ServiceServer
+
+	hdrs map[oid.ID]*object.Header
+
+	// number of the requests synthesized by the merger
+	synthesized atomic.Int32
+}
+
+func (s *testOverlayServer) checkRequest(ctx context.Context, req interface {
+	GetMetaHeader() *session.RequestMetaHeader
+}) {
+	for _, x := range req.GetMetaHeader().GetXHeaders() {
+		if x.GetKey() == XHeaderMergeOverlays {
+			panic("merge header is passed to the next server")
+		}
+	}
+
+	if _, ok := RequestOriginFromContext(ctx); !ok {
+		return
+	}
+
+	if err := signature.VerifyServiceMessage(req); err != nil {
+		panic(fmt.Sprintf("invalid synthesized request signature: %v", err))
+	}
+
+	s.synthesized.Add(1)
+}
+
+func (s *testOverlayServer) Head(ctx context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	s.checkRequest(ctx, req)
+
+	var id oid.ID
+	if err := id.ReadFromV2(*req.GetBody().GetAddress().GetObjectID()); err != nil {
+		return nil, err
+	}
+
+	hdr, ok := s.hdrs[id]
+	if !ok {
+		return nil, apistatus.ObjectNotFound{}
+	}
+
+	hws := new(object.HeaderWithSignature)
+	hws.SetHeader(hdr)
+
+	body := new(object.HeadResponseBody)
+	body.SetHeaderPart(hws)
+
+	resp := new(object.HeadResponse)
+	resp.SetBody(body)
+
+	return resp, nil
+}
+
+func (s *testOverlayServer) Search(req *object.SearchRequest, stream SearchStream) error {
+	s.checkRequest(stream.Context(), req)
+
+	fs := req.GetBody().GetFilters()
+	if len(fs) != 1 || fs[0].GetKey() != objectcore.AttributeOverlayTarget {
+		panic("unexpected search filters")
+	}
+
+	var ids []refs.ObjectID
+
+	for id, hdr := range s.hdrs {
+		for _, a := range hdr.GetAttributes() {
+			if a.GetKey() == fs[0].GetKey() && a.GetValue() == fs[0].GetValue() {
+				var idV2 refs.ObjectID
+				id.WriteToV2(&idV2)
+
+				ids = append(ids, idV2)
+			}
+		}
+	}
+
+	// overlay removed after the search
+	var removed refs.ObjectID
+	oidtest.ID().WriteToV2(&removed)
+
+	body := new(object.SearchResponseBody)
+	body.SetIDList(append(ids, removed))
+
+	resp := new(object.SearchResponse)
+	resp.SetBody(body)
+
+	return stream.Send(resp)
+}
+
+func TestOverlayMerger(t *testing.T) {
+	target := oidtest.ID()
+
+	newHeader := func(epoch uint64, kv ...string) *object.Header {
+		attrs := make([]object.Attribute, len(kv)/2)
+		for i := range attrs {
+			attrs[i].SetKey(kv[2*i])
+			attrs[i].SetValue(kv[2*i+1])
+		}
+
+		hdr := new(object.Header)
+		hdr.SetCreationEpoch(epoch)
+		hdr.SetAttributes(attrs)
+
+		return hdr
+	}
+
+	next := &testOverlayServer{hdrs: map[oid.ID]*object.Header{
+		target:       newHeader(1, "color", "red", "size", "10"),
+		oidtest.ID(): newHeader(3, objectcore.AttributeOverlayTarget, target.EncodeToString(), "color", "blue"),
+		oidtest.ID(): newHeader(2, objectcore.AttributeOverlayTarget, target.EncodeToString(), "color", "green", "tag", "x"),
+	}}
+
+	newRequest := func(xs ...string) *object.HeadRequest {
+		var cnr refs.ContainerID
+		cidtest.ID().WriteToV2(&cnr)
+
+		var id refs.ObjectID
+		target.WriteToV2(&id)
+
+		var addr refs.Address
+		addr.SetContainerID(&cnr)
+		addr.SetObjectID(&id)
+
+		body := new(object.HeadRequestBody)
+		body.SetAddress(&addr)
+
+		hdrs := make([]session.XHeader, len(xs)/2)
+		for i := range hdrs {
+			hdrs[i].SetKey(xs[2*i])
+			hdrs[i].SetValue(xs[2*i+1])
+		}
+
+		meta := new(session.RequestMetaHeader)
+		meta.SetXHeaders(hdrs)
+
+		req := new(object.HeadRequest)
+		req.SetBody(body)
+		req.SetMetaHeader(meta)
+
+		return req
+	}
+
+	attributes := func(t *testing.T, resp *object.HeadResponse) map[string]string {
+		hws, ok := resp.GetBody().GetHeaderPart().(*object.HeaderWithSignature)
+		require.True(t, ok)
+
+		res := make(map[string]string)
+		for _, a := range hws.GetHeader().GetAttributes() {
+			res[a.GetKey()] = a.GetValue()
+		}
+
+		return res
+	}
+
+	nodeSigner := testSigner(t)
+	merger := NewOverlayMerger(next, nodeSigner)
+
+	t.Run("merge", func(t *testing.T) {
+		resp, err := merger.Head(context.Background(), newRequest(XHeaderMergeOverlays, "true"))
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{
+			"color": "blue",
+			"size":  "10",
+			"tag":   "x",
+		}, attributes(t, resp))
+
+		// target HEAD, SEARCH and HEADs of the found overlays
+		require.EqualValues(t, 5, next.synthesized.Load())
+
+		hws := resp.GetBody().GetHeaderPart().(*object.HeaderWithSignature)
+
+		obj := new(object.Object)
+		obj.SetHeader(hws.GetHeader())
+
+		hdr := objectSDK.NewFromV2(obj)
+
+		id, err := hdr.CalculateID()
+		require.NoError(t, err)
+
+		var sig neofscrypto.Signature
+		require.NoError(t, sig.ReadFromV2(*hws.GetSignature()))
+
+		hdr.SetID(id)
+		hdr.SetSignature(&sig)
+
+		require.True(t, hdr.VerifySignature())
+		require.Equal(t, nodeSigner.PublicKey().Bytes(), sig.PublicKeyBytes())
+	})
+
+	t.Run("plain", func(t *testing.T) {
+		resp, err := merger.Head(context.Background(), newRequest())
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{
+			"color": "red",
+			"size":  "10",
+		}, attributes(t, resp))
+	})
+
+	t.Run("invalid header", func(t *testing.T) {
+		_, err := merger.Head(context.Background(), newRequest(XHeaderMergeOverlays, "maybe"))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,123 @@
+package putsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ObjectSource reads the objects copied and patched by the PUT requests with
+// util.XHeaderCopyFrom and util.XHeaderPatchFrom X-headers.
+type ObjectSource interface {
+	// ReadObject writes the header and then the payload of the object to w.
+	ReadObject(context.Context, *util.CommonPrm, oid.Address, ObjectWriter) error
+
+	// HeadObject returns the header of the object. If raw is set,
+	// object.SplitInfoError is returned for the objects split into children.
+	HeadObject(ctx context.Context, prm *util.CommonPrm, addr oid.Address, raw bool) (*object.Object, error)
+
+	// ReadPayloadRange writes the payload range of the object to w.
+	ReadPayloadRange(ctx context.Context, prm *util.CommonPrm, addr oid.Address, off, ln uint64, w ChunkWriter) error
+}
+
+// ChunkWriter receives the payload of the read object.
+type ChunkWriter interface {
+	WriteChunk([]byte) error
+}
+
+// ObjectWriter receives the copied object.
+type ObjectWriter interface {
+	internal.HeaderWriter
+	ChunkWriter
+}
+
+var errCopyPayload = errors.New("payload of the copied object is taken from the source object")
+
+// copyWriter writes the copied object to the target. The header of the new
+// object is formed from the request one: attributes of the source object
+// missing in it are added.
+type copyWriter struct {
+	hdr *object.Object
+
+	target internal.Target
+}
+
+func (w *copyWriter) WriteHeader(src *object.Object) error {
+	if typ := src.Type(); typ != object.TypeRegular {
+		return fmt.Errorf("objects of %s type can not be copied", typ)
+	}
+
+	inheritAttributes(w.hdr, src)
+	w.hdr.SetPayloadSize(src.PayloadSize())
+
+	return w.target.WriteHeader(w.hdr)
+}
+
+// lifecycleAttributes are the attributes managing the lifecycle of the
+// particular object, they are not inherited by its copies and versions.
+var lifecycleAttributes = map[string]struct{}{
+	objectV2.SysAttributeExpEpoch:  {},
+	objectV2.SysAttributeTickEpoch: {},
+	objectV2.SysAttributeTickTopic: {},
+	objectV2.SysAttributeUploadID:  {},
+}
+
+// inheritAttributes adds the attributes of the source object missing in the
+// header. Attributes set by the storage nodes like the references to the
+// objects the children of which are shared by the source object are not
+// inherited since the children of the new object are stored separately unless
+// it shares them too. Lifecycle attributes like the
+// expiration epoch are not inherited too, the new object lives until the
+// client sets them explicitly.
+func inheritAttributes(hdr, src *object.Object) {
+	attrs := hdr.Attributes()
+	set := make(map[string]struct{}, len(attrs))
+
+	for i := range attrs {
+		set[attrs[i].Key()] = struct{}{}
+	}
+
+	for _, a := range src.Attributes() {
+		if objectcore.IsNodeAttribute(a.Key()) {
+			continue
+		}
+
+		if _, ok := lifecycleAttributes[a.Key()]; ok {
+			continue
+		}
+
+		if _, ok := set[a.Key()]; !ok {
+			attrs = append(attrs, a)
+		}
+	}
+
+	hdr.SetAttributes(attrs...)
+}
+
+func (w *copyWriter) WriteChunk(p []byte) error {
+	_, err := w.target.Write(p)
+	return err
+}
+
+// copyObject reads the source object and writes it to the target. Payload is
+// written as it is read, so the large objects are copied child by child.
+// Objects copied inside the container share the children of the source object
+// instead (see newObjectCopy).
+func (p *Streamer) copyObject() error {
+	err := p.objSource.ReadObject(p.ctx, p.copyPrm, *p.copyPrm.CopySource(), &copyWriter{
+		hdr:    p.copyHdr,
+		target: p.target,
+	})
+	if err != nil {
+		return fmt.Errorf("(%T) could not copy object %s: %w", p, p.copyPrm.CopySource(), err)
+	}
+
+	return nil
+}
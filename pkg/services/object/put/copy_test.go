@@ -0,0 +1,57 @@
+package putsvc
+
+import (
+	"testing"
+
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyWriter(t *testing.T) {
+	newAttr := func(k, v string) objectSDK.Attribute {
+		var a objectSDK.Attribute
+		a.SetKey(k)
+		a.SetValue(v)
+
+		return a
+	}
+
+	payload := []byte("Hello, world!")
+
+	src := objectSDK.New()
+	src.SetAttributes(newAttr("FileName", "src.txt"), newAttr("Type", "text"),
+		newAttr(objectSDK.AttributeExpirationEpoch, "10"))
+	src.SetPayloadSize(uint64(len(payload)))
+
+	t.Run("regular", func(t *testing.T) {
+		hdr := objectSDK.New()
+		hdr.SetAttributes(newAttr("FileName", "dst.txt"))
+
+		next := new(collectingTarget)
+		w := &copyWriter{hdr: hdr, target: next}
+
+		require.NoError(t, w.WriteHeader(src))
+		require.NoError(t, w.WriteChunk(payload[:5]))
+		require.NoError(t, w.WriteChunk(payload[5:]))
+
+		_, err := next.Close()
+		require.NoError(t, err)
+		require.Len(t, next.objs, 1)
+
+		res := next.objs[0]
+		require.Equal(t, payload, res.Payload())
+		require.EqualValues(t, len(payload), res.PayloadSize())
+		require.Equal(t, []objectSDK.Attribute{
+			newAttr("FileName", "dst.txt"),
+			newAttr("Type", "text"),
+		}, res.Attributes())
+	})
+
+	t.Run("tombstone", func(t *testing.T) {
+		tomb := objectSDK.New()
+		tomb.SetType(objectSDK.TypeTombstone)
+
+		w := &copyWriter{hdr: objectSDK.New(), target: new(collectingTarget)}
+		require.Error(t, w.WriteHeader(tomb))
+	})
+}
@@ -6,9 +6,11 @@ import (
 	"sync/atomic"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
 	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
@@ -17,6 +19,7 @@ import (
 type preparedObjectTarget interface {
 	WriteObject(*objectSDK.Object, object.ContentMeta) error
 	Close() (oid.ID, error)
+	OpenStream(*objectSDK.Object) (payloadStream, error)
 }
 
 type distributedTarget struct {
@@ -35,9 +38,27 @@ type distributedTarget struct {
 
 	relay func(nodeDesc) error
 
+	relayStream func(nodeDesc) (payloadStream, error)
+
+	// payload streams to the placement nodes by their public keys, nil if
+	// the payload is buffered
+	streams map[string]streamedNode
+	// failures of the payload streams by the node public keys
+	streamErrs map[string]error
+	streamMtx  sync.Mutex
+	// whether the payload buffer holds the whole streamed payload to replay
+	// it to the reserve nodes
+	replayable bool
+
+	// set if the objects are erasure-coded in the container
+	ecPlacement *ecPlacement
+
 	fmt *object.FormatValidator
 
 	log *zap.Logger
+
+	// called with the objects removed by the saved tombstone, may be nil
+	removalCallback func(cid.ID, []oid.ID)
 }
 
 // parameters and state of container traversal.
@@ -116,21 +137,34 @@ func (x errIncompletePut) Error() string {
 
 func (t *distributedTarget) WriteHeader(obj *objectSDK.Object) error {
 	t.obj = obj
+	t.streams = nil
+
+	if t.streamable(obj) {
+		t.replayable = true
+		return t.openStreams()
+	}
 
 	return nil
 }
 
 func (t *distributedTarget) Write(p []byte) (n int, err error) {
+	if t.streams != nil {
+		t.writeStreams(p)
+
+		return len(p), nil
+	}
+
 	t.payload = append(t.payload, p...)
 
 	return len(p), nil
 }
 
 func (t *distributedTarget) Close() (oid.ID, error) {
-	defer func() {
-		putPayload(t.payload)
-		t.payload = nil
-	}()
+	defer t.release()
+
+	if t.streams != nil {
+		return t.closeStreams()
+	}
 
 	t.obj.SetPayload(t.payload)
 
@@ -140,12 +174,31 @@ func (t *distributedTarget) Close() (oid.ID, error) {
 		return oid.ID{}, fmt.Errorf("(%T) could not validate payload content: %w", t, err)
 	}
 
+	if t.ecPlacement != nil && ec.Applicable(t.obj) {
+		return t.saveErasureCoded()
+	}
+
 	if len(t.obj.Children()) > 0 {
 		// enabling extra broadcast for linking objects
 		t.traversal.extraBroadcastEnabled = true
 	}
 
-	return t.iteratePlacement(t.sendObject)
+	id, err := t.iteratePlacement(t.sendObject)
+	if err == nil && t.removalCallback != nil && t.objMeta.Type() == objectSDK.TypeTombstone {
+		cnr, _ := t.obj.ContainerID()
+		t.removalCallback(cnr, t.objMeta.Objects())
+	}
+
+	return id, err
+}
+
+// release returns the payload buffer to the pool. The target must not be
+// used after.
+func (t *distributedTarget) release() {
+	if t.payload != nil {
+		putPayload(t.payload)
+		t.payload = nil
+	}
 }
 
 func (t *distributedTarget) sendObject(node nodeDesc) error {
@@ -0,0 +1,119 @@
+package putsvc
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
+	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// parameters of the erasure-coded object placement.
+type ecPlacement struct {
+	rule ec.Rule
+
+	// signer returns the signer of the object parts
+	signer func() (neofscrypto.Signer, error)
+
+	// nodes returns storage nodes for the parts of the given object
+	nodes func(oid.ID) ([]netmap.NodeInfo, error)
+
+	// partTarget returns the target saving the part on the given node
+	partTarget func(ni client.NodeInfo, local bool) preparedObjectTarget
+}
+
+// saveErasureCoded splits the object into parts and saves i-th part on the
+// i-th node of the object placement. All parts must be saved.
+func (t *distributedTarget) saveErasureCoded() (oid.ID, error) {
+	id, _ := t.obj.ID()
+
+	nodes, err := t.ecPlacement.nodes(id)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("(%T) could not build placement of the object parts: %w", t, err)
+	}
+
+	signer, err := t.ecPlacement.signer()
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("(%T) could not get signer of the object parts: %w", t, err)
+	}
+
+	parts, err := ec.Encode(t.ecPlacement.rule, t.obj, signer)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("(%T) could not encode object: %w", t, err)
+	}
+
+	var (
+		wg     sync.WaitGroup
+		resErr atomic.Value
+		saved  atomic.Int32
+	)
+
+	for i := range parts {
+		var info client.NodeInfo
+
+		err := client.NodeInfoFromRawNetmapElement(&info, netmapcore.Node(nodes[i]))
+		if err != nil {
+			resErr.Store(fmt.Errorf("parse node info: %w", err))
+			continue
+		}
+
+		isLocal := t.isLocalKey(nodes[i].PublicKey())
+
+		workerPool := t.remotePool
+		if isLocal {
+			workerPool = t.localPool
+		}
+
+		part := parts[i]
+
+		wg.Add(1)
+
+		if err := workerPool.Submit(func() {
+			defer wg.Done()
+
+			err := savePart(t.ecPlacement.partTarget(info, isLocal), part)
+			if err != nil {
+				resErr.Store(err)
+				svcutil.LogServiceError(t.log, "PUT", info.AddressGroup(), err)
+				return
+			}
+
+			saved.Add(1)
+		}); err != nil {
+			wg.Done()
+
+			svcutil.LogWorkerPoolError(t.log, "PUT", err)
+
+			break
+		}
+	}
+
+	wg.Wait()
+
+	if int(saved.Load()) < len(parts) {
+		var err errIncompletePut
+
+		err.singleErr, _ = resErr.Load().(error)
+
+		return oid.ID{}, err
+	}
+
+	return id, nil
+}
+
+func savePart(target preparedObjectTarget, part *objectSDK.Object) error {
+	if err := target.WriteObject(part, object.ContentMeta{}); err != nil {
+		return fmt.Errorf("could not write header: %w", err)
+	} else if _, err := target.Close(); err != nil {
+		return fmt.Errorf("could not close object stream: %w", err)
+	}
+	return nil
+}
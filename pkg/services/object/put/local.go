@@ -61,3 +61,14 @@ func (t *localTarget) Close() (oid.ID, error) {
 
 	return id, nil
 }
+
+// OpenStream returns the stream collecting the object payload. The object is
+// saved in the local storage when the stream is closed. Only regular objects
+// can be streamed.
+func (t *localTarget) OpenStream(hdr *object.Object) (payloadStream, error) {
+	return &localStream{
+		target:  t,
+		hdr:     hdr,
+		payload: make([]byte, 0, hdr.PayloadSize()),
+	}, nil
+}
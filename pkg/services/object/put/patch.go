@@ -0,0 +1,395 @@
+package putsvc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// objectPatch is a state of the PUT request forming the new version of the
+// object with util.XHeaderPatchFrom X-header. Payload of the new version is
+// the payload of the patched object with the range replaced by the request
+// payload. Leading children of the patched object preceding the range are
+// shared by both objects, the rest payload is sliced into new children. The
+// new version references the patched object by objectcore.SharedAttribute,
+// so the shared children are removed with the last object listing them.
+//
+// Objects copied inside the container with util.XHeaderCopyFrom X-header are
+// formed as the patches replacing nothing, so they share all the children of
+// the source object except the last one.
+type objectPatch struct {
+	src ObjectSource
+
+	// object is copied, not patched
+	copy bool
+
+	// parameters of the patched object reading
+	prm *util.CommonPrm
+
+	addr oid.Address
+
+	hdr *object.Object
+
+	// replaced payload range
+	off, ln uint64
+
+	// split ID of the shared children chain
+	splitID *object.SplitID
+
+	// state of the new version seeded with the shared children
+	session *uploadSession
+}
+
+// newObjectPatch reads the patched object and prepares the patch of its
+// payload range set in the request parameters.
+func newObjectPatch(ctx context.Context, src ObjectSource, prm *util.CommonPrm, cnr cid.ID, homoHashRequired bool) (*objectPatch, error) {
+	return openObjectPatch(ctx, src, prm, *prm.PatchSource(), cnr, homoHashRequired, false)
+}
+
+// newObjectCopy reads the object copied inside the container and prepares
+// its copy sharing the children of the source object.
+func newObjectCopy(ctx context.Context, src ObjectSource, prm *util.CommonPrm, homoHashRequired bool) (*objectPatch, error) {
+	addr := *prm.CopySource()
+
+	return openObjectPatch(ctx, src, prm, addr, addr.Container(), homoHashRequired, true)
+}
+
+func openObjectPatch(ctx context.Context, src ObjectSource, prm *util.CommonPrm, addr oid.Address, cnr cid.ID, homoHashRequired, copied bool) (*objectPatch, error) {
+	p := &objectPatch{
+		src:     src,
+		copy:    copied,
+		prm:     prm,
+		addr:    addr,
+		session: new(uploadSession),
+	}
+
+	op := "patched"
+	if copied {
+		op = "copied"
+	}
+
+	var err error
+
+	p.hdr, err = src.HeadObject(ctx, prm, p.addr, false)
+	if err != nil {
+		return nil, fmt.Errorf("read %s object header: %w", op, err)
+	}
+
+	if typ := p.hdr.Type(); typ != object.TypeRegular {
+		return nil, fmt.Errorf("objects of %s type can not be %s", typ, op)
+	}
+
+	size := p.hdr.PayloadSize()
+
+	var ok bool
+
+	if !copied {
+		p.off, p.ln, ok = prm.PatchRange()
+	}
+
+	if !ok {
+		p.off, p.ln = size, 0
+	} else if p.off+p.ln > size {
+		return nil, new(apistatus.ObjectOutOfRange)
+	}
+
+	if p.addr.Container() == cnr {
+		if err := p.shareChildren(ctx, homoHashRequired); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// shareChildren seeds the new version with the leading children of the
+// patched object preceding the replaced range. The last child is never shared
+// since it carries the parent header.
+func (p *objectPatch) shareChildren(ctx context.Context, homoHashRequired bool) error {
+	_, err := p.src.HeadObject(ctx, p.prm, p.addr, true)
+	if err == nil {
+		// small object, nothing to share
+		return nil
+	}
+
+	var errSplit *object.SplitInfoError
+	if !errors.As(err, &errSplit) {
+		return fmt.Errorf("read split information of the source object: %w", err)
+	}
+
+	// shared children are bound to the chain by its split ID
+	splitID := errSplit.SplitInfo().SplitID()
+	if splitID == nil {
+		return nil
+	}
+
+	linkID, ok := errSplit.SplitInfo().Link()
+	if !ok {
+		return nil
+	}
+
+	var addr oid.Address
+	addr.SetContainer(p.addr.Container())
+	addr.SetObject(linkID)
+
+	link, err := p.src.HeadObject(ctx, p.prm, addr, true)
+	if err != nil {
+		return fmt.Errorf("read linking object %s: %w", linkID, err)
+	}
+
+	if sid := link.SplitID(); sid == nil || sid.String() != splitID.String() {
+		return fmt.Errorf("linking object %s belongs to the other split chain", linkID)
+	}
+
+	p.splitID = splitID
+
+	us := p.session
+	children := link.Children()
+
+	for i := 0; i < len(children)-1; i++ {
+		addr.SetObject(children[i])
+
+		child, err := p.src.HeadObject(ctx, p.prm, addr, true)
+		if err != nil {
+			return fmt.Errorf("read child object %s: %w", children[i], err)
+		}
+
+		if us.offset+child.PayloadSize() > p.off {
+			break
+		}
+
+		var homoHash []byte
+		if homoHashRequired {
+			cs, ok := child.PayloadHomomorphicHash()
+			if !ok {
+				break
+			}
+
+			homoHash = cs.Value()
+		}
+
+		us.children = append(us.children, children[i])
+		us.homoHashes = append(us.homoHashes, homoHash)
+		us.offset += child.PayloadSize()
+	}
+
+	return nil
+}
+
+// header forms the header of the new version from the request one:
+// attributes of the patched object missing in it are added and the reference
+// to the patched object is set.
+func (p *objectPatch) header(hdr *object.Object) {
+	inheritAttributes(hdr, p.hdr)
+
+	// references set by the client or inherited from the previous versions
+	// are replaced
+	var attrs []object.Attribute
+
+	for _, a := range hdr.Attributes() {
+		k := a.Key()
+		if k != objectcore.AttributePatchOrigin && !strings.HasPrefix(k, objectcore.AttributeSharedPrefix) {
+			attrs = append(attrs, a)
+		}
+	}
+
+	if !p.copy {
+		var a object.Attribute
+		a.SetKey(objectcore.AttributePatchOrigin)
+		a.SetValue(p.addr.Object().EncodeToString())
+
+		attrs = append(attrs, a)
+	}
+
+	if len(p.session.children) > 0 {
+		attrs = append(attrs, p.sharedAttributes()...)
+	}
+
+	hdr.SetAttributes(attrs...)
+
+	// payload size is unknown until the request payload is received
+	hdr.SetPayloadSize(0)
+}
+
+// sharedAttributes returns the attributes referencing the source object and
+// the objects the children of which are shared by it.
+func (p *objectPatch) sharedAttributes() []object.Attribute {
+	var res []object.Attribute
+
+	for _, a := range p.hdr.Attributes() {
+		if strings.HasPrefix(a.Key(), objectcore.AttributeSharedPrefix) {
+			res = append(res, a)
+		}
+	}
+
+	var a object.Attribute
+	a.SetKey(objectcore.SharedAttribute(p.addr.Object()))
+	a.SetValue(p.splitID.String())
+
+	return append(res, a)
+}
+
+// writePrefix writes the payload of the patched object preceding the replaced
+// range to the target. Payload of the shared children is not written, but it
+// is read to calculate the payload checksum of the new version.
+func (p *objectPatch) writePrefix(ctx context.Context, target internal.Target) error {
+	w := &patchPrefixWriter{
+		session: p.session,
+		hashed:  p.session.offset,
+		hash:    sha256.New(),
+		target:  target,
+	}
+
+	if p.off > 0 {
+		err := p.src.ReadPayloadRange(ctx, p.prm, p.addr, 0, p.off, w)
+		if err != nil {
+			return fmt.Errorf("read payload of the source object %s: %w", p.addr, err)
+		}
+	}
+
+	return w.finishHashing()
+}
+
+// writeSuffix writes the payload of the patched object following the replaced
+// range to the target.
+func (p *objectPatch) writeSuffix(ctx context.Context, target internal.Target) error {
+	from := p.off + p.ln
+
+	size := p.hdr.PayloadSize()
+	if from >= size {
+		return nil
+	}
+
+	err := p.src.ReadPayloadRange(ctx, p.prm, p.addr, from, size-from, &chunkTargetWriter{target: target})
+	if err != nil {
+		return fmt.Errorf("read payload of the patched object %s: %w", p.addr, err)
+	}
+
+	return nil
+}
+
+type chunkTargetWriter struct {
+	target internal.Target
+}
+
+func (w *chunkTargetWriter) WriteChunk(p []byte) error {
+	_, err := w.target.Write(p)
+	return err
+}
+
+// patchPrefixWriter hashes the payload of the shared children and writes the
+// rest to the target.
+type patchPrefixWriter struct {
+	session *uploadSession
+
+	// number of the payload bytes to be hashed only
+	hashed uint64
+
+	hash hash.Hash
+
+	target internal.Target
+}
+
+func (w *patchPrefixWriter) WriteChunk(p []byte) error {
+	if w.hashed > 0 {
+		n := w.hashed
+		if n > uint64(len(p)) {
+			n = uint64(len(p))
+		}
+
+		w.hash.Write(p[:n])
+		w.hashed -= n
+		p = p[n:]
+
+		if w.hashed == 0 {
+			if err := w.finishHashing(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(p) == 0 {
+		return nil
+	}
+
+	_, err := w.target.Write(p)
+	return err
+}
+
+// finishHashing saves the checksum state of the shared payload in the
+// session, so the new children continue it.
+func (w *patchPrefixWriter) finishHashing() error {
+	if w.hashed > 0 {
+		return fmt.Errorf("payload of the patched object is %d bytes shorter than its children", w.hashed)
+	}
+
+	if w.session.offset == 0 || w.session.checksum != nil {
+		return nil
+	}
+
+	state, err := w.hash.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("save payload checksum state: %w", err)
+	}
+
+	w.session.checksum = state
+
+	return nil
+}
+
+// patchTarget slices the payload of the new object version like
+// resumableTarget does continuing from the children shared with the patched
+// object. Header of the new version is formed from the validated request one,
+// so the references set by the node are not checked as the client ones.
+type patchTarget struct {
+	*resumableTarget
+
+	patch *objectPatch
+}
+
+func newPatchTarget(rt *resumableTarget, patch *objectPatch) internal.Target {
+	rt.session = patch.session
+	rt.gen = patch.session.gen
+
+	return patchTarget{resumableTarget: rt, patch: patch}
+}
+
+func (t patchTarget) WriteHeader(hdr *object.Object) error {
+	t.patch.header(hdr)
+
+	cnr, ok := hdr.ContainerID()
+	if !ok {
+		return errors.New("missing container ID")
+	}
+
+	owner, err := t.owner(hdr)
+	if err != nil {
+		return err
+	}
+
+	us := t.session
+
+	us.mtx.Lock()
+	defer us.mtx.Unlock()
+
+	us.cnr = cnr
+	t.initHeader(us, hdr, owner)
+
+	return nil
+}
+
+func (t patchTarget) Close() (oid.ID, error) {
+	return t.commit(t.payload, true)
+}
@@ -0,0 +1,361 @@
+package putsvc
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testObjectSource serves the objects written to collectingTarget.
+type testObjectSource struct {
+	objs map[oid.ID]*objectSDK.Object
+
+	// root object assembled from the children, nil for the small objects
+	root *objectSDK.Object
+
+	link oid.ID
+}
+
+func newTestObjectSource(stored []*objectSDK.Object) *testObjectSource {
+	s := &testObjectSource{objs: make(map[oid.ID]*objectSDK.Object)}
+
+	var payload []byte
+
+	for _, obj := range stored {
+		id, _ := obj.ID()
+		s.objs[id] = obj
+
+		if len(obj.Children()) > 0 {
+			s.link = id
+			continue
+		}
+
+		payload = append(payload, obj.Payload()...)
+
+		if par := obj.Parent(); par != nil && len(stored) > 1 {
+			s.root = objectSDK.New()
+			par.CopyTo(s.root)
+		}
+	}
+
+	if s.root != nil {
+		s.root.SetPayload(payload)
+	}
+
+	return s
+}
+
+// address returns the address of the root object.
+func (s *testObjectSource) address(cnr cid.ID) oid.Address {
+	var addr oid.Address
+	addr.SetContainer(cnr)
+
+	if s.root != nil {
+		id, _ := s.root.ID()
+		addr.SetObject(id)
+
+		return addr
+	}
+
+	for id := range s.objs {
+		addr.SetObject(id)
+	}
+
+	return addr
+}
+
+func (s *testObjectSource) object(addr oid.Address) (*objectSDK.Object, error) {
+	if s.root != nil {
+		if id, _ := s.root.ID(); id == addr.Object() {
+			return s.root, nil
+		}
+	}
+
+	obj, ok := s.objs[addr.Object()]
+	if !ok {
+		return nil, apistatus.ObjectNotFound{}
+	}
+
+	return obj, nil
+}
+
+func (s *testObjectSource) ReadObject(context.Context, *util.CommonPrm, oid.Address, ObjectWriter) error {
+	panic("unexpected object reading")
+}
+
+func (s *testObjectSource) HeadObject(_ context.Context, _ *util.CommonPrm, addr oid.Address, raw bool) (*objectSDK.Object, error) {
+	obj, err := s.object(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw && obj == s.root {
+		si := objectSDK.NewSplitInfo()
+		si.SetSplitID(s.objs[s.link].SplitID())
+		si.SetLink(s.link)
+
+		return nil, objectSDK.NewSplitInfoError(si)
+	}
+
+	return obj.CutPayload(), nil
+}
+
+func (s *testObjectSource) ReadPayloadRange(_ context.Context, _ *util.CommonPrm, addr oid.Address, off, ln uint64, w ChunkWriter) error {
+	obj, err := s.object(addr)
+	if err != nil {
+		return err
+	}
+
+	// chunks are split to check the shared payload hashing
+	for _, b := range obj.Payload()[off : off+ln] {
+		if err := w.WriteChunk([]byte{b}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestObjectPatch(t *testing.T) {
+	ctx := context.Background()
+
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	signer := user.NewAutoIDSigner(pk.PrivateKey)
+	owner := signer.UserID()
+	cnr := cidtest.ID()
+
+	newHeader := func() *objectSDK.Object {
+		hdr := objectSDK.New()
+		hdr.SetContainerID(cnr)
+		hdr.SetOwnerID(&owner)
+
+		return hdr
+	}
+
+	const maxObjSize = 4
+
+	newTarget := func(next *collectingTarget) *resumableTarget {
+		return newResumableTarget(nil, uploadPrm{}, maxObjSize, false, signer, nil, 10, next).(*resumableTarget)
+	}
+
+	// store writes the object the way the node slices it
+	store := func(t *testing.T, payload []byte) *testObjectSource {
+		uploads := newUploadSessions(time.Hour, nil, nil, zap.NewNop())
+		next := new(collectingTarget)
+
+		tgt := newResumableTarget(uploads, uploadPrm{id: "upload"}, maxObjSize, false, signer, nil, 10, next)
+		require.NoError(t, tgt.WriteHeader(newHeader()))
+
+		_, err := tgt.Write(payload)
+		require.NoError(t, err)
+
+		_, err = tgt.Close()
+		require.NoError(t, err)
+
+		return newTestObjectSource(next.objs)
+	}
+
+	newPrm := func(t *testing.T, src oid.Address, rng string) *util.CommonPrm {
+		return newXHeaderPrm(t, util.XHeaderPatchFrom, src, rng)
+	}
+
+	patch := func(t *testing.T, src *testObjectSource, addr oid.Address, rng string, payload []byte) ([]*objectSDK.Object, *objectSDK.Object) {
+		p, err := newObjectPatch(ctx, src, newPrm(t, addr, rng), cnr, true)
+		require.NoError(t, err)
+
+		return writePatch(t, p, newTarget, newHeader(), payload)
+	}
+
+	checkPayload := func(t *testing.T, root *objectSDK.Object, expected []byte) {
+		require.EqualValues(t, len(expected), root.PayloadSize())
+
+		cs, _ := root.PayloadChecksum()
+		sum := sha256.Sum256(expected)
+		require.Equal(t, sum[:], cs.Value())
+
+		homo, _ := root.PayloadHomomorphicHash()
+		tzSum := tz.Sum(expected)
+		require.Equal(t, tzSum[:], homo.Value())
+	}
+
+	attribute := func(obj *objectSDK.Object, key string) string {
+		for _, a := range obj.Attributes() {
+			if a.Key() == key {
+				return a.Value()
+			}
+		}
+
+		return ""
+	}
+
+	t.Run("shared children", func(t *testing.T) {
+		src := store(t, []byte("0123456789ab"))
+
+		addr := src.address(cnr)
+
+		stored, root := patch(t, src, addr, "9:2", []byte("XY"))
+		checkPayload(t, root, []byte("012345678XYb"))
+
+		// first two children are shared, the rest payload fits into one child
+		require.Len(t, stored, 2)
+		require.Equal(t, []byte("8XYb"), stored[0].Payload())
+
+		link := stored[1].Children()
+		require.Len(t, link, 3)
+
+		for i := 0; i < 2; i++ {
+			_, ok := src.objs[link[i]]
+			require.True(t, ok)
+		}
+
+		prev, _ := stored[0].PreviousID()
+		require.Equal(t, link[1], prev)
+
+		require.Equal(t, addr.Object().EncodeToString(), attribute(root, objectcore.AttributePatchOrigin))
+
+		origins, err := objectcore.SharedOrigins(root)
+		require.NoError(t, err)
+		require.Equal(t, []oid.ID{addr.Object()}, origins)
+
+		// shared children are bound to the chain of the patched object
+		chains, err := objectcore.SharedChains(root)
+		require.NoError(t, err)
+		require.Len(t, chains, 1)
+		require.Equal(t, src.objs[src.link].SplitID().String(), chains[0].String())
+	})
+
+	t.Run("append", func(t *testing.T) {
+		src := store(t, []byte("012"))
+
+		addr := src.address(cnr)
+
+		_, root := patch(t, src, addr, "", []byte("3456"))
+		checkPayload(t, root, []byte("0123456"))
+
+		origins, err := objectcore.SharedOrigins(root)
+		require.NoError(t, err)
+		require.Empty(t, origins)
+	})
+
+	t.Run("copy", func(t *testing.T) {
+		src := store(t, []byte("0123456789ab"))
+
+		addr := src.address(cnr)
+
+		p, err := newObjectCopy(ctx, src, newXHeaderPrm(t, util.XHeaderCopyFrom, addr, ""), true)
+		require.NoError(t, err)
+
+		// references set by the client are dropped
+		var a objectSDK.Attribute
+		a.SetKey(objectcore.SharedAttribute(oidtest.ID()))
+		a.SetValue("true")
+
+		hdr := newHeader()
+		hdr.SetAttributes(a)
+
+		stored, root := writePatch(t, p, newTarget, hdr, nil)
+		checkPayload(t, root, []byte("0123456789ab"))
+
+		// all children except the last one are shared
+		require.Len(t, stored, 2)
+		require.Equal(t, []byte("89ab"), stored[0].Payload())
+		require.Len(t, stored[1].Children(), 3)
+
+		origins, err := objectcore.SharedOrigins(root)
+		require.NoError(t, err)
+		require.Equal(t, []oid.ID{addr.Object()}, origins)
+		require.Empty(t, attribute(root, objectcore.AttributePatchOrigin))
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		src := store(t, []byte("012"))
+
+		_, err := newObjectPatch(ctx, src, newPrm(t, src.address(cnr), "2:2"), cnr, true)
+		require.ErrorAs(t, err, new(*apistatus.ObjectOutOfRange))
+	})
+}
+
+// newXHeaderPrm returns the request parameters with the X-header referencing
+// the source object and the optional patched range.
+func newXHeaderPrm(t *testing.T, key string, src oid.Address, rng string) *util.CommonPrm {
+	xs := []session.XHeader{{}}
+	xs[0].SetKey(key)
+	xs[0].SetValue(src.EncodeToString())
+
+	if rng != "" {
+		xs = append(xs, session.XHeader{})
+		xs[1].SetKey(util.XHeaderPatchRange)
+		xs[1].SetValue(rng)
+	}
+
+	var meta session.RequestMetaHeader
+	meta.SetXHeaders(xs)
+
+	var req session.RequestMetaHeader
+	req.SetOrigin(&meta)
+
+	prm, err := util.CommonPrmFromV2(metaHolder{&req})
+	require.NoError(t, err)
+
+	return prm
+}
+
+// writePatch forms the object by the prepared patch and returns the stored
+// objects and the root one.
+func writePatch(t *testing.T, p *objectPatch, newTarget func(*collectingTarget) *resumableTarget, hdr *objectSDK.Object, payload []byte) ([]*objectSDK.Object, *objectSDK.Object) {
+	ctx := context.Background()
+
+	next := new(collectingTarget)
+	tgt := newPatchTarget(newTarget(next), p)
+
+	require.NoError(t, tgt.WriteHeader(hdr))
+	require.NoError(t, p.writePrefix(ctx, tgt))
+
+	_, err := tgt.Write(payload)
+	require.NoError(t, err)
+
+	require.NoError(t, p.writeSuffix(ctx, tgt))
+
+	id, err := tgt.Close()
+	require.NoError(t, err)
+
+	for _, obj := range next.objs {
+		if par := obj.Parent(); par != nil {
+			parID, _ := par.ID()
+			require.Equal(t, id, parID)
+			require.NoError(t, par.CheckHeaderVerificationFields())
+
+			return next.objs, par
+		}
+	}
+
+	require.Len(t, next.objs, 1)
+
+	return next.objs, next.objs[0]
+}
+
+type metaHolder struct {
+	meta *session.RequestMetaHeader
+}
+
+func (x metaHolder) GetMetaHeader() *session.RequestMetaHeader {
+	return x.meta
+}
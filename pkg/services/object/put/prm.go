@@ -2,6 +2,7 @@ package putsvc
 
 import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	containerSDK "github.com/nspcc-dev/neofs-sdk-go/container"
@@ -17,9 +18,17 @@ type PutInitPrm struct {
 
 	traverseOpts []placement.Option
 
+	// erasure coding rule of the container, zero if disabled
+	ecRule ec.Rule
+
+	// builder of the erasure-coded object placement
+	ecBuilder placement.Builder
+
 	copiesNumber uint32
 
 	relay func(client.NodeInfo, client.MultiAddressClient) error
+
+	relayStream func(client.NodeInfo, client.MultiAddressClient) (RelayStream, error)
 }
 
 type PutChunkPrm struct {
@@ -50,6 +59,17 @@ func (p *PutInitPrm) WithRelay(f func(client.NodeInfo, client.MultiAddressClient
 	return p
 }
 
+// WithRelayStream sets the function opening the relayed request stream to the
+// remote node. The streams are opened during the stream initialization if the
+// payload is streamed to the nodes as it arrives, see Streamer.PayloadStreamed.
+func (p *PutInitPrm) WithRelayStream(f func(client.NodeInfo, client.MultiAddressClient) (RelayStream, error)) *PutInitPrm {
+	if p != nil {
+		p.relayStream = f
+	}
+
+	return p
+}
+
 func (p *PutInitPrm) WithCopiesNumber(cn uint32) *PutInitPrm {
 	if p != nil {
 		p.copiesNumber = cn
@@ -0,0 +1,65 @@
+package putsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ObjectPuller reads the objects replicated by the PUT requests with
+// util.XHeaderReplicateFrom X-header from the nodes holding them.
+type ObjectPuller interface {
+	// PullObject writes the header and then the payload of the object read
+	// from the nearest of the nodes with the given public keys to w. Payload
+	// is written as it is received.
+	PullObject(ctx context.Context, addr oid.Address, holders [][]byte, w ObjectWriter) error
+}
+
+var errPullPayload = errors.New("payload of the replicated object is pulled from its holders")
+
+// pullWriter writes the pulled object to the target. The pulled object must
+// be the one declared in the request.
+type pullWriter struct {
+	hdr *object.Object
+
+	target internal.Target
+}
+
+func (w *pullWriter) WriteHeader(hdr *object.Object) error {
+	id, _ := hdr.ID()
+	if exp, _ := w.hdr.ID(); id != exp {
+		return fmt.Errorf("pulled object %s differs from the requested one %s", id, exp)
+	}
+
+	return w.target.WriteHeader(hdr)
+}
+
+func (w *pullWriter) WriteChunk(p []byte) error {
+	_, err := w.target.Write(p)
+	return err
+}
+
+// pullObject reads the replicated object from its holders and writes it to
+// the target.
+func (p *Streamer) pullObject() error {
+	id, _ := p.pullHdr.ID()
+	cnr, _ := p.pullHdr.ContainerID()
+
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(id)
+
+	err := p.objPuller.PullObject(p.ctx, addr, p.pullSources, &pullWriter{
+		hdr:    p.pullHdr,
+		target: p.target,
+	})
+	if err != nil {
+		return fmt.Errorf("(%T) could not pull object %s: %w", p, addr, err)
+	}
+
+	return nil
+}
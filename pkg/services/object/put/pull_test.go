@@ -0,0 +1,33 @@
+package putsvc
+
+import (
+	"testing"
+
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullWriter(t *testing.T) {
+	obj := objecttest.Object(t)
+	obj.SetPayload([]byte("Hello, world!"))
+
+	t.Run("requested object", func(t *testing.T) {
+		next := new(collectingTarget)
+		w := &pullWriter{hdr: obj.CutPayload(), target: next}
+
+		require.NoError(t, w.WriteHeader(obj.CutPayload()))
+		require.NoError(t, w.WriteChunk(obj.Payload()[:5]))
+		require.NoError(t, w.WriteChunk(obj.Payload()[5:]))
+
+		_, err := next.Close()
+		require.NoError(t, err)
+		require.Len(t, next.objs, 1)
+		require.Equal(t, obj.Payload(), next.objs[0].Payload())
+	})
+
+	t.Run("other object", func(t *testing.T) {
+		w := &pullWriter{hdr: obj.CutPayload(), target: new(collectingTarget)}
+		other := objecttest.Object(t)
+		require.Error(t, w.WriteHeader(&other))
+	})
+}
@@ -3,12 +3,14 @@ package putsvc
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	netmapCore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/rate"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -25,6 +27,8 @@ type remoteTarget struct {
 
 	obj *object.Object
 
+	limiter *rate.Limiter
+
 	clientConstructor ClientConstructor
 }
 
@@ -41,6 +45,8 @@ type RemotePutPrm struct {
 	node netmap.NodeInfo
 
 	obj *object.Object
+
+	limiter *rate.Limiter
 }
 
 func (t *remoteTarget) WriteObject(obj *object.Object, _ objectcore.ContentMeta) error {
@@ -50,6 +56,46 @@ func (t *remoteTarget) WriteObject(obj *object.Object, _ objectcore.ContentMeta)
 }
 
 func (t *remoteTarget) Close() (oid.ID, error) {
+	prm, err := t.prepare()
+	if err != nil {
+		return oid.ID{}, err
+	}
+
+	prm.SetObject(t.obj)
+	prm.SetPayloadLimiter(t.limiter)
+
+	res, err := internalclient.PutObject(prm)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("(%T) could not put object to %s: %w", t, t.nodeInfo.AddressGroup(), err)
+	}
+
+	return res.ID(), nil
+}
+
+// OpenStream opens the stream of the object to the remote node.
+func (t *remoteTarget) OpenStream(hdr *object.Object) (payloadStream, error) {
+	prm, err := t.prepare()
+	if err != nil {
+		return nil, err
+	}
+
+	prm.SetObject(hdr)
+
+	stream, err := internalclient.InitPutObject(prm)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not open object stream to %s: %w", t, t.nodeInfo.AddressGroup(), err)
+	}
+
+	return &remoteStream{
+		stream: stream,
+		node:   t.nodeInfo,
+	}, nil
+}
+
+// prepare fills common parameters of the remote PUT.
+func (t *remoteTarget) prepare() (internalclient.PutObjectPrm, error) {
+	var prm internalclient.PutObjectPrm
+
 	var sessionInfo *util.SessionInfo
 
 	if tok := t.commonPrm.SessionToken(); tok != nil {
@@ -59,32 +105,24 @@ func (t *remoteTarget) Close() (oid.ID, error) {
 		}
 	}
 
-	key, err := t.keyStorage.GetKey(sessionInfo)
+	signer, err := t.keyStorage.GetSigner(sessionInfo)
 	if err != nil {
-		return oid.ID{}, fmt.Errorf("(%T) could not receive private key: %w", t, err)
+		return prm, fmt.Errorf("(%T) could not receive private key: %w", t, err)
 	}
 
 	c, err := t.clientConstructor.Get(t.nodeInfo)
 	if err != nil {
-		return oid.ID{}, fmt.Errorf("(%T) could not create SDK client %s: %w", t, t.nodeInfo, err)
+		return prm, fmt.Errorf("(%T) could not create SDK client %s: %w", t, t.nodeInfo, err)
 	}
 
-	var prm internalclient.PutObjectPrm
-
 	prm.SetContext(t.ctx)
 	prm.SetClient(c)
-	prm.SetPrivateKey(key)
+	prm.SetSigner(signer)
 	prm.SetSessionToken(t.commonPrm.SessionToken())
 	prm.SetBearerToken(t.commonPrm.BearerToken())
 	prm.SetXHeaders(t.commonPrm.XHeaders())
-	prm.SetObject(t.obj)
 
-	res, err := internalclient.PutObject(prm)
-	if err != nil {
-		return oid.ID{}, fmt.Errorf("(%T) could not put object to %s: %w", t, t.nodeInfo.AddressGroup(), err)
-	}
-
-	return res.ID(), nil
+	return prm, nil
 }
 
 // NewRemoteSender creates, initializes and returns new RemoteSender instance.
@@ -113,11 +151,22 @@ func (p *RemotePutPrm) WithObject(v *object.Object) *RemotePutPrm {
 	return p
 }
 
+// WithPayloadLimiter sets limiter of the payload transmission rate. Limited
+// payload is streamed in chunks.
+func (p *RemotePutPrm) WithPayloadLimiter(v *rate.Limiter) *RemotePutPrm {
+	if p != nil {
+		p.limiter = v
+	}
+
+	return p
+}
+
 // PutObject sends object to remote node.
 func (s *RemoteSender) PutObject(ctx context.Context, p *RemotePutPrm) error {
 	t := &remoteTarget{
 		ctx:               ctx,
 		keyStorage:        s.keyStorage,
+		limiter:           p.limiter,
 		clientConstructor: s.clientConstructor,
 	}
 
@@ -134,3 +183,43 @@ func (s *RemoteSender) PutObject(ctx context.Context, p *RemotePutPrm) error {
 
 	return nil
 }
+
+// RequestPull asks the remote node to pull the object with the given header
+// from the nearest of the holders and store it locally. The object payload
+// is transmitted from the holder to the node directly.
+func (s *RemoteSender) RequestPull(ctx context.Context, node netmap.NodeInfo, hdr *object.Object, holders []netmap.NodeInfo) error {
+	t := &remoteTarget{
+		ctx:               ctx,
+		keyStorage:        s.keyStorage,
+		clientConstructor: s.clientConstructor,
+	}
+
+	err := clientcore.NodeInfoFromRawNetmapElement(&t.nodeInfo, netmapCore.Node(node))
+	if err != nil {
+		return fmt.Errorf("parse client node info: %w", err)
+	}
+
+	prm, err := t.prepare()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, len(holders))
+	for i := range holders {
+		keys[i] = netmap.StringifyPublicKey(holders[i])
+	}
+
+	prm.SetObject(hdr)
+	prm.SetXHeaders([]string{util.XHeaderReplicateFrom, strings.Join(keys, ",")})
+
+	stream, err := internalclient.InitPutObject(prm)
+	if err != nil {
+		return fmt.Errorf("(%T) could not request pull from %s: %w", s, t.nodeInfo.AddressGroup(), err)
+	}
+
+	if _, err = stream.Close(); err != nil {
+		return fmt.Errorf("(%T) could not pull object to %s: %w", s, t.nodeInfo.AddressGroup(), err)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package putsvc
 
 import (
 	"context"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
@@ -9,6 +10,8 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	objutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
 
@@ -53,14 +56,30 @@ type cfg struct {
 
 	clientConstructor ClientConstructor
 
+	uploadLifetime time.Duration
+
+	uploadStore UploadSessionStore
+
+	uploads *uploadSessions
+
+	tombstoneLifetime uint64
+
+	objSource ObjectSource
+
+	objPuller ObjectPuller
+
+	removalCallback func(cid.ID, []oid.ID)
+
 	log *zap.Logger
 }
 
 func defaultCfg() *cfg {
 	return &cfg{
-		remotePool: util.NewPseudoWorkerPool(),
-		localPool:  util.NewPseudoWorkerPool(),
-		log:        zap.L(),
+		remotePool:        util.NewPseudoWorkerPool(),
+		localPool:         util.NewPseudoWorkerPool(),
+		uploadLifetime:    DefaultUploadSessionLifetime,
+		tombstoneLifetime: DefaultTombstoneLifetime,
+		log:               zap.L(),
 	}
 }
 
@@ -73,9 +92,13 @@ func NewService(opts ...Option) *Service {
 
 	c.fmtValidator = object.NewFormatValidator(c.fmtValidatorOpts...)
 
-	return &Service{
+	s := &Service{
 		cfg: c,
 	}
+
+	c.uploads = newUploadSessions(c.uploadLifetime, c.uploadStore, s.tombstoneOrphans, c.log)
+
+	return s
 }
 
 func (p *Service) Put(ctx context.Context) (*Streamer, error) {
@@ -147,3 +170,59 @@ func WithLogger(l *zap.Logger) Option {
 		c.log = l
 	}
 }
+
+// WithUploadSessionLifetime returns option to set the time the resumable
+// upload session is kept after the last stored part. Defaults to
+// DefaultUploadSessionLifetime.
+func WithUploadSessionLifetime(d time.Duration) Option {
+	return func(c *cfg) {
+		if d > 0 {
+			c.uploadLifetime = d
+		}
+	}
+}
+
+// WithUploadSessionStore returns option to keep the resumable upload sessions
+// in the persistent store, so they survive the node restarts. By default,
+// sessions are kept in memory only.
+func WithUploadSessionStore(v UploadSessionStore) Option {
+	return func(c *cfg) {
+		c.uploadStore = v
+	}
+}
+
+// WithTombstoneLifetime returns option to set the lifetime in epochs of the
+// tombstones saved by the service on its own. Defaults to
+// DefaultTombstoneLifetime.
+func WithTombstoneLifetime(v uint64) Option {
+	return func(c *cfg) {
+		if v > 0 {
+			c.tombstoneLifetime = v
+		}
+	}
+}
+
+// WithObjectSource returns option to set the source of the objects copied by
+// the PUT requests. Copying is not supported without it.
+func WithObjectSource(v ObjectSource) Option {
+	return func(c *cfg) {
+		c.objSource = v
+	}
+}
+
+// WithObjectPuller returns option to set the puller of the objects replicated
+// by the PUT requests with the holders list. Such requests are not supported
+// without it.
+func WithObjectPuller(v ObjectPuller) Option {
+	return func(c *cfg) {
+		c.objPuller = v
+	}
+}
+
+// WithRemovalCallback returns option to set the function called with the
+// objects removed by the tombstones saved through the service.
+func WithRemovalCallback(f func(cid.ID, []oid.ID)) Option {
+	return func(c *cfg) {
+		c.removalCallback = f
+	}
+}
@@ -0,0 +1,302 @@
+package putsvc
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
+	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// payloadStream is a stream of the object payload to the single node.
+type payloadStream interface {
+	// WriteChunk sends the next payload chunk.
+	WriteChunk([]byte) error
+	// Close finishes the stream. The object is saved on the node after the
+	// successful Close.
+	Close() error
+}
+
+// RelayStream relays the original PUT request stream of the object to the
+// remote node. WriteChunk is called in the same routine with the
+// Streamer.SendChunk processing the chunk, so the stream can relay the
+// original request carrying it.
+type RelayStream interface {
+	// WriteChunk relays the request carrying the given payload chunk.
+	WriteChunk([]byte) error
+	// Close finishes the relayed stream and checks the response.
+	Close() error
+}
+
+var errNotStreamed = errors.New("object payload is streamed to other nodes")
+
+// streamedNode is a placement node receiving the payload stream.
+type streamedNode struct {
+	node nodeDesc
+
+	stream payloadStream
+}
+
+// localStream collects the payload of the object to be saved in the local
+// storage. The storage accepts whole objects only, so the payload is copied
+// into the buffer of the declared size allocated once.
+type localStream struct {
+	target *localTarget
+
+	hdr *objectSDK.Object
+
+	payload []byte
+}
+
+var errPayloadOverflow = errors.New("payload exceeds the declared size")
+
+func (s *localStream) WriteChunk(p []byte) error {
+	if uint64(len(s.payload)+len(p)) > s.hdr.PayloadSize() {
+		return errPayloadOverflow
+	}
+
+	s.payload = append(s.payload, p...)
+
+	return nil
+}
+
+func (s *localStream) Close() error {
+	defer func() {
+		s.payload = nil
+	}()
+
+	s.hdr.SetPayload(s.payload)
+
+	// only regular objects are streamed, they have no content meta
+	if err := s.target.WriteObject(s.hdr, object.ContentMeta{}); err != nil {
+		return fmt.Errorf("could not write header: %w", err)
+	} else if _, err := s.target.Close(); err != nil {
+		return fmt.Errorf("could not close object stream: %w", err)
+	}
+
+	return nil
+}
+
+// remoteStream streams the payload to the remote node.
+type remoteStream struct {
+	stream *internalclient.PutObjectStream
+
+	node client.NodeInfo
+}
+
+func (s *remoteStream) WriteChunk(p []byte) error {
+	if err := s.stream.Write(p); err != nil {
+		return fmt.Errorf("(%T) could not write payload chunk to %s: %w", s, s.node.AddressGroup(), err)
+	}
+
+	return nil
+}
+
+func (s *remoteStream) Close() error {
+	if _, err := s.stream.Close(); err != nil {
+		return fmt.Errorf("(%T) could not put object to %s: %w", s, s.node.AddressGroup(), err)
+	}
+
+	return nil
+}
+
+// streamable checks whether the object payload can be forwarded to the
+// placement nodes as it arrives. Payload of other objects is buffered: the
+// content of such objects is checked or transformed as a whole.
+func (t *distributedTarget) streamable(obj *objectSDK.Object) bool {
+	if obj.Type() != objectSDK.TypeRegular || len(obj.Children()) > 0 || t.traversal.extraBroadcastEnabled {
+		return false
+	}
+
+	if _, ok := obj.ID(); !ok {
+		return false
+	}
+
+	return t.ecPlacement == nil || !ec.Applicable(obj)
+}
+
+// openStreams opens payload streams to the placement nodes. Unavailable nodes
+// are replaced with the reserve ones like in the usual placement.
+func (t *distributedTarget) openStreams() error {
+	t.streams = make(map[string]streamedNode)
+	t.streamErrs = make(map[string]error)
+
+	_, err := t.iteratePlacement(t.openStream)
+	if err != nil {
+		return fmt.Errorf("(%T) could not open object streams: %w", t, err)
+	}
+
+	return nil
+}
+
+func (t *distributedTarget) openStream(node nodeDesc) error {
+	var (
+		stream payloadStream
+		err    error
+	)
+
+	if !node.local && t.relayStream != nil {
+		stream, err = t.relayStream(node)
+	} else {
+		stream, err = t.nodeTargetInitializer(node).OpenStream(t.obj)
+	}
+
+	key := string(node.info.PublicKey())
+
+	t.streamMtx.Lock()
+	defer t.streamMtx.Unlock()
+
+	if err != nil {
+		err = fmt.Errorf("could not open object stream: %w", err)
+		t.streamErrs[key] = err
+
+		return err
+	}
+
+	t.streams[key] = streamedNode{
+		node:   node,
+		stream: stream,
+	}
+
+	return nil
+}
+
+// streamReplayLimit limits the payload prefix kept to replay it to the reserve
+// nodes replacing the failed streams. Failed streams are not replaced after
+// the payload exceeds the limit.
+const streamReplayLimit = 4 << 20
+
+// writeStreams forwards the payload chunk to all nodes concurrently. Failed
+// streams are closed for further chunks and replaced with the reserve nodes
+// while the written payload can be replayed, the result is checked on Close.
+func (t *distributedTarget) writeStreams(p []byte) {
+	if t.replayable {
+		if len(t.payload)+len(p) > streamReplayLimit {
+			t.replayable = false
+			t.payload = t.payload[:0]
+		} else {
+			t.payload = append(t.payload, p...)
+		}
+	}
+
+	var (
+		wg     sync.WaitGroup
+		failed bool
+	)
+
+	for key, sn := range t.streams {
+		wg.Add(1)
+
+		go func(key string, stream payloadStream) {
+			defer wg.Done()
+
+			if err := stream.WriteChunk(p); err != nil {
+				t.streamMtx.Lock()
+				t.streamErrs[key] = err
+				failed = true
+				t.streamMtx.Unlock()
+			}
+		}(key, sn.stream)
+	}
+
+	wg.Wait()
+
+	for key := range t.streamErrs {
+		delete(t.streams, key)
+	}
+
+	if failed && t.replayable {
+		// the result is checked on Close
+		_, _ = t.iteratePlacement(t.replaceStream)
+	}
+}
+
+// replaceStream opens the payload stream to the reserve node not processed
+// yet and replays the written payload to it. The original requests are not
+// kept, so the payload is sent on behalf of the node even if the object
+// stream is relayed.
+func (t *distributedTarget) replaceStream(node nodeDesc) error {
+	key := string(node.info.PublicKey())
+
+	t.streamMtx.Lock()
+	_, ok := t.streams[key]
+	err, failed := t.streamErrs[key]
+	t.streamMtx.Unlock()
+
+	if ok {
+		return nil
+	} else if failed {
+		return err
+	}
+
+	stream, err := t.nodeTargetInitializer(node).OpenStream(t.obj)
+	if err != nil {
+		err = fmt.Errorf("could not open object stream: %w", err)
+	} else if err = stream.WriteChunk(t.payload); err != nil {
+		err = fmt.Errorf("could not replay the payload: %w", err)
+	}
+
+	t.streamMtx.Lock()
+	defer t.streamMtx.Unlock()
+
+	if err != nil {
+		t.streamErrs[key] = err
+
+		return err
+	}
+
+	t.streams[key] = streamedNode{
+		node:   node,
+		stream: stream,
+	}
+
+	return nil
+}
+
+// closeStreams finishes all payload streams and checks that the object is
+// saved according to the placement.
+func (t *distributedTarget) closeStreams() (oid.ID, error) {
+	var wg sync.WaitGroup
+
+	for key, sn := range t.streams {
+		wg.Add(1)
+
+		go func(key string, stream payloadStream) {
+			defer wg.Done()
+
+			if err := stream.Close(); err != nil {
+				t.streamMtx.Lock()
+				t.streamErrs[key] = err
+				t.streamMtx.Unlock()
+			}
+		}(key, sn.stream)
+	}
+
+	wg.Wait()
+
+	// traverse the placement once again to count the nodes like they are
+	// processed sequentially
+	return t.iteratePlacement(t.streamResult)
+}
+
+func (t *distributedTarget) streamResult(node nodeDesc) error {
+	key := string(node.info.PublicKey())
+
+	t.streamMtx.Lock()
+	defer t.streamMtx.Unlock()
+
+	if err, ok := t.streamErrs[key]; ok {
+		return err
+	}
+
+	if _, ok := t.streams[key]; ok {
+		return nil
+	}
+
+	return errNotStreamed
+}
@@ -0,0 +1,233 @@
+package putsvc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testPlacementBuilder []netmap.NodeInfo
+
+func (b testPlacementBuilder) BuildPlacement(cid.ID, *oid.ID, netmap.PlacementPolicy) ([][]netmap.NodeInfo, error) {
+	return [][]netmap.NodeInfo{b}, nil
+}
+
+// testNode records the payload streamed to the node.
+type testNode struct {
+	openErr, writeErr, closeErr error
+
+	mtx     sync.Mutex
+	payload bytes.Buffer
+	closed  bool
+}
+
+type testTarget struct{ *testNode }
+
+func (t testTarget) WriteObject(*objectSDK.Object, object.ContentMeta) error {
+	return errors.New("unexpected buffered write")
+}
+
+func (t testTarget) Close() (oid.ID, error) {
+	return oid.ID{}, errors.New("unexpected buffered write")
+}
+
+func (t testTarget) OpenStream(*objectSDK.Object) (payloadStream, error) {
+	if t.openErr != nil {
+		return nil, t.openErr
+	}
+	return testStream{t.testNode}, nil
+}
+
+type testStream struct{ *testNode }
+
+func (s testStream) WriteChunk(p []byte) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.writeErr != nil {
+		return s.writeErr
+	}
+	s.payload.Write(p)
+	return nil
+}
+
+func (s testStream) Close() error {
+	s.closed = true
+	return s.closeErr
+}
+
+func newTestStreamingTarget(t *testing.T, targets []*testNode) *distributedTarget {
+	nodes := make([]netmap.NodeInfo, len(targets))
+	byKey := make(map[string]*testNode, len(targets))
+
+	for i := range nodes {
+		nodes[i].SetPublicKey([]byte{byte(i)})
+		nodes[i].SetNetworkEndpoints(fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", 8080+i))
+		byKey[string(nodes[i].PublicKey())] = targets[i]
+	}
+
+	var policy netmap.PlacementPolicy
+	require.NoError(t, policy.DecodeString("REP 2"))
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(policy)
+
+	return &distributedTarget{
+		traversal: traversal{
+			opts: []placement.Option{
+				placement.ForContainer(cnr),
+				placement.UseBuilder(testPlacementBuilder(nodes)),
+			},
+		},
+		remotePool: util.NewPseudoWorkerPool(),
+		localPool:  util.NewPseudoWorkerPool(),
+		nodeTargetInitializer: func(node nodeDesc) preparedObjectTarget {
+			return testTarget{byKey[string(node.info.PublicKey())]}
+		},
+		isLocalKey: func([]byte) bool { return false },
+		log:        zap.NewNop(),
+	}
+}
+
+func TestDistributedTargetStreaming(t *testing.T) {
+	obj := objectSDK.New()
+	obj.SetContainerID(cidtest.ID())
+	obj.SetID(oidtest.ID())
+
+	payload := []byte("Hello, world!")
+
+	t.Run("reserve node", func(t *testing.T) {
+		targets := []*testNode{
+			{openErr: errors.New("unavailable")},
+			{},
+			{},
+		}
+
+		dt := newTestStreamingTarget(t, targets)
+
+		require.NoError(t, dt.WriteHeader(obj))
+		require.NotNil(t, dt.streams, "regular objects must be streamed")
+
+		_, err := dt.Write(payload[:5])
+		require.NoError(t, err)
+		_, err = dt.Write(payload[5:])
+		require.NoError(t, err)
+
+		id, err := dt.Close()
+		require.NoError(t, err)
+
+		expected, _ := obj.ID()
+		require.Equal(t, expected, id)
+
+		require.Zero(t, targets[0].payload.Len())
+		for _, tgt := range targets[1:] {
+			require.True(t, tgt.closed)
+			require.Equal(t, payload, tgt.payload.Bytes())
+		}
+	})
+
+	t.Run("failed stream", func(t *testing.T) {
+		targets := []*testNode{
+			{},
+			{closeErr: errors.New("storage failure")},
+			{},
+		}
+
+		dt := newTestStreamingTarget(t, targets)
+
+		require.NoError(t, dt.WriteHeader(obj))
+
+		_, err := dt.Write(payload)
+		require.NoError(t, err)
+
+		_, err = dt.Close()
+		require.ErrorAs(t, err, new(errIncompletePut))
+	})
+
+	t.Run("replaced stream", func(t *testing.T) {
+		targets := []*testNode{
+			{},
+			{},
+			{},
+		}
+
+		dt := newTestStreamingTarget(t, targets)
+
+		require.NoError(t, dt.WriteHeader(obj))
+
+		_, err := dt.Write(payload[:5])
+		require.NoError(t, err)
+
+		targets[1].writeErr = errors.New("connection lost")
+
+		_, err = dt.Write(payload[5:])
+		require.NoError(t, err)
+
+		_, err = dt.Close()
+		require.NoError(t, err)
+
+		require.Equal(t, payload, targets[0].payload.Bytes())
+		require.Equal(t, payload, targets[2].payload.Bytes(), "written payload must be replayed to the reserve node")
+	})
+
+	t.Run("replay limit", func(t *testing.T) {
+		targets := []*testNode{
+			{},
+			{},
+			{},
+		}
+
+		dt := newTestStreamingTarget(t, targets)
+
+		require.NoError(t, dt.WriteHeader(obj))
+
+		_, err := dt.Write(make([]byte, streamReplayLimit+1))
+		require.NoError(t, err)
+
+		targets[1].writeErr = errors.New("connection lost")
+
+		_, err = dt.Write(payload)
+		require.NoError(t, err)
+
+		_, err = dt.Close()
+		require.ErrorAs(t, err, new(errIncompletePut))
+		require.Zero(t, targets[2].payload.Len())
+	})
+
+	t.Run("not enough nodes", func(t *testing.T) {
+		targets := []*testNode{
+			{openErr: errors.New("unavailable")},
+			{openErr: errors.New("unavailable")},
+			{},
+		}
+
+		dt := newTestStreamingTarget(t, targets)
+
+		require.Error(t, dt.WriteHeader(obj))
+	})
+
+	t.Run("buffered", func(t *testing.T) {
+		dt := newTestStreamingTarget(t, []*testNode{{}, {}, {}})
+
+		tomb := objectSDK.New()
+		tomb.SetType(objectSDK.TypeTombstone)
+		tomb.SetID(oidtest.ID())
+
+		require.NoError(t, dt.WriteHeader(tomb))
+		require.Nil(t, dt.streams)
+	})
+}
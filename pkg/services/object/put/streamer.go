@@ -4,15 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
-	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	nodesigner "github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
-	"github.com/nspcc-dev/neofs-sdk-go/user"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
 type Streamer struct {
@@ -24,7 +29,34 @@ type Streamer struct {
 
 	relay func(client.NodeInfo, client.MultiAddressClient) error
 
+	relayStream func(client.NodeInfo, client.MultiAddressClient) (RelayStream, error)
+
+	// last target distributing the objects to the nodes
+	distributed *distributedTarget
+
+	// parameters of the object copying, nil if the object is not copied
+	copyPrm *util.CommonPrm
+
+	// header of the copied object written after the source header is read
+	copyHdr *object.Object
+
+	// state of the object patching, nil if the object is not patched
+	patch *objectPatch
+
+	// public keys of the nodes to pull the replicated object from, nil if
+	// the payload is sent in the request
+	pullSources [][]byte
+
+	// header of the replicated object declared in the request
+	pullHdr *object.Object
+
 	maxPayloadSz uint64 // network config
+
+	// mtx serializes the stream processing with the release of its
+	// resources when the request is aborted
+	mtx sync.Mutex
+	// closed on the stream Close, nil before Init
+	done chan struct{}
 }
 
 var errNotInit = errors.New("stream not initialized")
@@ -32,17 +64,59 @@ var errNotInit = errors.New("stream not initialized")
 var errInitRecall = errors.New("init recall")
 
 func (p *Streamer) Init(prm *PutInitPrm) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
 	// initialize destination target
 	if err := p.initTarget(prm); err != nil {
 		return fmt.Errorf("(%T) could not initialize object target: %w", p, err)
 	}
 
+	p.done = make(chan struct{})
+	go p.releaseOnAbort(p.done)
+
+	if p.copyPrm != nil {
+		// header is written when the source object is read
+		p.copyHdr = prm.hdr
+		return nil
+	}
+
+	if p.pullSources != nil {
+		// header is written when the object is pulled
+		p.pullHdr = prm.hdr
+		return nil
+	}
+
 	if err := p.target.WriteHeader(prm.hdr); err != nil {
 		return fmt.Errorf("(%T) could not write header to target: %w", p, err)
 	}
+
+	if p.patch != nil {
+		if err := p.patch.writePrefix(p.ctx, p.target); err != nil {
+			return fmt.Errorf("(%T) could not patch object %s: %w", p, p.patch.addr, err)
+		}
+	}
+
 	return nil
 }
 
+// PayloadStreamed reports whether the payload chunks are forwarded to the
+// container nodes as they arrive. Otherwise, the object is sent after the
+// whole payload is received.
+//
+// Must be called after the successful Init.
+func (p *Streamer) PayloadStreamed() bool {
+	return p.distributed != nil && p.distributed.streams != nil
+}
+
+// PayloadPulled reports whether the payload is pulled by the node from the
+// object holders instead of being sent in the request.
+//
+// Must be called after the successful Init.
+func (p *Streamer) PayloadPulled() bool {
+	return p.pullSources != nil
+}
+
 // MaxObjectSize returns maximum payload size for the streaming session.
 //
 // Must be called after the successful Init.
@@ -68,8 +142,55 @@ func (p *Streamer) initTarget(prm *PutInitPrm) error {
 
 	homomorphicChecksumRequired := !prm.cnr.IsHomomorphicHashingDisabled()
 
+	if prm.common.CopySource() != nil {
+		if prm.hdr.Signature() != nil {
+			return errors.New("copied object must be signed by the node")
+		}
+
+		if p.objSource == nil {
+			return errors.New("object copying is not supported")
+		}
+
+		// copied object is read on behalf of the node
+		copyPrm := *prm.common
+		copyPrm.ForgetTokens()
+
+		p.copyPrm = &copyPrm
+	}
+
+	if srcs := prm.common.ReplicationSources(); srcs != nil {
+		switch {
+		case !prm.common.LocalOnly():
+			return errors.New("replicated object can only be pulled to the local storage")
+		case prm.hdr.Signature() == nil:
+			return errors.New("pulled object must be signed")
+		case prm.common.CopySource() != nil || prm.common.PatchSource() != nil:
+			return errors.New("object can not be pulled and copied at once")
+		case p.objPuller == nil:
+			return errors.New("object pulling is not supported")
+		}
+
+		p.pullSources = srcs
+	}
+
+	patchSrc := prm.common.PatchSource()
+	if patchSrc != nil {
+		if prm.common.CopySource() != nil {
+			return errors.New("object can not be copied and patched at once")
+		}
+
+		if prm.hdr.Signature() != nil {
+			return errors.New("patched object must be signed by the node")
+		}
+
+		if p.objSource == nil {
+			return errors.New("object patching is not supported")
+		}
+	}
+
 	if prm.hdr.Signature() != nil {
 		p.relay = prm.relay
+		p.relayStream = prm.relayStream
 
 		// prepare untrusted-Put object target
 		p.target = &validatingTarget{
@@ -98,12 +219,12 @@ func (p *Streamer) initTarget(prm *PutInitPrm) error {
 		}
 	}
 
-	sessionKey, err := p.keyStorage.GetKey(sessionInfo)
+	sessionSigner, err := p.keyStorage.GetSigner(sessionInfo)
 	if err != nil {
 		return fmt.Errorf("(%T) could not receive session key: %w", p, err)
 	}
 
-	signer := neofsecdsa.SignerRFC6979(*sessionKey)
+	signer := nodesigner.User(sessionSigner, neofscrypto.ECDSA_DETERMINISTIC_SHA256)
 
 	// In case session token is missing, the line above returns the default key.
 	// If it isn't owner key, replication attempts will fail, thus this check.
@@ -113,25 +234,87 @@ func (p *Streamer) initTarget(prm *PutInitPrm) error {
 			return errors.New("missing object owner")
 		}
 
-		ownerSession := user.ResolveFromECDSAPublicKey(signer.PublicKey)
+		ownerSession := signer.UserID()
 
 		if !ownerObj.Equals(ownerSession) {
 			return fmt.Errorf("(%T) session token is missing but object owner id is different from the default key", p)
 		}
 	}
 
-	p.target = &validatingTarget{
-		fmt:              p.fmtValidator,
-		unpreparedObject: true,
-		nextTarget: newSlicingTarget(
+	upload, resumable, err := uploadPrmFromXHeaders(prm.common.XHeaders())
+	if err != nil {
+		return fmt.Errorf("(%T) could not read upload parameters: %w", p, err)
+	}
+
+	idCnr, _ := prm.hdr.ContainerID()
+
+	if copySrc := prm.common.CopySource(); copySrc != nil && !resumable && copySrc.Container() == idCnr {
+		// objects copied inside the container share the children of the source
+		p.patch, err = newObjectCopy(p.ctx, p.objSource, p.copyPrm, homomorphicChecksumRequired)
+		if err != nil {
+			return fmt.Errorf("(%T) could not prepare copy of object %s: %w", p, copySrc, err)
+		}
+
+		p.copyPrm = nil
+	}
+
+	var slicer internal.Target
+	if patchSrc != nil || p.patch != nil {
+		if patchSrc != nil && resumable {
+			return errors.New("patched object can not be uploaded in the resumable session")
+		}
+
+		if patchSrc != nil {
+			// patched object is read on behalf of the node
+			patchPrm := *prm.common
+			patchPrm.ForgetTokens()
+
+			p.patch, err = newObjectPatch(p.ctx, p.objSource, &patchPrm, idCnr, homomorphicChecksumRequired)
+			if err != nil {
+				return fmt.Errorf("(%T) could not prepare patch of object %s: %w", p, patchSrc, err)
+			}
+		}
+
+		slicer = newPatchTarget(
+			newResumableTarget(
+				nil,
+				uploadPrm{},
+				p.maxPayloadSz,
+				!homomorphicChecksumRequired,
+				signer,
+				sToken,
+				p.networkState.CurrentEpoch(),
+				p.newCommonTarget(prm),
+			).(*resumableTarget),
+			p.patch,
+		)
+	} else if resumable {
+		slicer = newResumableTarget(
+			p.uploads,
+			upload,
+			p.maxPayloadSz,
+			!homomorphicChecksumRequired,
+			signer,
+			sToken,
+			p.networkState.CurrentEpoch(),
+			p.newCommonTarget(prm),
+		)
+	} else {
+		slicer = newSlicingTarget(
 			p.ctx,
 			p.maxPayloadSz,
 			!homomorphicChecksumRequired,
-			user.NewAutoIDSigner(*sessionKey),
+			signer,
 			sToken,
 			p.networkState.CurrentEpoch(),
 			p.newCommonTarget(prm),
-		),
+		)
+	}
+
+	p.target = &validatingTarget{
+		fmt:                         p.fmtValidator,
+		unpreparedObject:            true,
+		nextTarget:                  slicer,
 		homomorphicChecksumRequired: homomorphicChecksumRequired,
 	}
 
@@ -184,6 +367,15 @@ func (p *Streamer) preparePrm(prm *PutInitPrm) error {
 
 		// use local-only placement builder
 		builder = util.NewLocalPlacement(builder, p.netmapKeys)
+	} else {
+		// objects are erasure-coded by the node which processes the original
+		// request, other nodes store the received parts as they are
+		prm.ecRule, err = ec.RuleFromContainer(prm.cnr)
+		if err != nil {
+			return fmt.Errorf("(%T) could not read container erasure coding rule: %w", p, err)
+		}
+
+		prm.ecBuilder = builder
 	}
 
 	// set placement builder
@@ -209,12 +401,33 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) internal.Target {
 		}
 	}
 
+	var relayStream func(nodeDesc) (payloadStream, error)
+	if p.relayStream != nil {
+		relayStream = func(node nodeDesc) (payloadStream, error) {
+			var info client.NodeInfo
+
+			client.NodeInfoFromNetmapElement(&info, node.info)
+
+			c, err := p.clientConstructor.Get(info)
+			if err != nil {
+				return nil, fmt.Errorf("could not create SDK client %s: %w", info.AddressGroup(), err)
+			}
+
+			return p.relayStream(info, c)
+		}
+	}
+
 	// enable additional container broadcast on non-local operation
 	// if object has TOMBSTONE or LOCK type.
 	typ := prm.hdr.Type()
 	withBroadcast := !prm.common.LocalOnly() && (typ == object.TypeTombstone || typ == object.TypeLock)
 
-	return &distributedTarget{
+	var ecPlc *ecPlacement
+	if !prm.ecRule.IsZero() {
+		ecPlc = p.newECPlacement(prm)
+	}
+
+	p.distributed = &distributedTarget{
 		traversal: traversal{
 			opts: prm.traverseOpts,
 
@@ -233,27 +446,109 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) internal.Target {
 			rt := &remoteTarget{
 				ctx:               p.ctx,
 				keyStorage:        p.keyStorage,
-				commonPrm:         prm.common,
 				clientConstructor: p.clientConstructor,
 			}
 
+			// copies and new versions carry the references set by the node,
+			// so they are sent on behalf of the node like the replicated
+			// objects
+			if p.patch == nil {
+				rt.commonPrm = prm.common
+			}
+
 			client.NodeInfoFromNetmapElement(&rt.nodeInfo, node.info)
 
 			return rt
 		},
-		relay: relay,
-		fmt:   p.fmtValidator,
-		log:   p.log,
+		relay:       relay,
+		relayStream: relayStream,
+		ecPlacement: ecPlc,
+		fmt:         p.fmtValidator,
+		log:         p.log,
+
+		removalCallback: p.removalCallback,
 
 		isLocalKey: p.netmapKeys.IsLocalKey,
 	}
+
+	return p.distributed
+}
+
+func (p *Streamer) newECPlacement(prm *PutInitPrm) *ecPlacement {
+	var idCnr cid.ID
+	prm.cnr.CalculateID(&idCnr)
+
+	return &ecPlacement{
+		rule: prm.ecRule,
+		signer: func() (neofscrypto.Signer, error) {
+			// parts are signed by the node
+			s, err := p.keyStorage.GetSigner(nil)
+			if err != nil {
+				return nil, err
+			}
+
+			return nodesigner.SDK(s, neofscrypto.ECDSA_DETERMINISTIC_SHA256), nil
+		},
+		nodes: func(id oid.ID) ([]netmapSDK.NodeInfo, error) {
+			vs, err := prm.ecBuilder.BuildPlacement(idCnr, &id, prm.cnr.PlacementPolicy())
+			if err != nil {
+				return nil, err
+			}
+
+			return ec.PartNodes(vs, prm.ecRule)
+		},
+		partTarget: func(info client.NodeInfo, local bool) preparedObjectTarget {
+			if local {
+				return &localTarget{
+					storage: p.localStore,
+				}
+			}
+
+			// parts are sent on behalf of the node like the replicated objects
+			return &remoteTarget{
+				ctx:               p.ctx,
+				keyStorage:        p.keyStorage,
+				nodeInfo:          info,
+				clientConstructor: p.clientConstructor,
+			}
+		},
+	}
+}
+
+// releaseOnAbort releases the stream resources if the request context is done
+// before the stream is closed.
+func (p *Streamer) releaseOnAbort(done <-chan struct{}) {
+	select {
+	case <-done:
+	case <-p.ctx.Done():
+		p.mtx.Lock()
+		p.release()
+		p.mtx.Unlock()
+	}
+}
+
+func (p *Streamer) release() {
+	if p.distributed != nil {
+		p.distributed.release()
+	}
 }
 
 func (p *Streamer) SendChunk(prm *PutChunkPrm) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
 	if p.target == nil {
 		return errNotInit
 	}
 
+	if p.copyPrm != nil || p.patch != nil && p.patch.copy {
+		return errCopyPayload
+	}
+
+	if p.pullSources != nil {
+		return errPullPayload
+	}
+
 	if _, err := p.target.Write(prm.chunk); err != nil {
 		return fmt.Errorf("(%T) could not write payload chunk to target: %w", p, err)
 	}
@@ -262,10 +557,40 @@ func (p *Streamer) SendChunk(prm *PutChunkPrm) error {
 }
 
 func (p *Streamer) Close() (*PutResponse, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
 	if p.target == nil {
 		return nil, errNotInit
 	}
 
+	defer func() {
+		p.release()
+
+		if p.done != nil {
+			close(p.done)
+			p.done = nil
+		}
+	}()
+
+	if p.copyPrm != nil {
+		if err := p.copyObject(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.pullSources != nil {
+		if err := p.pullObject(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.patch != nil {
+		if err := p.patch.writeSuffix(p.ctx, p.target); err != nil {
+			return nil, fmt.Errorf("(%T) could not patch object %s: %w", p, p.patch.addr, err)
+		}
+	}
+
 	id, err := p.target.Close()
 	if err != nil {
 		return nil, fmt.Errorf("(%T) could not close object target: %w", p, err)
@@ -0,0 +1,904 @@
+package putsvc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
+	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/nspcc-dev/tzhash/tz"
+	"go.uber.org/zap"
+)
+
+const (
+	// XHeaderUploadID is an X-header of the PUT request with the
+	// client-generated ID of the resumable upload session. The ID is unique
+	// among the uploads of the object owner within the session, uploads are
+	// resumed under the session token they are started with.
+	XHeaderUploadID = "__NEOFS__UPLOAD_ID"
+
+	// XHeaderUploadOffset is an X-header of the PUT request with the payload
+	// offset the resumed upload continues from. The offset must be equal to
+	// the size of the payload already stored by the upload session.
+	XHeaderUploadOffset = "__NEOFS__UPLOAD_OFFSET"
+
+	// DefaultUploadSessionLifetime is a default time the upload session is
+	// kept after the last stored part.
+	DefaultUploadSessionLifetime = time.Hour
+
+	// DefaultTombstoneLifetime is a default lifetime in epochs of the
+	// tombstones removing the children of the expired upload sessions.
+	DefaultTombstoneLifetime = 5
+
+	// orphansTimeout limits the time of the orphaned children removal.
+	orphansTimeout = time.Minute
+)
+
+var errUnknownUpload = errors.New("unknown or expired upload session")
+
+var errUploadTakenOver = errors.New("upload session is taken over by another stream")
+
+// UploadOffsetDetailID is an ID of the upload offset status detail carrying
+// the size of the payload stored by the upload session. The detail value is
+// a big-endian uint64.
+const UploadOffsetDetailID = 0
+
+// UploadOffsetError is returned when the upload stream does not continue from
+// the payload offset stored by the upload session. Clients use it to get the
+// progress of the interrupted upload.
+//
+// It is transmitted to the clients as the util.StatusUploadOffset status with
+// the UploadOffsetDetailID detail.
+type UploadOffsetError struct {
+	// Stored is the size of the payload already stored by the session.
+	Stored uint64
+}
+
+func (e UploadOffsetError) Error() string {
+	return fmt.Sprintf("upload must continue from %d payload offset", e.Stored)
+}
+
+// ErrorToV2 implements apistatus.StatusV2 interface.
+func (e UploadOffsetError) ErrorToV2() *status.Status {
+	st := svcutil.NewStatus(svcutil.StatusUploadOffset, e.Error())
+
+	var d status.Detail
+	d.SetID(UploadOffsetDetailID)
+	d.SetValue(binary.BigEndian.AppendUint64(nil, e.Stored))
+
+	st.AppendDetails(d)
+
+	return st
+}
+
+// uploadPrm groups parameters of the resumable upload read from the request
+// X-headers.
+type uploadPrm struct {
+	id string
+
+	offset uint64
+}
+
+// uploadPrmFromXHeaders reads upload parameters from the request X-headers.
+// Returns false if the upload ID is not set.
+func uploadPrmFromXHeaders(xhdrs []string) (uploadPrm, bool, error) {
+	var (
+		prm uploadPrm
+		err error
+	)
+
+	for i := 0; i+1 < len(xhdrs); i += 2 {
+		switch xhdrs[i] {
+		case XHeaderUploadID:
+			prm.id = xhdrs[i+1]
+		case XHeaderUploadOffset:
+			prm.offset, err = strconv.ParseUint(xhdrs[i+1], 10, 64)
+			if err != nil {
+				return prm, false, fmt.Errorf("invalid %s X-header: %w", XHeaderUploadOffset, err)
+			}
+		}
+	}
+
+	return prm, prm.id != "", nil
+}
+
+// uploadSession is a state of the resumable upload: it keeps the information
+// about stored child objects required to continue slicing after the upload
+// stream is interrupted.
+type uploadSession struct {
+	mtx sync.Mutex
+
+	// generation of the stream owning the session, streams opened before
+	// can not modify the session
+	gen uint64
+
+	expires time.Time
+
+	cnr cid.ID
+
+	hdr object.Object
+
+	splitID *object.SplitID
+
+	children []oid.ID
+
+	homoHashes [][]byte
+
+	// marshaled state of SHA-256 hash of the stored payload
+	checksum []byte
+
+	offset uint64
+}
+
+// UploadSessionStore is a persistent storage of the resumable upload
+// sessions, so they survive the node restarts.
+type UploadSessionStore interface {
+	// Put saves the encoded session by the key.
+	Put(key string, data []byte) error
+	// Delete removes the session by the key.
+	Delete(key string) error
+	// Iterate passes all saved sessions to f.
+	Iterate(f func(key string, data []byte) error) error
+}
+
+// uploadSessionRecord is a stored state of the upload session.
+type uploadSessionRecord struct {
+	Gen        uint64    `json:"gen"`
+	Expires    time.Time `json:"expires"`
+	Container  string    `json:"container"`
+	Header     []byte    `json:"header"`
+	SplitID    []byte    `json:"split_id,omitempty"`
+	Children   []string  `json:"children,omitempty"`
+	HomoHashes [][]byte  `json:"homo_hashes,omitempty"`
+	Checksum   []byte    `json:"checksum,omitempty"`
+	Offset     uint64    `json:"offset"`
+}
+
+func (us *uploadSession) marshal() ([]byte, error) {
+	// root header has no ID yet, so it is encoded as the raw message
+	hdr := us.hdr.ToV2().StableMarshal(nil)
+
+	rec := uploadSessionRecord{
+		Gen:        us.gen,
+		Expires:    us.expires,
+		Container:  us.cnr.EncodeToString(),
+		Header:     hdr,
+		Children:   make([]string, len(us.children)),
+		HomoHashes: us.homoHashes,
+		Checksum:   us.checksum,
+		Offset:     us.offset,
+	}
+
+	if us.splitID != nil {
+		rec.SplitID = us.splitID.ToV2()
+	}
+
+	for i := range us.children {
+		rec.Children[i] = us.children[i].EncodeToString()
+	}
+
+	return json.Marshal(rec)
+}
+
+func (us *uploadSession) unmarshal(data []byte) error {
+	var rec uploadSessionRecord
+
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return err
+	}
+
+	if err := us.cnr.DecodeString(rec.Container); err != nil {
+		return fmt.Errorf("invalid container: %w", err)
+	}
+
+	var hdr objectV2.Object
+	if err := hdr.Unmarshal(rec.Header); err != nil {
+		return fmt.Errorf("invalid header: %w", err)
+	}
+
+	us.hdr = *object.NewFromV2(&hdr)
+
+	us.children = make([]oid.ID, len(rec.Children))
+	for i := range rec.Children {
+		if err := us.children[i].DecodeString(rec.Children[i]); err != nil {
+			return fmt.Errorf("invalid child #%d: %w", i, err)
+		}
+	}
+
+	if rec.SplitID != nil {
+		us.splitID = object.NewSplitIDFromV2(rec.SplitID)
+		if us.splitID == nil {
+			return errors.New("invalid split ID")
+		}
+	}
+
+	us.gen = rec.Gen
+	us.expires = rec.Expires
+	us.homoHashes = rec.HomoHashes
+	us.checksum = rec.Checksum
+	us.offset = rec.Offset
+
+	return nil
+}
+
+// uploadOrphans are the child objects stored by the expired upload session.
+type uploadOrphans struct {
+	cnr cid.ID
+
+	// header of the root object with its owner and session token
+	hdr object.Object
+
+	splitID *object.SplitID
+
+	children []oid.ID
+}
+
+// uploadSessions is a registry of the resumable upload sessions. Sessions
+// expire if no parts are stored during the configured lifetime, the child
+// objects they have stored are passed to the orphans handler.
+type uploadSessions struct {
+	log *zap.Logger
+
+	lifetime time.Duration
+
+	// nil if sessions are kept in memory only
+	store UploadSessionStore
+
+	// nil if the orphaned children are kept
+	orphans func(uploadOrphans)
+
+	mtx sync.Mutex
+
+	m map[string]*uploadSession
+}
+
+func newUploadSessions(lifetime time.Duration, store UploadSessionStore, orphans func(uploadOrphans), log *zap.Logger) *uploadSessions {
+	s := &uploadSessions{
+		log:      log,
+		lifetime: lifetime,
+		store:    store,
+		orphans:  orphans,
+		m:        make(map[string]*uploadSession),
+	}
+
+	if store != nil {
+		err := store.Iterate(func(key string, data []byte) error {
+			us := new(uploadSession)
+
+			if err := us.unmarshal(data); err != nil {
+				log.Warn("skip invalid stored upload session",
+					zap.String("key", key),
+					zap.String("error", err.Error()),
+				)
+
+				return nil
+			}
+
+			s.m[key] = us
+
+			return nil
+		})
+		if err != nil {
+			log.Error("could not load stored upload sessions",
+				zap.String("error", err.Error()),
+			)
+		}
+	}
+
+	return s
+}
+
+// uploadKey returns the key of the upload session. Uploads are bound to the
+// session tokens, so the streams of the other sessions of the same owner can
+// not take them over and the root header is signed with the key of the
+// session it references.
+func uploadKey(owner user.ID, tok *session.Object, id string) string {
+	var sessionID string
+	if tok != nil {
+		sessionID = tok.ID().String()
+	}
+
+	return owner.EncodeToString() + "/" + sessionID + "/" + id
+}
+
+// open opens the new upload session or takes over the existing one. Streams
+// which opened the session before lose the access to it.
+func (s *uploadSessions) open(key string, offset uint64) (*uploadSession, uint64, error) {
+	now := time.Now()
+
+	s.mtx.Lock()
+
+	expired := s.collectGarbage(now)
+
+	us, ok := s.m[key]
+	if !ok && offset == 0 {
+		us = &uploadSession{expires: now.Add(s.lifetime)}
+		s.m[key] = us
+	}
+
+	s.mtx.Unlock()
+
+	if len(expired) > 0 {
+		go s.expire(expired)
+	}
+
+	if us == nil {
+		return nil, 0, errUnknownUpload
+	}
+
+	// stream writing the part now finishes first
+	us.mtx.Lock()
+	defer us.mtx.Unlock()
+
+	if offset != us.offset {
+		return nil, 0, UploadOffsetError{Stored: us.offset}
+	}
+
+	us.gen++
+	us.expires = now.Add(s.lifetime)
+
+	return us, us.gen, nil
+}
+
+// save saves the state of the session in the persistent store if any. Must
+// be called under the session lock.
+func (s *uploadSessions) save(key string, us *uploadSession) {
+	if s == nil || s.store == nil {
+		return
+	}
+
+	data, err := us.marshal()
+	if err == nil {
+		err = s.store.Put(key, data)
+	}
+
+	if err != nil {
+		s.log.Warn("could not save upload session, it will not survive the restart",
+			zap.String("key", key),
+			zap.String("error", err.Error()),
+		)
+	}
+}
+
+// close removes the finished upload session.
+func (s *uploadSessions) close(key string, us *uploadSession) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.m[key] == us {
+		delete(s.m, key)
+		s.remove(key)
+	}
+}
+
+func (s *uploadSessions) remove(key string) {
+	if s.store == nil {
+		return
+	}
+
+	if err := s.store.Delete(key); err != nil {
+		s.log.Warn("could not remove stored upload session",
+			zap.String("key", key),
+			zap.String("error", err.Error()),
+		)
+	}
+}
+
+// expiredUpload is the upload session removed from the registry after
+// expiration.
+type expiredUpload struct {
+	key string
+
+	orphans uploadOrphans
+}
+
+// collectGarbage removes expired sessions from the registry and returns them.
+// Must be called under the lock.
+func (s *uploadSessions) collectGarbage(now time.Time) []expiredUpload {
+	var res []expiredUpload
+
+	for key, us := range s.m {
+		// session is locked while its part is being stored, so it is in use
+		if !us.mtx.TryLock() {
+			continue
+		}
+
+		expired := now.After(us.expires)
+
+		var orphans uploadOrphans
+		if expired {
+			orphans = uploadOrphans{
+				cnr:      us.cnr,
+				splitID:  us.splitID,
+				children: us.children,
+			}
+
+			us.hdr.CopyTo(&orphans.hdr)
+		}
+
+		us.mtx.Unlock()
+
+		if !expired {
+			continue
+		}
+
+		delete(s.m, key)
+
+		res = append(res, expiredUpload{key: key, orphans: orphans})
+	}
+
+	return res
+}
+
+// expire removes the expired sessions from the persistent store and passes
+// the children stored by them to the orphans handler.
+func (s *uploadSessions) expire(expired []expiredUpload) {
+	for i := range expired {
+		s.remove(expired[i].key)
+
+		s.log.Info("upload session expired",
+			zap.String("key", expired[i].key),
+			zap.Int("abandoned children", len(expired[i].orphans.children)),
+		)
+
+		if s.orphans != nil && len(expired[i].orphans.children) > 0 {
+			s.orphans(expired[i].orphans)
+		}
+	}
+}
+
+// collectExpired removes all expired sessions.
+func (s *uploadSessions) collectExpired() {
+	s.mtx.Lock()
+	expired := s.collectGarbage(time.Now())
+	s.mtx.Unlock()
+
+	s.expire(expired)
+}
+
+// resumableTarget slices the payload of the root object like slicingTarget
+// does, but keeps the state of the upload in the session after each stored
+// child object. If the stream is interrupted, the next stream of the session
+// continues from the last stored child.
+type resumableTarget struct {
+	uploads *uploadSessions
+
+	prm uploadPrm
+
+	key string
+
+	session *uploadSession
+
+	gen uint64
+
+	signer           user.Signer
+	sessionToken     *session.Object
+	currentEpoch     uint64
+	maxObjSize       uint64
+	homoHashDisabled bool
+
+	nextTarget internal.Target
+
+	payload []byte
+}
+
+func newResumableTarget(
+	uploads *uploadSessions,
+	prm uploadPrm,
+	maxObjSize uint64,
+	homoHashDisabled bool,
+	signer user.Signer,
+	sessionToken *session.Object,
+	curEpoch uint64,
+	nextTarget internal.Target,
+) internal.Target {
+	return &resumableTarget{
+		uploads:          uploads,
+		prm:              prm,
+		signer:           signer,
+		sessionToken:     sessionToken,
+		currentEpoch:     curEpoch,
+		maxObjSize:       maxObjSize,
+		homoHashDisabled: homoHashDisabled,
+		nextTarget:       nextTarget,
+	}
+}
+
+func (t *resumableTarget) WriteHeader(hdr *object.Object) error {
+	cnr, ok := hdr.ContainerID()
+	if !ok {
+		return errors.New("missing container ID")
+	}
+
+	owner, err := t.owner(hdr)
+	if err != nil {
+		return err
+	}
+
+	t.key = uploadKey(owner, t.sessionToken, t.prm.id)
+
+	us, gen, err := t.uploads.open(t.key, t.prm.offset)
+	if err != nil {
+		return fmt.Errorf("open upload session: %w", err)
+	}
+
+	us.mtx.Lock()
+	defer us.mtx.Unlock()
+
+	if len(us.children) == 0 {
+		// nothing is stored yet, the upload is (re)started
+		us.cnr = cnr
+		t.initHeader(us, hdr, owner)
+		us.splitID = nil
+		us.checksum = nil
+	} else if cnr != us.cnr {
+		return errors.New("container of the resumed upload differs")
+	}
+
+	t.uploads.save(t.key, us)
+
+	t.session = us
+	t.gen = gen
+
+	return nil
+}
+
+func (t *resumableTarget) owner(hdr *object.Object) (user.ID, error) {
+	if t.sessionToken != nil {
+		// session issuer is a container owner
+		return t.sessionToken.Issuer(), nil
+	} else if o := hdr.OwnerID(); o != nil {
+		return *o, nil
+	}
+
+	return user.ID{}, errors.New("missing object owner")
+}
+
+// initHeader sets the header of the root object formed by the session.
+func (t *resumableTarget) initHeader(us *uploadSession, hdr *object.Object, owner user.ID) {
+	ver := version.Current()
+
+	hdr.CopyTo(&us.hdr)
+	us.hdr.SetOwnerID(&owner)
+	us.hdr.SetSessionToken(t.sessionToken)
+	us.hdr.SetCreationEpoch(t.currentEpoch)
+	us.hdr.SetVersion(&ver)
+}
+
+func (t *resumableTarget) Write(p []byte) (int, error) {
+	t.payload = append(t.payload, p...)
+
+	// the child is written only when the next one will follow, so the last
+	// child carrying the parent header is never empty
+	for uint64(len(t.payload)) > t.maxObjSize {
+		if _, err := t.commit(t.payload[:t.maxObjSize], false); err != nil {
+			return 0, err
+		}
+
+		t.payload = append(t.payload[:0], t.payload[t.maxObjSize:]...)
+	}
+
+	return len(p), nil
+}
+
+func (t *resumableTarget) Close() (oid.ID, error) {
+	id, err := t.commit(t.payload, true)
+	if err != nil {
+		return oid.ID{}, err
+	}
+
+	t.uploads.close(t.key, t.session)
+
+	return id, nil
+}
+
+// commit forms the next child object from the given payload, writes it and
+// saves the result in the upload session. The last child is followed by the
+// linking object, the ID of the root object is returned for it.
+func (t *resumableTarget) commit(payload []byte, last bool) (oid.ID, error) {
+	us := t.session
+
+	us.mtx.Lock()
+	defer us.mtx.Unlock()
+
+	if us.gen != t.gen {
+		return oid.ID{}, errUploadTakenOver
+	}
+
+	rootHash := sha256.New()
+	if us.checksum != nil {
+		if err := rootHash.(encoding.BinaryUnmarshaler).UnmarshalBinary(us.checksum); err != nil {
+			return oid.ID{}, fmt.Errorf("restore payload checksum state: %w", err)
+		}
+	}
+
+	rootHash.Write(payload)
+
+	var homoHash []byte
+	if !t.homoHashDisabled {
+		h := tz.Sum(payload)
+		homoHash = h[:]
+	}
+
+	if last {
+		if sz := us.hdr.PayloadSize(); sz != 0 && sz != math.MaxUint64 && sz != us.offset+uint64(len(payload)) {
+			return oid.ID{}, ErrWrongPayloadSize
+		}
+
+		if len(us.children) == 0 {
+			// the payload fits into a single object
+			var obj object.Object
+
+			us.hdr.CopyTo(&obj)
+			setPayloadMeta(&obj, payload, rootHash.Sum(nil), homoHash)
+
+			return t.writeObject(&obj, payload)
+		}
+	}
+
+	split := us.splitID
+	if split == nil {
+		split = object.NewSplitID()
+	}
+
+	child := t.stubObject(us)
+	child.SetSplitID(split)
+
+	if len(us.children) > 0 {
+		child.SetPreviousID(us.children[len(us.children)-1])
+	}
+
+	setPayloadMeta(child, payload, sha256Sum(payload), homoHash)
+
+	var rootID oid.ID
+
+	if last {
+		var parent object.Object
+
+		us.hdr.CopyTo(&parent)
+
+		parHomo := homoHash
+		if !t.homoHashDisabled {
+			var err error
+
+			parHomo, err = tz.Concat(append(us.homoHashes, homoHash))
+			if err != nil {
+				return oid.ID{}, fmt.Errorf("calculate homomorphic payload checksum: %w", err)
+			}
+		}
+
+		parent.SetPayloadSize(us.offset + uint64(len(payload)))
+		setChecksums(&parent, rootHash.Sum(nil), parHomo)
+
+		if err := parent.SetIDWithSignature(t.signer); err != nil {
+			return oid.ID{}, fmt.Errorf("form root object: %w", err)
+		}
+
+		rootID, _ = parent.ID()
+
+		child.SetParentID(rootID)
+		child.SetParent(&parent)
+	}
+
+	id, err := t.writeObject(child, payload)
+	if err != nil {
+		return oid.ID{}, err
+	}
+
+	if last {
+		link := t.stubObject(us)
+		link.SetSplitID(split)
+		link.SetParentID(rootID)
+		link.SetParent(child.Parent())
+		link.SetChildren(append(us.children, id)...)
+
+		var emptyHomo []byte
+		if !t.homoHashDisabled {
+			h := tz.Sum(nil)
+			emptyHomo = h[:]
+		}
+
+		setPayloadMeta(link, nil, sha256Sum(nil), emptyHomo)
+
+		if _, err := t.writeObject(link, nil); err != nil {
+			return oid.ID{}, fmt.Errorf("write linking object: %w", err)
+		}
+
+		return rootID, nil
+	}
+
+	state, err := rootHash.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("save payload checksum state: %w", err)
+	}
+
+	us.splitID = split
+	us.children = append(us.children, id)
+	us.homoHashes = append(us.homoHashes, homoHash)
+	us.checksum = state
+	us.offset += uint64(len(payload))
+
+	if t.uploads != nil {
+		us.expires = time.Now().Add(t.uploads.lifetime)
+	}
+
+	t.uploads.save(t.key, us)
+
+	return id, nil
+}
+
+// stubObject returns header of the child object without payload related
+// fields.
+func (t *resumableTarget) stubObject(us *uploadSession) *object.Object {
+	ver := version.Current()
+	owner := us.hdr.OwnerID()
+
+	obj := object.New()
+	obj.SetVersion(&ver)
+	obj.SetContainerID(us.cnr)
+	obj.SetCreationEpoch(t.currentEpoch)
+	obj.SetType(object.TypeRegular)
+	obj.SetOwnerID(owner)
+	obj.SetSessionToken(t.sessionToken)
+
+	return obj
+}
+
+func setPayloadMeta(obj *object.Object, payload, cs, homoCS []byte) {
+	obj.SetPayloadSize(uint64(len(payload)))
+	setChecksums(obj, cs, homoCS)
+}
+
+func (t *resumableTarget) writeObject(obj *object.Object, payload []byte) (oid.ID, error) {
+	if _, ok := obj.ID(); !ok {
+		if err := obj.SetIDWithSignature(t.signer); err != nil {
+			return oid.ID{}, fmt.Errorf("form object: %w", err)
+		}
+	}
+
+	if err := t.nextTarget.WriteHeader(obj); err != nil {
+		return oid.ID{}, err
+	}
+
+	if _, err := t.nextTarget.Write(payload); err != nil {
+		return oid.ID{}, err
+	}
+
+	return t.nextTarget.Close()
+}
+
+func setChecksums(obj *object.Object, cs, homoCS []byte) {
+	var sum checksum.Checksum
+
+	var csBytes [sha256.Size]byte
+	copy(csBytes[:], cs)
+
+	sum.SetSHA256(csBytes)
+	obj.SetPayloadChecksum(sum)
+
+	if homoCS != nil {
+		var homoBytes [tz.Size]byte
+		copy(homoBytes[:], homoCS)
+
+		sum.SetTillichZemor(homoBytes)
+		obj.SetPayloadHomomorphicHash(sum)
+	}
+}
+
+func sha256Sum(p []byte) []byte {
+	h := sha256.Sum256(p)
+	return h[:]
+}
+
+// CollectExpiredUploads removes the expired resumable upload sessions and
+// the child objects stored by them. Sessions are also collected when the new
+// ones are opened, this method allows to do it regularly, e.g. on the new
+// epoch.
+func (p *Service) CollectExpiredUploads() {
+	p.uploads.collectExpired()
+}
+
+// tombstoneOrphans saves the tombstone of the child objects stored by the
+// expired upload session on behalf of the session signer, so the children
+// which will never be linked do not occupy the storage.
+func (p *Service) tombstoneOrphans(o uploadOrphans) {
+	ctx, cancel := context.WithTimeout(context.Background(), orphansTimeout)
+	defer cancel()
+
+	if err := p.putTombstone(ctx, o); err != nil {
+		p.log.Warn("could not remove children of the expired upload",
+			zap.Stringer("container", o.cnr),
+			zap.Int("children", len(o.children)),
+			zap.String("error", err.Error()),
+		)
+	}
+}
+
+func (p *Service) putTombstone(ctx context.Context, o uploadOrphans) error {
+	var sessionInfo *svcutil.SessionInfo
+
+	tok := o.hdr.SessionToken()
+	if tok != nil {
+		sessionInfo = &svcutil.SessionInfo{
+			ID:    tok.ID(),
+			Owner: tok.Issuer(),
+		}
+	}
+
+	sessionSigner, err := p.keyStorage.GetSigner(sessionInfo)
+	if err != nil {
+		return fmt.Errorf("could not receive session key: %w", err)
+	}
+
+	tomb := object.NewTombstone()
+	tomb.SetExpirationEpoch(p.networkState.CurrentEpoch() + p.tombstoneLifetime)
+	tomb.SetSplitID(o.splitID)
+	tomb.SetMembers(o.children)
+
+	payload, err := tomb.Marshal()
+	if err != nil {
+		return fmt.Errorf("could not marshal tombstone: %w", err)
+	}
+
+	var exp object.Attribute
+	exp.SetKey(object.AttributeExpirationEpoch)
+	exp.SetValue(strconv.FormatUint(tomb.ExpirationEpoch(), 10))
+
+	ver := version.Current()
+
+	obj := object.New()
+	obj.SetVersion(&ver)
+	obj.SetContainerID(o.cnr)
+	obj.SetType(object.TypeTombstone)
+	obj.SetOwnerID(o.hdr.OwnerID())
+	obj.SetSessionToken(tok)
+	obj.SetCreationEpoch(p.networkState.CurrentEpoch())
+	obj.SetAttributes(exp)
+
+	homoHash := tz.Sum(payload)
+	setPayloadMeta(obj, payload, sha256Sum(payload), homoHash[:])
+
+	if err = obj.SetIDWithSignature(signer.User(sessionSigner, neofscrypto.ECDSA_DETERMINISTIC_SHA256)); err != nil {
+		return fmt.Errorf("could not sign tombstone: %w", err)
+	}
+
+	streamer, err := p.Put(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = streamer.Init(new(PutInitPrm).
+		WithCommonPrm(new(svcutil.CommonPrm)).
+		WithObject(obj.CutPayload()))
+	if err != nil {
+		return err
+	}
+
+	if err = streamer.SendChunk(new(PutChunkPrm).WithChunk(payload)); err != nil {
+		return err
+	}
+
+	_, err = streamer.Close()
+
+	return err
+}
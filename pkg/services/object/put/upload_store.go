@@ -0,0 +1,66 @@
+package putsvc
+
+import (
+	"fmt"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+// BoltUploadSessionStore is an UploadSessionStore kept in the bolt DB.
+type BoltUploadSessionStore struct {
+	db *bbolt.DB
+}
+
+var uploadsBucket = []byte("uploads")
+
+// NewBoltUploadSessionStore opens the bolt DB at the given path and returns
+// the UploadSessionStore kept in it. The DB must be closed by Close.
+func NewBoltUploadSessionStore(path string, timeout time.Duration) (*BoltUploadSessionStore, error) {
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{
+		Timeout: timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can't open bbolt at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(uploadsBucket)
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("could not init uploads bucket: %w", err)
+	}
+
+	return &BoltUploadSessionStore{db: db}, nil
+}
+
+// Put implements UploadSessionStore.
+func (s *BoltUploadSessionStore) Put(key string, data []byte) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(uploadsBucket).Put([]byte(key), data)
+	})
+}
+
+// Delete implements UploadSessionStore.
+func (s *BoltUploadSessionStore) Delete(key string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(uploadsBucket).Delete([]byte(key))
+	})
+}
+
+// Iterate implements UploadSessionStore.
+func (s *BoltUploadSessionStore) Iterate(f func(key string, data []byte) error) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(uploadsBucket).ForEach(func(k, v []byte) error {
+			return f(string(k), v)
+		})
+	})
+}
+
+// Close closes the underlying bolt DB.
+func (s *BoltUploadSessionStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,345 @@
+package putsvc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// collectingTarget saves the written objects.
+type collectingTarget struct {
+	failAt int
+
+	objs []*objectSDK.Object
+
+	cur *objectSDK.Object
+}
+
+func (t *collectingTarget) WriteHeader(hdr *objectSDK.Object) error {
+	t.cur = objectSDK.New()
+	hdr.CopyTo(t.cur)
+
+	return nil
+}
+
+func (t *collectingTarget) Write(p []byte) (int, error) {
+	t.cur.SetPayload(append(t.cur.Payload(), p...))
+	return len(p), nil
+}
+
+func (t *collectingTarget) Close() (oid.ID, error) {
+	if t.failAt > 0 && len(t.objs)+1 == t.failAt {
+		return oid.ID{}, errors.New("storage failure")
+	}
+
+	t.objs = append(t.objs, t.cur)
+
+	id, _ := t.cur.ID()
+
+	return id, nil
+}
+
+func TestResumableTarget(t *testing.T) {
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	signer := user.NewAutoIDSigner(pk.PrivateKey)
+	owner := signer.UserID()
+	cnr := cidtest.ID()
+
+	hdr := objectSDK.New()
+	hdr.SetContainerID(cnr)
+	hdr.SetOwnerID(&owner)
+
+	payload := []byte("0123456789ab")
+
+	const maxObjSize = 4
+
+	newTarget := func(uploads *uploadSessions, offset uint64, next *collectingTarget) *resumableTarget {
+		return newResumableTarget(uploads, uploadPrm{id: "upload", offset: offset}, maxObjSize,
+			false, signer, nil, 10, next).(*resumableTarget)
+	}
+
+	t.Run("resume", func(t *testing.T) {
+		uploads := newUploadSessions(time.Hour, nil, nil, zap.NewNop())
+		next := new(collectingTarget)
+
+		// interrupted stream
+		tgt := newTarget(uploads, 0, next)
+		require.NoError(t, tgt.WriteHeader(hdr))
+
+		_, err := tgt.Write(payload[:10])
+		require.NoError(t, err)
+		require.Len(t, next.objs, 2)
+
+		var offsetErr UploadOffsetError
+
+		tgt = newTarget(uploads, 0, next)
+		require.ErrorAs(t, tgt.WriteHeader(hdr), &offsetErr)
+		require.EqualValues(t, 8, offsetErr.Stored)
+
+		tgt = newTarget(uploads, 8, next)
+		require.NoError(t, tgt.WriteHeader(hdr))
+
+		_, err = tgt.Write(payload[8:])
+		require.NoError(t, err)
+
+		rootID, err := tgt.Close()
+		require.NoError(t, err)
+
+		require.Len(t, next.objs, 4)
+
+		var collected []byte
+
+		for i, child := range next.objs[:3] {
+			require.NoError(t, child.CheckVerificationFields())
+
+			if i > 0 {
+				prev, ok := child.PreviousID()
+				require.True(t, ok)
+
+				id, _ := next.objs[i-1].ID()
+				require.Equal(t, id, prev)
+			}
+
+			collected = append(collected, child.Payload()...)
+		}
+
+		require.Equal(t, payload, collected)
+
+		parent := next.objs[2].Parent()
+		require.NotNil(t, parent)
+		require.NoError(t, parent.CheckHeaderVerificationFields())
+		require.EqualValues(t, len(payload), parent.PayloadSize())
+
+		id, _ := parent.ID()
+		require.Equal(t, rootID, id)
+
+		cs, _ := parent.PayloadHomomorphicHash()
+		homo := tz.Sum(payload)
+		require.Equal(t, homo[:], cs.Value())
+
+		link := next.objs[3]
+		require.Len(t, link.Children(), 3)
+		require.True(t, bytes.Equal(link.SplitID().ToV2(), next.objs[0].SplitID().ToV2()))
+
+		// finished session is removed
+		require.ErrorIs(t, newTarget(uploads, 8, next).WriteHeader(hdr), errUnknownUpload)
+	})
+
+	t.Run("taken over", func(t *testing.T) {
+		uploads := newUploadSessions(time.Hour, nil, nil, zap.NewNop())
+		next := new(collectingTarget)
+
+		old := newTarget(uploads, 0, next)
+		require.NoError(t, old.WriteHeader(hdr))
+
+		tgt := newTarget(uploads, 0, next)
+		require.NoError(t, tgt.WriteHeader(hdr))
+
+		_, err := old.Write(payload)
+		require.ErrorIs(t, err, errUploadTakenOver)
+
+		_, err = tgt.Write(payload)
+		require.NoError(t, err)
+
+		_, err = tgt.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("other session", func(t *testing.T) {
+		uploads := newUploadSessions(time.Hour, nil, nil, zap.NewNop())
+		next := new(collectingTarget)
+
+		newSessionTarget := func(offset uint64) *resumableTarget {
+			var tok session.Object
+			tok.SetID(uuid.New())
+			tok.BindContainer(cnr)
+			tok.ForVerb(session.VerbObjectPut)
+			require.NoError(t, tok.Sign(signer))
+
+			return newResumableTarget(uploads, uploadPrm{id: "upload", offset: offset}, maxObjSize,
+				false, signer, &tok, 10, next).(*resumableTarget)
+		}
+
+		tgt := newSessionTarget(0)
+		require.NoError(t, tgt.WriteHeader(hdr))
+
+		_, err := tgt.Write(payload[:10])
+		require.NoError(t, err)
+
+		// upload is not resumed under the other session of the same owner
+		require.ErrorIs(t, newSessionTarget(8).WriteHeader(hdr), errUnknownUpload)
+
+		other := newSessionTarget(0)
+		require.NoError(t, other.WriteHeader(hdr))
+
+		_, err = tgt.Write(payload[10:])
+		require.NoError(t, err)
+
+		_, err = tgt.Close()
+		require.NoError(t, err)
+	})
+
+	t.Run("failed part", func(t *testing.T) {
+		uploads := newUploadSessions(time.Hour, nil, nil, zap.NewNop())
+		next := &collectingTarget{failAt: 2}
+
+		tgt := newTarget(uploads, 0, next)
+		require.NoError(t, tgt.WriteHeader(hdr))
+
+		_, err := tgt.Write(payload)
+		require.Error(t, err)
+
+		var offsetErr UploadOffsetError
+
+		require.ErrorAs(t, newTarget(uploads, 0, next).WriteHeader(hdr), &offsetErr)
+		require.EqualValues(t, 4, offsetErr.Stored)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		var orphans []uploadOrphans
+
+		store := make(testUploadStore)
+		uploads := newUploadSessions(time.Millisecond, store, func(o uploadOrphans) {
+			orphans = append(orphans, o)
+		}, zap.NewNop())
+		next := new(collectingTarget)
+
+		tgt := newTarget(uploads, 0, next)
+		require.NoError(t, tgt.WriteHeader(hdr))
+
+		_, err := tgt.Write(payload[:8])
+		require.NoError(t, err)
+
+		time.Sleep(10 * time.Millisecond)
+
+		uploads.collectExpired()
+
+		require.Empty(t, store)
+		require.Len(t, orphans, 1)
+		require.Equal(t, cnr, orphans[0].cnr)
+		require.Equal(t, owner, *orphans[0].hdr.OwnerID())
+		require.Len(t, orphans[0].children, 1)
+
+		id, _ := next.objs[0].ID()
+		require.Equal(t, id, orphans[0].children[0])
+
+		require.ErrorIs(t, newTarget(uploads, 4, next).WriteHeader(hdr), errUnknownUpload)
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		store := make(testUploadStore)
+		next := new(collectingTarget)
+
+		tgt := newTarget(newUploadSessions(time.Hour, store, nil, zap.NewNop()), 0, next)
+		require.NoError(t, tgt.WriteHeader(hdr))
+
+		_, err := tgt.Write(payload[:10])
+		require.NoError(t, err)
+		require.Len(t, store, 1)
+
+		// sessions are restored from the store
+		uploads := newUploadSessions(time.Hour, store, nil, zap.NewNop())
+
+		tgt = newTarget(uploads, 8, next)
+		require.NoError(t, tgt.WriteHeader(hdr))
+
+		_, err = tgt.Write(payload[8:])
+		require.NoError(t, err)
+
+		rootID, err := tgt.Close()
+		require.NoError(t, err)
+		require.Empty(t, store)
+
+		require.Len(t, next.objs, 4)
+
+		parent := next.objs[2].Parent()
+		require.NoError(t, parent.CheckHeaderVerificationFields())
+
+		id, _ := parent.ID()
+		require.Equal(t, rootID, id)
+
+		cs, _ := parent.PayloadChecksum()
+		expected := sha256.Sum256(payload)
+		require.Equal(t, expected[:], cs.Value())
+		require.Len(t, next.objs[3].Children(), 3)
+	})
+
+	t.Run("single object", func(t *testing.T) {
+		uploads := newUploadSessions(time.Hour, nil, nil, zap.NewNop())
+		next := new(collectingTarget)
+
+		tgt := newTarget(uploads, 0, next)
+		require.NoError(t, tgt.WriteHeader(hdr))
+
+		_, err := tgt.Write(payload[:maxObjSize])
+		require.NoError(t, err)
+
+		id, err := tgt.Close()
+		require.NoError(t, err)
+
+		require.Len(t, next.objs, 1)
+		require.NoError(t, next.objs[0].CheckVerificationFields())
+
+		objID, _ := next.objs[0].ID()
+		require.Equal(t, objID, id)
+	})
+}
+
+type testUploadStore map[string][]byte
+
+func (s testUploadStore) Put(key string, data []byte) error {
+	s[key] = data
+	return nil
+}
+
+func (s testUploadStore) Delete(key string) error {
+	delete(s, key)
+	return nil
+}
+
+func (s testUploadStore) Iterate(f func(key string, data []byte) error) error {
+	for k, v := range s {
+		if err := f(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestUploadOffsetError(t *testing.T) {
+	st := apistatus.ErrorToV2(fmt.Errorf("wrapped: %w", UploadOffsetError{Stored: 42}))
+
+	code := st.Code()
+	require.True(t, code.EqualNumber(2048+uint32(svcutil.StatusUploadOffset)))
+
+	var stored []byte
+
+	st.IterateDetails(func(d *status.Detail) bool {
+		if d.ID() == UploadOffsetDetailID {
+			stored = d.Value()
+		}
+		return false
+	})
+
+	require.EqualValues(t, 42, binary.BigEndian.Uint64(stored))
+}
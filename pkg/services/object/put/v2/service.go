@@ -2,11 +2,11 @@ package putsvc
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/services/object"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 )
 
 // Service implements Put operation of Object service v2.
@@ -18,8 +18,8 @@ type Service struct {
 type Option func(*cfg)
 
 type cfg struct {
-	svc *putsvc.Service
-	key *ecdsa.PrivateKey
+	svc    *putsvc.Service
+	signer signer.Signer
 }
 
 // NewService constructs Service instance from provided options.
@@ -43,8 +43,9 @@ func (s *Service) Put(ctx context.Context) (object.PutObjectStream, error) {
 	}
 
 	return &streamer{
+		ctx:    ctx,
 		stream: stream,
-		key:    s.key,
+		signer: s.signer,
 	}, nil
 }
 
@@ -54,8 +55,8 @@ func WithInternalService(v *putsvc.Service) Option {
 	}
 }
 
-func WithKey(k *ecdsa.PrivateKey) Option {
+func WithSigner(s signer.Signer) Option {
 	return func(c *cfg) {
-		c.key = k
+		c.signer = s
 	}
 }
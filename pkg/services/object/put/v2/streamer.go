@@ -1,7 +1,9 @@
 package putsvc
 
 import (
-	"crypto/ecdsa"
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
@@ -14,14 +16,22 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
+	"github.com/nspcc-dev/neofs-node/pkg/services/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 )
 
 type streamer struct {
-	stream     *putsvc.Streamer
-	key        *ecdsa.PrivateKey
+	ctx    context.Context
+	stream *putsvc.Streamer
+	signer signer.Signer
+	// whether the request can be relayed to other nodes
+	relayed bool
+	// whether the chunks are cached to be relayed after the whole payload
 	saveChunks bool
 	init       *object.PutRequest
 	chunks     []*object.PutRequest
+	// relayed request carrying the chunk being processed
+	chunk *object.PutRequest
 
 	*sizes // only for relay streams
 }
@@ -29,7 +39,7 @@ type streamer struct {
 type sizes struct {
 	payloadSz uint64 // value from the header
 
-	writtenPayload uint64 // sum size of already cached chunks
+	writtenPayload uint64 // sum size of already processed chunks
 }
 
 func (s *streamer) Send(req *object.PutRequest) (err error) {
@@ -42,12 +52,25 @@ func (s *streamer) Send(req *object.PutRequest) (err error) {
 			return err
 		}
 
+		s.relayed = v.GetSignature() != nil
+		if s.relayed {
+			// relay streams may be opened during the initialization
+			s.init, err = s.forwardRequest(req)
+			if err != nil {
+				return err
+			}
+		}
+
 		if err = s.stream.Init(initPrm); err != nil {
-			err = fmt.Errorf("(%T) could not init object put stream: %w", s, err)
+			return fmt.Errorf("(%T) could not init object put stream: %w", s, err)
 		}
 
-		s.saveChunks = v.GetSignature() != nil
-		if s.saveChunks {
+		if s.stream.PayloadPulled() {
+			// payload is pulled by the node itself, nothing is sent or relayed
+			s.relayed = false
+		}
+
+		if s.relayed {
 			maxSz := s.stream.MaxObjectSize()
 
 			s.sizes = &sizes{
@@ -59,45 +82,58 @@ func (s *streamer) Send(req *object.PutRequest) (err error) {
 				return putsvc.ErrExceedingMaxSize
 			}
 
-			s.init = req
+			s.saveChunks = !s.stream.PayloadStreamed()
 		}
 	case *object.PutObjectPartChunk:
-		if s.saveChunks {
+		if s.relayed {
 			s.writtenPayload += uint64(len(v.GetChunk()))
 
 			// check payload size overflow
 			if s.writtenPayload > s.payloadSz {
 				return putsvc.ErrWrongPayloadSize
 			}
+
+			s.chunk, err = s.forwardRequest(req)
+			if err != nil {
+				return err
+			}
 		}
 
 		if err = s.stream.SendChunk(toChunkPrm(v)); err != nil {
-			err = fmt.Errorf("(%T) could not send payload chunk: %w", s, err)
+			return fmt.Errorf("(%T) could not send payload chunk: %w", s, err)
 		}
 
 		if s.saveChunks {
-			s.chunks = append(s.chunks, req)
+			s.chunks = append(s.chunks, s.chunk)
 		}
 	default:
 		err = fmt.Errorf("(%T) invalid object put stream part type %T", s, v)
 	}
 
-	if err != nil || !s.saveChunks {
-		return
-	}
+	return
+}
+
+// forwardRequest returns the copy of the request to be relayed to other
+// nodes.
+func (s *streamer) forwardRequest(req *object.PutRequest) (*object.PutRequest, error) {
+	fwd := *req
 
 	metaHdr := new(sessionV2.RequestMetaHeader)
 	meta := req.GetMetaHeader()
 
 	metaHdr.SetTTL(meta.GetTTL() - 1)
 	metaHdr.SetOrigin(meta)
-	req.SetMetaHeader(metaHdr)
+	fwd.SetMetaHeader(metaHdr)
 
-	return signature.SignServiceMessage(s.key, req)
+	if err := util.SignForwardedRequest(s.signer, &fwd); err != nil {
+		return nil, fmt.Errorf("(%T) could not sign relayed request: %w", s, err)
+	}
+
+	return &fwd, nil
 }
 
 func (s *streamer) CloseAndRecv() (*object.PutResponse, error) {
-	if s.saveChunks {
+	if s.relayed {
 		// check payload size correctness
 		if s.writtenPayload != s.payloadSz {
 			return nil, putsvc.ErrWrongPayloadSize
@@ -183,3 +219,101 @@ func (s *streamer) relayRequest(info client.NodeInfo, c client.MultiAddressClien
 
 	return firstErr
 }
+
+// relayStream relays the requests of the streamed object to the remote node.
+type relayStream struct {
+	s *streamer
+
+	c client.MultiAddressClient
+
+	key []byte
+
+	stream *rpc.PutRequestWriter
+
+	resp *object.PutResponse
+}
+
+func (s *streamer) openRelayStream(info client.NodeInfo, c client.MultiAddressClient) (putsvc.RelayStream, error) {
+	res := &relayStream{
+		s:    s,
+		c:    c,
+		key:  info.PublicKey(),
+		resp: new(object.PutResponse),
+	}
+
+	var firstErr error
+
+	info.AddressGroup().IterateAddresses(func(addr network.Address) (stop bool) {
+		var err error
+
+		defer func() {
+			stop = err == nil
+
+			if stop || firstErr == nil {
+				firstErr = err
+			}
+		}()
+
+		err = c.RawForAddress(addr, func(cli *rawclient.Client) error {
+			// the stream is aborted along with the original request if the
+			// latter fails before the stream is closed
+			res.stream, err = rpc.PutObject(cli, res.resp, rawclient.WithContext(s.ctx))
+			return err
+		})
+		if err != nil {
+			err = fmt.Errorf("stream opening failed: %w", err)
+			return
+		}
+
+		// send init part
+		err = res.stream.Write(s.init)
+		if err != nil {
+			internalclient.ReportError(c, err)
+			err = fmt.Errorf("sending the initial message to stream failed: %w", err)
+		}
+
+		return
+	})
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return res, nil
+}
+
+// WriteChunk relays the request carrying the chunk being processed. The
+// request is signed by the client, so the chunk must be exactly the one it
+// carries.
+func (x *relayStream) WriteChunk(p []byte) error {
+	part, ok := x.s.chunk.GetBody().GetObjectPart().(*object.PutObjectPartChunk)
+	if !ok || !bytes.Equal(part.GetChunk(), p) {
+		return errors.New("chunk does not match the relayed request")
+	}
+
+	if err := x.stream.Write(x.s.chunk); err != nil {
+		internalclient.ReportError(x.c, err)
+		return fmt.Errorf("sending the chunk failed: %w", err)
+	}
+
+	return nil
+}
+
+func (x *relayStream) Close() error {
+	// close object stream and receive response from remote node
+	if err := x.stream.Close(); err != nil {
+		return fmt.Errorf("closing the stream failed: %w", err)
+	}
+
+	// verify response key
+	if err := internal.VerifyResponseKeyV2(x.key, x.resp); err != nil {
+		return err
+	}
+
+	// verify response structure
+	if err := signature.VerifyServiceMessage(x.resp); err != nil {
+		return fmt.Errorf("response verification failed: %w", err)
+	}
+
+	return nil
+}
@@ -24,6 +24,7 @@ func (s *streamer) toInitPrm(part *objectV2.PutObjectPartInit, req *objectV2.Put
 			object.NewFromV2(oV2),
 		).
 		WithRelay(s.relayRequest).
+		WithRelayStream(s.openRelayStream).
 		WithCommonPrm(commonPrm).
 		WithCopiesNumber(part.GetCopiesNumber()), nil
 }
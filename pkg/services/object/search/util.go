@@ -91,7 +91,7 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 		}
 	}
 
-	key, err := exec.svc.keyStore.GetKey(sessionInfo)
+	signer, err := exec.svc.keyStore.GetSigner(sessionInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +100,7 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 
 	prm.SetContext(exec.context())
 	prm.SetClient(c.client)
-	prm.SetPrivateKey(key)
+	prm.SetSigner(signer)
 	prm.SetSessionToken(exec.prm.common.SessionToken())
 	prm.SetBearerToken(exec.prm.common.BearerToken())
 	prm.SetTTL(exec.prm.common.TTL())
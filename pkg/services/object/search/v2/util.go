@@ -17,6 +17,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/util"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -54,7 +55,7 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 	if !commonPrm.LocalOnly() {
 		var onceResign sync.Once
 
-		key, err := s.keyStorage.GetKey(nil)
+		nodeSigner, err := s.keyStorage.GetSigner(nil)
 		if err != nil {
 			return nil, err
 		}
@@ -72,7 +73,7 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 
 				req.SetMetaHeader(metaHdr)
 
-				err = signature.SignServiceMessage(key, req)
+				err = svcutil.SignForwardedRequest(nodeSigner, req)
 			})
 
 			if err != nil {
@@ -2,16 +2,14 @@ package object
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 )
 
 type SignService struct {
-	key *ecdsa.PrivateKey
-
 	sigSvc *util.SignService
 
 	svc ServiceServer
@@ -41,10 +39,9 @@ type getRangeStreamSigner struct {
 	respWriter util.ResponseMessageWriter
 }
 
-func NewSignService(key *ecdsa.PrivateKey, svc ServiceServer) *SignService {
+func NewSignService(s signer.Signer, svc ServiceServer) *SignService {
 	return &SignService{
-		key:    key,
-		sigSvc: util.NewUnarySignService(key),
+		sigSvc: util.NewUnarySignService(s),
 		svc:    svc,
 	}
 }
@@ -1,12 +1,13 @@
 package util
 
 import (
-	"crypto/ecdsa"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/services/session/storage"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
@@ -25,7 +26,7 @@ type SessionSource interface {
 
 // KeyStorage represents private key storage of the local node.
 type KeyStorage struct {
-	key *ecdsa.PrivateKey
+	signer signer.Signer
 
 	tokenStore SessionSource
 
@@ -33,9 +34,9 @@ type KeyStorage struct {
 }
 
 // NewKeyStorage creates, initializes and returns new KeyStorage instance.
-func NewKeyStorage(localKey *ecdsa.PrivateKey, tokenStore SessionSource, net netmap.State) *KeyStorage {
+func NewKeyStorage(localSigner signer.Signer, tokenStore SessionSource, net netmap.State) *KeyStorage {
 	return &KeyStorage{
-		key:          localKey,
+		signer:       localSigner,
 		tokenStore:   tokenStore,
 		networkState: net,
 	}
@@ -51,14 +52,15 @@ type SessionInfo struct {
 	Owner user.ID
 }
 
-// GetKey fetches private key depending on the SessionInfo.
+// GetSigner fetches signer depending on the SessionInfo.
 //
 // If info is not `nil`, searches for dynamic session token through the
-// underlying token storage. Returns apistatus.SessionTokenNotFound if
-// token storage does not contain information about provided dynamic session.
+// underlying token storage and returns signer of its private key. Returns
+// apistatus.SessionTokenNotFound if token storage does not contain information
+// about provided dynamic session.
 //
-// If info is `nil`, returns node's private key.
-func (s *KeyStorage) GetKey(info *SessionInfo) (*ecdsa.PrivateKey, error) {
+// If info is `nil`, returns node's signer.
+func (s *KeyStorage) GetSigner(info *SessionInfo) (signer.Signer, error) {
 	if info != nil {
 		binID, err := info.ID.MarshalBinary()
 		if err != nil {
@@ -72,7 +74,7 @@ func (s *KeyStorage) GetKey(info *SessionInfo) (*ecdsa.PrivateKey, error) {
 
 				return nil, errExpired
 			}
-			return pToken.SessionKey(), nil
+			return signer.FromPrivateKey(&keys.PrivateKey{PrivateKey: *pToken.SessionKey()}), nil
 		}
 
 		var errNotFound apistatus.SessionTokenNotFound
@@ -80,5 +82,5 @@ func (s *KeyStorage) GetKey(info *SessionInfo) (*ecdsa.PrivateKey, error) {
 		return nil, errNotFound
 	}
 
-	return s.key, nil
+	return s.signer, nil
 }
@@ -11,6 +11,7 @@ import (
 	sessionV2 "github.com/nspcc-dev/neofs-api-go/v2/session"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	tokenStorage "github.com/nspcc-dev/neofs-node/pkg/services/session/storage/temporary"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
@@ -23,18 +24,18 @@ func TestNewKeyStorage(t *testing.T) {
 	require.NoError(t, err)
 
 	tokenStor := tokenStorage.NewTokenStore()
-	stor := util.NewKeyStorage(&nodeKey.PrivateKey, tokenStor, mockedNetworkState{42})
+	stor := util.NewKeyStorage(signer.FromPrivateKey(nodeKey), tokenStor, mockedNetworkState{42})
 
 	owner := usertest.ID(t)
 
 	t.Run("node key", func(t *testing.T) {
-		key, err := stor.GetKey(nil)
+		s, err := stor.GetSigner(nil)
 		require.NoError(t, err)
-		require.Equal(t, nodeKey.PrivateKey, *key)
+		require.Equal(t, nodeKey.PublicKey(), s.PublicKey())
 	})
 
 	t.Run("unknown token", func(t *testing.T) {
-		_, err = stor.GetKey(&util.SessionInfo{
+		_, err = stor.GetSigner(&util.SessionInfo{
 			ID:    uuid.New(),
 			Owner: usertest.ID(t),
 		})
@@ -44,17 +45,17 @@ func TestNewKeyStorage(t *testing.T) {
 	t.Run("known token", func(t *testing.T) {
 		tok := createToken(t, tokenStor, owner, 100)
 
-		key, err := stor.GetKey(&util.SessionInfo{
+		s, err := stor.GetSigner(&util.SessionInfo{
 			ID:    tok.ID(),
 			Owner: owner,
 		})
 		require.NoError(t, err)
-		require.True(t, tok.AssertAuthKey((*neofsecdsa.PublicKey)(&key.PublicKey)))
+		require.True(t, tok.AssertAuthKey((*neofsecdsa.PublicKey)(s.PublicKey())))
 	})
 
 	t.Run("expired token", func(t *testing.T) {
 		tok := createToken(t, tokenStor, owner, 30)
-		_, err := stor.GetKey(&util.SessionInfo{
+		_, err := stor.GetSigner(&util.SessionInfo{
 			ID:    tok.ID(),
 			Owner: owner,
 		})
@@ -70,7 +71,7 @@ func createToken(t *testing.T, store *tokenStorage.TokenStore, owner user.ID, ex
 	req.SetOwnerID(&ownerV2)
 	req.SetExpiration(exp)
 
-	resp, err := store.Create(context.Background(), req)
+	resp, err := store.Create(context.Background(), req, nil)
 	require.NoError(t, err)
 
 	pub, err := keys.NewPublicKeyFromBytes(resp.GetSessionKey(), elliptic.P256())
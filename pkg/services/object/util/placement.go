@@ -5,6 +5,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -162,3 +163,36 @@ func (g *TraverserGenerator) GenerateTraverser(idCnr cid.ID, idObj *oid.ID, epoc
 
 	return placement.NewTraverser(traverseOpts...)
 }
+
+// GenerateECPlacement returns erasure coding rule of the container and storage
+// nodes for the parts of the erasure-coded object using epoch-th network map:
+// i-th part is stored on the i-th node. Returns zero rule if erasure coding is
+// disabled in the container.
+func (g *TraverserGenerator) GenerateECPlacement(idCnr cid.ID, idObj oid.ID, epoch uint64) (ec.Rule, []netmapSDK.NodeInfo, error) {
+	cnr, err := g.cnrSrc.Get(idCnr)
+	if err != nil {
+		return ec.Rule{}, nil, fmt.Errorf("could not get container: %w", err)
+	}
+
+	rule, err := ec.RuleFromContainer(cnr.Value)
+	if err != nil || rule.IsZero() {
+		return rule, nil, err
+	}
+
+	nm, err := g.netMapSrc.GetNetMapByEpoch(epoch)
+	if err != nil {
+		return ec.Rule{}, nil, fmt.Errorf("could not get network map #%d: %w", epoch, err)
+	}
+
+	vs, err := placement.NewNetworkMapBuilder(nm).BuildPlacement(idCnr, &idObj, cnr.Value.PlacementPolicy())
+	if err != nil {
+		return ec.Rule{}, nil, fmt.Errorf("could not build object placement: %w", err)
+	}
+
+	nodes, err := ec.PartNodes(vs, rule)
+	if err != nil {
+		return ec.Rule{}, nil, err
+	}
+
+	return rule, nodes, nil
+}
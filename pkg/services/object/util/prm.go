@@ -1,17 +1,50 @@
 package util
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	sessionsdk "github.com/nspcc-dev/neofs-sdk-go/session"
 )
 
 // maxLocalTTL is maximum TTL for an operation to be considered local.
 const maxLocalTTL = 1
 
+// XHeaderCopyFrom is an X-header of the PUT request with the address of the
+// object to be copied. The address is encoded by oid.Address.EncodeToString.
+const XHeaderCopyFrom = "__NEOFS__COPY_FROM"
+
+// XHeaderPatchFrom is an X-header of the PUT request with the address of the
+// object to be patched. The address is encoded by oid.Address.EncodeToString.
+const XHeaderPatchFrom = "__NEOFS__PATCH_FROM"
+
+// XHeaderPatchRange is an X-header of the patching PUT request with the
+// payload range of the patched object replaced by the request payload in
+// "<offset>:<length>" format. The request payload is appended to the patched
+// object if the header is missing.
+const XHeaderPatchRange = "__NEOFS__PATCH_RANGE"
+
+// XHeaderReplicateFrom is an X-header of the replication PUT request making
+// the node pull the object from one of the listed container nodes instead of
+// receiving the payload in the request. The value is a comma-separated list
+// of the hex-encoded public keys of the nodes holding the object.
+const XHeaderReplicateFrom = "__NEOFS__REPLICATE_FROM"
+
+// XHeaderRedirect is an X-header of the GET and RANGE requests allowing the
+// node to respond with the container nodes storing the object instead of
+// proxying it. The value is a boolean in strconv.ParseBool format.
+const XHeaderRedirect = "__NEOFS__REDIRECT"
+
+// XHeaderACLTrace is an X-header of the object requests making the node
+// explain the access denials: the sender role and the matching eACL record
+// are added to the reason. The value is a boolean in strconv.ParseBool format.
+const XHeaderACLTrace = "__NEOFS__ACL_TRACE"
+
 type CommonPrm struct {
 	local bool
 
@@ -24,6 +57,20 @@ type CommonPrm struct {
 	ttl uint32
 
 	xhdrs []string
+
+	copyFrom *oid.Address
+
+	patchFrom *oid.Address
+
+	// set if the patched payload range is specified
+	patchRange bool
+
+	patchOff, patchLen uint64
+
+	redirect bool
+
+	// public keys of the nodes to pull the replicated object from
+	replicateFrom [][]byte
 }
 
 // TTL returns TTL for new requests.
@@ -76,6 +123,56 @@ func (p *CommonPrm) BearerToken() *bearer.Token {
 	return nil
 }
 
+// ReplicationSources returns public keys of the nodes to pull the replicated
+// object from, nil if the object is not pulled.
+func (p *CommonPrm) ReplicationSources() [][]byte {
+	if p != nil {
+		return p.replicateFrom
+	}
+
+	return nil
+}
+
+// CopySource returns the address of the object to be copied, nil if the
+// request does not copy objects.
+func (p *CommonPrm) CopySource() *oid.Address {
+	if p != nil {
+		return p.copyFrom
+	}
+
+	return nil
+}
+
+// PatchSource returns the address of the object to be patched, nil if the
+// request does not patch objects.
+func (p *CommonPrm) PatchSource() *oid.Address {
+	if p != nil {
+		return p.patchFrom
+	}
+
+	return nil
+}
+
+// PatchRange returns the offset and the length of the patched payload range.
+// Returns false if the request payload is appended to the patched object.
+func (p *CommonPrm) PatchRange() (uint64, uint64, bool) {
+	if p != nil && p.patchRange {
+		return p.patchOff, p.patchLen, true
+	}
+
+	return 0, 0, false
+}
+
+// Redirect returns true if the client allowed the node to redirect it to the
+// other nodes.
+func (p *CommonPrm) Redirect() bool {
+	if p != nil {
+		return p.redirect
+	}
+
+	return false
+}
+
 func (p *CommonPrm) NetmapEpoch() uint64 {
 	if p != nil {
 		return p.netmapEpoch
@@ -163,6 +260,57 @@ func CommonPrmFromV2(req interface {
 			if err != nil {
 				return nil, err
 			}
+		case XHeaderCopyFrom:
+			prm.copyFrom = new(oid.Address)
+
+			err := prm.copyFrom.DecodeString(xHdrs[i].GetValue())
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s X-header: %w", key, err)
+			}
+		case XHeaderPatchFrom:
+			prm.patchFrom = new(oid.Address)
+
+			err := prm.patchFrom.DecodeString(xHdrs[i].GetValue())
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s X-header: %w", key, err)
+			}
+		case XHeaderPatchRange:
+			off, ln, found := strings.Cut(xHdrs[i].GetValue(), ":")
+			if !found {
+				return nil, fmt.Errorf("invalid %s X-header: missing length", key)
+			}
+
+			prm.patchOff, err = strconv.ParseUint(off, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid offset in %s X-header: %w", key, err)
+			}
+
+			prm.patchLen, err = strconv.ParseUint(ln, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid length in %s X-header: %w", key, err)
+			}
+
+			if prm.patchOff+prm.patchLen < prm.patchOff {
+				return nil, fmt.Errorf("invalid %s X-header: range overflow", key)
+			}
+
+			prm.patchRange = true
+		case XHeaderReplicateFrom:
+			for _, s := range strings.Split(xHdrs[i].GetValue(), ",") {
+				pub, err := hex.DecodeString(s)
+				if err != nil || len(pub) == 0 {
+					return nil, fmt.Errorf("invalid public key %q in %s X-header", s, key)
+				}
+
+				prm.replicateFrom = append(prm.replicateFrom, pub)
+			}
+		case XHeaderRedirect:
+			var err error
+
+			prm.redirect, err = strconv.ParseBool(xHdrs[i].GetValue())
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s X-header: %w", key, err)
+			}
 		default:
 			prm.xhdrs = append(prm.xhdrs, key, xHdrs[i].GetValue())
 		}
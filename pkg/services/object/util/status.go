@@ -0,0 +1,35 @@
+package util
+
+import (
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+)
+
+// Local codes of the object statuses specific to the node. They are taken
+// from the end of the object failure section not used by the NeoFS API, so
+// the clients unaware of them process the statuses as unknown failures.
+const (
+	// StatusUploadOffset is a local code of the status returned when the
+	// resumed upload does not continue from the payload offset stored by the
+	// upload session.
+	StatusUploadOffset status.Code = 1023 - iota
+	// StatusPayloadCorrupted is a local code of the status returned when the
+	// payload of the read object does not match its size or checksums.
+	StatusPayloadCorrupted
+	// StatusRedirect is a local code of the status returned when the object
+	// is not stored on the node and should be requested from the container
+	// nodes directly.
+	StatusRedirect
+)
+
+// NewStatus returns the object failure status with the given local code and
+// message.
+func NewStatus(code status.Code, msg string) *status.Status {
+	objectV2.GlobalizeFail(&code)
+
+	st := new(status.Status)
+	st.SetCode(code)
+	st.SetMessage(msg)
+
+	return st
+}
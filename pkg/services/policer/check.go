@@ -3,6 +3,8 @@ package policer
 import (
 	"context"
 	"errors"
+	"sort"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -76,7 +78,10 @@ func (n nodeCache) atLeastOneHolder() bool {
 	return false
 }
 
-func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.AddressWithType) {
+// processObject checks the object storage policy compliance and replicates the
+// object if needed. Returns false if the object still lacks replicas after
+// the check.
+func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.AddressWithType) bool {
 	addr := addrWithType.Address
 	idCnr := addr.Container()
 	idObj := addr.Object()
@@ -101,11 +106,15 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 			}
 		}
 
-		return
+		return true
 	}
 
 	policy := cnr.Value.PlacementPolicy()
 
+	if info, ok := p.ecPartInfo(cnr.Value, addrWithType); ok {
+		return p.processECPart(ctx, addr, policy, info)
+	}
+
 	nn, err := p.placementBuilder.BuildPlacement(idCnr, &idObj, policy)
 	if err != nil {
 		p.log.Error("could not build placement vector for object",
@@ -113,7 +122,7 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 			zap.String("error", err.Error()),
 		)
 
-		return
+		return true
 	}
 
 	c := &processPlacementContext{
@@ -125,7 +134,7 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 	for i := range nn {
 		select {
 		case <-ctx.Done():
-			return
+			return true
 		default:
 		}
 
@@ -135,7 +144,7 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 	// if context is done, needLocalCopy might not be able to calculate
 	select {
 	case <-ctx.Done():
-		return
+		return true
 	default:
 	}
 
@@ -152,7 +161,7 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 					zap.Stringer("object", addr),
 				)
 
-				return
+				return true
 			}
 
 			// If local node is outside the object container and at least one correct
@@ -165,7 +174,7 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 					zap.Stringer("object", addr),
 				)
 
-				return
+				return true
 			}
 
 			p.log.Info("node outside the container, removing the replica so as not to violate the storage policy...",
@@ -179,6 +188,8 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 
 		p.cbRedundantCopy(addr)
 	}
+
+	return !c.replicationFailed
 }
 
 type processPlacementContext struct {
@@ -198,6 +209,33 @@ type processPlacementContext struct {
 
 	// caches nodes which has been already processed in previous iterations
 	checkedNodes *nodeCache
+
+	// whether the object has not been replicated to the required number of nodes
+	replicationFailed bool
+
+	// nodes confirmed to hold the object replica with their response times,
+	// zero for the local node
+	holders []replicaHolder
+}
+
+type replicaHolder struct {
+	node netmap.NodeInfo
+	rtt  time.Duration
+}
+
+// nearestHolders returns the replica holders sorted by their response time
+// starting from the local node.
+func (x *processPlacementContext) nearestHolders() []netmap.NodeInfo {
+	sort.SliceStable(x.holders, func(i, j int) bool {
+		return x.holders[i].rtt < x.holders[j].rtt
+	})
+
+	res := make([]netmap.NodeInfo, len(x.holders))
+	for i := range x.holders {
+		res[i] = x.holders[i].node
+	}
+
+	return res
 }
 
 func (p *Policer) processNodes(ctx *processPlacementContext, nodes []netmap.NodeInfo, shortage uint32) {
@@ -252,6 +290,9 @@ func (p *Policer) processNodes(ctx *processPlacementContext, nodes []netmap.Node
 			ctx.needLocalCopy = true
 
 			shortage--
+
+			// local replica is processed, so it is held
+			ctx.holders = append(ctx.holders, replicaHolder{node: nodes[i]})
 		} else if nodes[i].IsMaintenance() {
 			handleMaintenance(nodes[i])
 		} else {
@@ -268,7 +309,9 @@ func (p *Policer) processNodes(ctx *processPlacementContext, nodes []netmap.Node
 
 			callCtx, cancel := context.WithTimeout(ctx, headTimeout)
 
+			start := time.Now()
 			_, err := p.remoteHeader.Head(callCtx, prm.WithNodeInfo(nodes[i]))
+			rtt := time.Since(start)
 
 			cancel()
 
@@ -287,6 +330,7 @@ func (p *Policer) processNodes(ctx *processPlacementContext, nodes []netmap.Node
 			} else {
 				shortage--
 				ctx.checkedNodes.submitReplicaHolder(nodes[i])
+				ctx.holders = append(ctx.holders, replicaHolder{node: nodes[i], rtt: rtt})
 			}
 		}
 
@@ -304,8 +348,20 @@ func (p *Policer) processNodes(ctx *processPlacementContext, nodes []netmap.Node
 		task.SetObjectAddress(ctx.object.Address)
 		task.SetNodes(nodes)
 		task.SetCopiesNumber(shortage)
+		task.SetSources(ctx.nearestHolders())
 
 		p.replicator.HandleTask(ctx, task, ctx.checkedNodes)
+
+		var replicated uint32
+		for i := range nodes {
+			if ctx.checkedNodes.processStatus(nodes[i]) == 0 {
+				replicated++
+			}
+		}
+
+		if replicated < shortage {
+			ctx.replicationFailed = true
+		}
 	} else if uncheckedCopies > 0 {
 		// If we have more copies than needed, but some of them are from the maintenance nodes,
 		// save the local copy.
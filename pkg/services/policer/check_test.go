@@ -2,7 +2,9 @@ package policer
 
 import (
 	"testing"
+	"time"
 
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
 	"github.com/stretchr/testify/require"
 )
@@ -22,3 +24,18 @@ func TestNodeCache(t *testing.T) {
 	cache.submitReplicaHolder(node)
 	require.Zero(t, cache.processStatus(node))
 }
+
+func TestNearestHolders(t *testing.T) {
+	nodes := []netmap.NodeInfo{netmaptest.NodeInfo(), netmaptest.NodeInfo(), netmaptest.NodeInfo()}
+
+	var c processPlacementContext
+	require.Empty(t, c.nearestHolders())
+
+	c.holders = []replicaHolder{
+		{node: nodes[0], rtt: 30 * time.Millisecond},
+		{node: nodes[1], rtt: 10 * time.Millisecond},
+		{node: nodes[2], rtt: 20 * time.Millisecond},
+	}
+
+	require.Equal(t, []netmap.NodeInfo{nodes[1], nodes[2], nodes[0]}, c.nearestHolders())
+}
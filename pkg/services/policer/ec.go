@@ -0,0 +1,338 @@
+package policer
+
+import (
+	"context"
+	"errors"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	ecsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/ec"
+	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	containerSDK "github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// partRef references the part of the erasure-coded object by its header and
+// the node storing it.
+type partRef struct {
+	hdr *object.Object
+	// node storing the part, nil for the local storage
+	node *netmap.NodeInfo
+}
+
+// partSet is a set of the parts of the same erasure-coded object indexed by
+// the part indices.
+type partSet map[int]partRef
+
+// add puts the part headers of the given parent stored on the given node
+// into the set.
+func (s partSet) add(parent oid.ID, hdrs []*object.Object, node *netmap.NodeInfo) {
+	for i := range hdrs {
+		info, ok, err := ec.PartInfoOf(hdrs[i])
+		if err != nil || !ok || info.Parent != parent {
+			continue
+		}
+
+		if _, ok := s[info.Index]; !ok {
+			s[info.Index] = partRef{hdr: hdrs[i], node: node}
+		}
+	}
+}
+
+// hasPart checks whether the list of the part headers contains the part with
+// the given index.
+func hasPart(hdrs []*object.Object, parent oid.ID, idx int) bool {
+	s := make(partSet, len(hdrs))
+	s.add(parent, hdrs, nil)
+	_, ok := s[idx]
+	return ok
+}
+
+// processECPart checks that the parts of the erasure-coded object are stored
+// on their nodes: i-th part on the i-th node of the parent object placement.
+// The node holding the part with the lowest index restores the missing parts
+// from any Rule.DataParts survivors. Misplaced local parts are moved to their
+// nodes. Returns false if the parts still lack after the check.
+func (p *Policer) processECPart(ctx context.Context, addr oid.Address, policy netmap.PlacementPolicy, info ec.PartInfo) bool {
+	idCnr := addr.Container()
+
+	if p.ecParts == nil || p.signer == nil {
+		p.log.Debug("erasure coding is not configured, holding the part...",
+			zap.Stringer("object", addr),
+		)
+
+		return true
+	}
+
+	var parentAddr oid.Address
+	parentAddr.SetContainer(idCnr)
+	parentAddr.SetObject(info.Parent)
+
+	// parts of the removed objects are not needed anymore
+	_, err := engine.Head(p.jobQueue.localStorage, parentAddr)
+	if errors.As(err, new(apistatus.ObjectAlreadyRemoved)) {
+		p.log.Info("parent of the object part is removed, removing the part...",
+			zap.Stringer("object", addr),
+			zap.Stringer("parent", info.Parent),
+		)
+
+		p.cbRedundantCopy(addr)
+
+		return true
+	}
+
+	vs, err := p.placementBuilder.BuildPlacement(idCnr, &info.Parent, policy)
+	if err != nil {
+		p.log.Error("could not build placement vector for object",
+			zap.Stringer("cid", idCnr),
+			zap.String("error", err.Error()),
+		)
+
+		return true
+	}
+
+	nodes, err := ec.PartNodes(vs, info.Rule)
+	if err != nil {
+		p.log.Error("could not build placement of the object parts",
+			zap.Stringer("object", addr),
+			zap.String("error", err.Error()),
+		)
+
+		return true
+	}
+
+	if !p.netmapKeys.IsLocalKey(nodes[info.Index].PublicKey()) {
+		return p.moveECPart(ctx, addr, nodes[info.Index], info)
+	}
+
+	local, err := ecsvc.LocalHeads(p.jobQueue.localStorage, idCnr, info.Parent)
+	if err != nil {
+		p.log.Error("could not get local parts of the object",
+			zap.Stringer("parent", info.Parent),
+			zap.String("error", err.Error()),
+		)
+
+		return true
+	}
+
+	available := make(partSet, info.Rule.Total())
+	available.add(info.Parent, local, nil)
+
+	var missing []int
+
+	for i := range nodes {
+		if i == info.Index {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return true
+		default:
+		}
+
+		if nodes[i].IsMaintenance() {
+			// the same as for the replicas, consider nodes under maintenance
+			// as the holders
+			continue
+		}
+
+		hdrs, err := p.remotePartHeads(ctx, nodes[i], parentAddr)
+		if err != nil {
+			if !isClientErrMaintenance(err) {
+				p.log.Error("receive object parts to check policy compliance",
+					zap.Stringer("parent", info.Parent),
+					zap.String("node", netmap.StringifyPublicKey(nodes[i])),
+					zap.String("error", err.Error()),
+				)
+			}
+
+			continue
+		}
+
+		if !hasPart(hdrs, info.Parent, i) {
+			missing = append(missing, i)
+		} else if i < info.Index {
+			// the missing parts are restored by the node with the lower index
+			return true
+		}
+
+		available.add(info.Parent, hdrs, &nodes[i])
+	}
+
+	if len(missing) == 0 {
+		return true
+	}
+
+	p.log.Debug("shortage of object parts detected",
+		zap.Stringer("parent", info.Parent),
+		zap.Ints("missing", missing),
+	)
+
+	all, err := ec.Reconstruct(p.fetchParts(ctx, addr.Container(), available, info.Rule.DataParts), p.signer)
+	if err != nil {
+		p.log.Error("could not restore missing object parts",
+			zap.Stringer("parent", info.Parent),
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	}
+
+	for _, i := range missing {
+		var task replicator.Task
+		task.SetObject(all[i])
+		task.SetObjectAddress(objectcore.AddressOf(all[i]))
+		task.SetNodes([]netmap.NodeInfo{nodes[i]})
+		task.SetCopiesNumber(1)
+
+		res := newNodeCache()
+
+		p.replicator.HandleTask(ctx, task, res)
+
+		if res.processStatus(nodes[i]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// moveECPart replicates the local part to its node and removes the local
+// copy after that.
+func (p *Policer) moveECPart(ctx context.Context, addr oid.Address, node netmap.NodeInfo, info ec.PartInfo) bool {
+	if !p.network.IsLocalNodeInNetmap() {
+		p.log.Info("node is outside the network map, holding the part...",
+			zap.Stringer("object", addr),
+		)
+
+		return true
+	}
+
+	var parentAddr oid.Address
+	parentAddr.SetContainer(addr.Container())
+	parentAddr.SetObject(info.Parent)
+
+	hdrs, err := p.remotePartHeads(ctx, node, parentAddr)
+	if err != nil {
+		p.log.Error("receive object parts to check policy compliance",
+			zap.Stringer("parent", info.Parent),
+			zap.String("node", netmap.StringifyPublicKey(node)),
+			zap.String("error", err.Error()),
+		)
+
+		return true
+	}
+
+	if !hasPart(hdrs, info.Parent, info.Index) {
+		var task replicator.Task
+		task.SetObjectAddress(addr)
+		task.SetNodes([]netmap.NodeInfo{node})
+		task.SetCopiesNumber(1)
+
+		res := newNodeCache()
+
+		p.replicator.HandleTask(ctx, task, res)
+
+		if res.processStatus(node) != 0 {
+			return false
+		}
+	}
+
+	p.log.Info("object part is stored on another node, removing the local copy...",
+		zap.Stringer("object", addr),
+	)
+
+	p.cbRedundantCopy(addr)
+
+	return true
+}
+
+func (p *Policer) remotePartHeads(ctx context.Context, node netmap.NodeInfo, parent oid.Address) ([]*object.Object, error) {
+	p.cfg.RLock()
+	headTimeout := p.headTimeout
+	p.cfg.RUnlock()
+
+	callCtx, cancel := context.WithTimeout(ctx, headTimeout)
+	defer cancel()
+
+	return p.ecParts.Heads(callCtx, node, parent.Container(), parent.Object())
+}
+
+// fetchParts gets up to n full parts referenced by the set. Unavailable parts
+// are skipped.
+func (p *Policer) fetchParts(ctx context.Context, cnr cid.ID, s partSet, n int) []*object.Object {
+	res := make([]*object.Object, 0, n)
+
+	for idx, ref := range s {
+		if len(res) == n {
+			break
+		}
+
+		id, _ := ref.hdr.ID()
+
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(id)
+
+		var (
+			part *object.Object
+			err  error
+		)
+
+		if ref.node == nil {
+			part, err = engine.Get(p.jobQueue.localStorage, addr)
+		} else {
+			part, err = p.ecParts.Get(ctx, *ref.node, addr)
+		}
+
+		if err != nil {
+			p.log.Error("could not get the object part",
+				zap.Stringer("object", addr),
+				zap.Int("index", idx),
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		res = append(res, part)
+	}
+
+	return res
+}
+
+// ecPartInfo returns information about the local object if it is a part of
+// the erasure-coded object.
+func (p *Policer) ecPartInfo(cnr containerSDK.Container, addr objectcore.AddressWithType) (ec.PartInfo, bool) {
+	if addr.Type != object.TypeRegular {
+		return ec.PartInfo{}, false
+	}
+
+	if rule, err := ec.RuleFromContainer(cnr); err != nil || rule.IsZero() {
+		return ec.PartInfo{}, false
+	}
+
+	hdr, err := engine.Head(p.jobQueue.localStorage, addr.Address)
+	if err != nil {
+		return ec.PartInfo{}, false
+	}
+
+	info, ok, err := ec.PartInfoOf(hdr)
+	if err != nil {
+		p.log.Error("invalid object part",
+			zap.Stringer("object", addr.Address),
+			zap.String("error", err.Error()),
+		)
+
+		return ec.PartInfo{}, false
+	}
+
+	return info, ok
+}
@@ -0,0 +1,47 @@
+package policer
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object/ec"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartSet(t *testing.T) {
+	rule := ec.Rule{DataParts: 2, ParityParts: 2}
+
+	parent := object.New()
+	parent.SetContainerID(cidtest.ID())
+	owner := usertest.ID(t)
+	parent.SetOwnerID(&owner)
+	parent.SetPayload([]byte("Hello, world!"))
+	parent.SetPayloadSize(13)
+	require.NoError(t, parent.SetVerificationFields(neofscryptotest.RandomSigner(t)))
+
+	parentID, _ := parent.ID()
+
+	parts, err := ec.Encode(rule, parent, neofscryptotest.RandomSigner(t))
+	require.NoError(t, err)
+
+	s := make(partSet)
+	s.add(oidtest.ID(), parts, nil)
+	require.Empty(t, s, "parts of other objects must be ignored")
+
+	var node netmap.NodeInfo
+
+	s.add(parentID, parts[1:3], &node)
+	s.add(parentID, parts[2:3], nil)
+	require.Len(t, s, 2)
+	require.Equal(t, &node, s[2].node, "first found part must be kept")
+
+	require.False(t, hasPart(parts[1:3], parentID, 0))
+	require.True(t, hasPart(parts[1:3], parentID, 1))
+	require.True(t, hasPart(parts[1:3], parentID, 2))
+	require.False(t, hasPart(parts[1:3], oidtest.ID(), 1))
+}
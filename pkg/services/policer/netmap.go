@@ -0,0 +1,163 @@
+package policer
+
+import (
+	"bytes"
+	"errors"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"go.uber.org/zap"
+)
+
+// Prioritize schedules the object check before the objects from the
+// background sweep. Returns false if the queue of the prioritized objects is
+// full, such objects are checked by the background sweep only.
+func (p *Policer) Prioritize(addr objectcore.AddressWithType, priority Priority) bool {
+	return p.queue.push(addr, priority, 0)
+}
+
+// NetmapChanged notifies the Policer about the network map change. Local
+// objects of the containers whose placement is affected by the change are
+// scheduled for the prioritized check: with PriorityNodeRemoval if some of the
+// previous container nodes have left the network map and with
+// PriorityPlacementChange otherwise.
+//
+// NetmapChanged iterates over all local objects, so it is recommended to call
+// it asynchronously.
+func (p *Policer) NetmapChanged(prev, cur *netmap.NetMap) {
+	cnrs, err := engine.ListContainers(p.jobQueue.localStorage)
+	if err != nil {
+		p.log.Warn("could not list local containers to check placement changes", zap.Error(err))
+		return
+	}
+
+	affected := make(map[cid.ID]Priority)
+
+	for _, idCnr := range cnrs {
+		cnr, err := p.cnrSrc.Get(idCnr)
+		if err != nil {
+			p.log.Debug("could not get container to check placement changes",
+				zap.Stringer("cid", idCnr), zap.Error(err))
+			continue
+		}
+
+		policy := cnr.Value.PlacementPolicy()
+
+		curNodes, err := cur.ContainerNodes(policy, idCnr)
+		if err != nil {
+			p.log.Debug("could not build container nodes to check placement changes",
+				zap.Stringer("cid", idCnr), zap.Error(err))
+			continue
+		}
+
+		prevNodes, err := prev.ContainerNodes(policy, idCnr)
+		if err != nil {
+			// previous placement is unknown, so it is considered changed
+			p.log.Debug("could not build previous container nodes to check placement changes",
+				zap.Stringer("cid", idCnr), zap.Error(err))
+
+			affected[idCnr] = PriorityPlacementChange
+			continue
+		}
+
+		if priority, changed := placementChangePriority(prevNodes, curNodes, cur); changed {
+			affected[idCnr] = priority
+		}
+	}
+
+	if len(affected) > 0 {
+		p.prioritizeContainers(affected)
+	}
+}
+
+// placementChangePriority compares the container nodes built for the previous
+// and the current network maps and returns the priority of the container
+// objects check if the container nodes differ.
+func placementChangePriority(prev, cur [][]netmap.NodeInfo, curMap *netmap.NetMap) (Priority, bool) {
+	contains := func(nodes []netmap.NodeInfo, key []byte) bool {
+		for i := range nodes {
+			if bytes.Equal(nodes[i].PublicKey(), key) {
+				return true
+			}
+		}
+		return false
+	}
+
+	curNetmapNodes := curMap.Nodes()
+
+	var changed bool
+	for i := range prev {
+		for j := range prev[i] {
+			key := prev[i][j].PublicKey()
+			if !contains(curNetmapNodes, key) {
+				return PriorityNodeRemoval, true
+			}
+
+			if !changed && (i >= len(cur) || !contains(cur[i], key)) {
+				changed = true
+			}
+		}
+	}
+
+	if !changed {
+		for i := range cur {
+			if i >= len(prev) || len(cur[i]) != len(prev[i]) {
+				changed = true
+				break
+			}
+		}
+	}
+
+	return PriorityPlacementChange, changed
+}
+
+// prioritizeContainers schedules the check of all local objects of the given
+// containers with the corresponding priorities. Local storage is iterated in
+// batches, iteration is stopped when the prioritized check queue is full.
+func (p *Policer) prioritizeContainers(cnrs map[cid.ID]Priority) {
+	p.cfg.RLock()
+	batchSize := p.batchSize
+	p.cfg.RUnlock()
+
+	var (
+		addrs  []objectcore.AddressWithType
+		cursor *engine.Cursor
+		err    error
+		queued = make(map[cid.ID]int, len(cnrs))
+	)
+
+loop:
+	for {
+		addrs, cursor, err = p.jobQueue.Select(cursor, batchSize)
+		if err != nil {
+			if !errors.Is(err, engine.ErrEndOfListing) {
+				p.log.Warn("could not list local objects for prioritized check", zap.Error(err))
+			}
+			break
+		}
+
+		for i := range addrs {
+			idCnr := addrs[i].Address.Container()
+
+			priority, ok := cnrs[idCnr]
+			if !ok {
+				continue
+			}
+
+			if !p.queue.push(addrs[i], priority, 0) {
+				p.log.Info("prioritized check queue is full, remaining objects are left to the background check")
+				break loop
+			}
+			queued[idCnr]++
+		}
+	}
+
+	for idCnr, priority := range cnrs {
+		p.log.Debug("container objects are scheduled for prioritized check",
+			zap.Stringer("cid", idCnr),
+			zap.Uint8("priority", uint8(priority)),
+			zap.Int("count", queued[idCnr]))
+	}
+}
@@ -1,15 +1,21 @@
 package policer
 
 import (
+	"context"
 	"sync"
 	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	ecsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/ec"
 	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
@@ -52,6 +58,8 @@ type Policer struct {
 	*cfg
 
 	objsInWork *objectsInWork
+
+	queue *priorityQueue
 }
 
 // Option is an option for Policer constructor.
@@ -87,6 +95,13 @@ type cfg struct {
 
 	remoteHeader *headsvc.RemoteHeader
 
+	ecParts interface {
+		Heads(context.Context, netmapSDK.NodeInfo, cid.ID, oid.ID) ([]*object.Object, error)
+		Get(context.Context, netmapSDK.NodeInfo, oid.Address) (*object.Object, error)
+	}
+
+	signer neofscrypto.Signer
+
 	netmapKeys netmap.AnnouncedKeys
 
 	replicator *replicator.Replicator
@@ -126,6 +141,7 @@ func New(opts ...Option) *Policer {
 		objsInWork: &objectsInWork{
 			objs: make(map[oid.Address]struct{}, c.maxCapacity),
 		},
+		queue: newPriorityQueue(defaultPriorityQueueSize),
 	}
 }
 
@@ -171,6 +187,22 @@ func WithRemoteHeader(v *headsvc.RemoteHeader) Option {
 	}
 }
 
+// WithECPartSource returns option to set source of the erasure-coded object
+// parts stored on the remote nodes.
+func WithECPartSource(v *ecsvc.RemotePartSource) Option {
+	return func(c *cfg) {
+		c.ecParts = v
+	}
+}
+
+// WithSigner returns option to set signer of the object parts restored by
+// Policer.
+func WithSigner(v neofscrypto.Signer) Option {
+	return func(c *cfg) {
+		c.signer = v
+	}
+}
+
 // WithNetmapKeys returns option to set tool to work with announced public keys.
 func WithNetmapKeys(v netmap.AnnouncedKeys) Option {
 	return func(c *cfg) {
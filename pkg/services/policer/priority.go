@@ -0,0 +1,164 @@
+package policer
+
+import (
+	"container/heap"
+	"sync"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Priority defines the order in which the objects are checked by the Policer.
+// Objects with a higher priority are checked first. Objects without any
+// priority are checked by the background sweep over the whole local storage.
+type Priority uint8
+
+const (
+	// PriorityPlacementChange is used for the objects which placement has been
+	// changed, e.g. because of new nodes in the network map.
+	PriorityPlacementChange Priority = iota + 1
+	// PriorityScrub is used for the objects which were found problematic by
+	// the storage checks.
+	PriorityScrub
+	// PriorityReplicationFailure is used for the objects which could not be
+	// replicated to the required number of nodes during the previous check.
+	PriorityReplicationFailure
+	// PriorityNodeRemoval is used for the objects which had their replicas on
+	// the nodes removed from the network map.
+	PriorityNodeRemoval
+)
+
+const (
+	// defaultPriorityQueueSize is a maximum number of the objects waiting for
+	// the prioritized check. Objects above the limit are checked by the
+	// background sweep only.
+	defaultPriorityQueueSize = 100_000
+
+	// maxReplicationRetries is a maximum number of the prioritized checks of
+	// the object after the replication failures. After that the object is
+	// checked by the background sweep only.
+	maxReplicationRetries = 3
+)
+
+type priorityItem struct {
+	addr     objectcore.AddressWithType
+	priority Priority
+	// seq keeps FIFO order for the objects with the same priority
+	seq uint64
+	// retries is a number of the failed replication attempts
+	retries uint8
+
+	index int
+}
+
+type priorityHeap []*priorityItem
+
+func (h priorityHeap) Len() int { return len(h) }
+
+func (h priorityHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h priorityHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *priorityHeap) Push(x any) {
+	it := x.(*priorityItem)
+	it.index = len(*h)
+	*h = append(*h, it)
+}
+
+func (h *priorityHeap) Pop() any {
+	old := *h
+	n := len(old)
+	it := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return it
+}
+
+// priorityQueue is a bounded queue of the unique objects ordered by their
+// check priorities. It is safe for concurrent use.
+type priorityQueue struct {
+	m sync.Mutex
+
+	size  int
+	seq   uint64
+	heap  priorityHeap
+	index map[oid.Address]*priorityItem
+}
+
+func newPriorityQueue(size int) *priorityQueue {
+	return &priorityQueue{
+		size:  size,
+		index: make(map[oid.Address]*priorityItem),
+	}
+}
+
+// push adds the object to the queue. If the object is already queued, its
+// priority is raised if needed. Returns false if the queue is full.
+func (q *priorityQueue) push(addr objectcore.AddressWithType, p Priority, retries uint8) bool {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	if it, ok := q.index[addr.Address]; ok {
+		if it.priority < p {
+			it.priority = p
+			heap.Fix(&q.heap, it.index)
+		}
+		if it.retries < retries {
+			it.retries = retries
+		}
+		return true
+	}
+
+	if len(q.heap) >= q.size {
+		return false
+	}
+
+	q.seq++
+	it := &priorityItem{
+		addr:     addr,
+		priority: p,
+		seq:      q.seq,
+		retries:  retries,
+	}
+	heap.Push(&q.heap, it)
+	q.index[addr.Address] = it
+
+	return true
+}
+
+// pop removes up to n objects with the highest priorities from the queue
+// and returns them.
+func (q *priorityQueue) pop(n int) []priorityItem {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	if n > len(q.heap) {
+		n = len(q.heap)
+	}
+
+	res := make([]priorityItem, 0, n)
+	for i := 0; i < n; i++ {
+		it := heap.Pop(&q.heap).(*priorityItem)
+		delete(q.index, it.addr.Address)
+		res = append(res, *it)
+	}
+
+	return res
+}
+
+// len returns the number of the queued objects.
+func (q *priorityQueue) len() int {
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	return len(q.heap)
+}
@@ -0,0 +1,125 @@
+package policer
+
+import (
+	"context"
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/panjf2000/ants/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	addrs := make([]objectcore.AddressWithType, 5)
+	for i := range addrs {
+		addrs[i].Address = oidtest.Address()
+	}
+
+	q := newPriorityQueue(4)
+
+	require.True(t, q.push(addrs[0], PriorityPlacementChange, 0))
+	require.True(t, q.push(addrs[1], PriorityNodeRemoval, 0))
+	require.True(t, q.push(addrs[2], PriorityPlacementChange, 0))
+	require.True(t, q.push(addrs[3], PriorityScrub, 0))
+	require.False(t, q.push(addrs[4], PriorityNodeRemoval, 0), "queue is full")
+
+	// duplicates raise the priority but do not take place
+	require.True(t, q.push(addrs[2], PriorityReplicationFailure, 2))
+	require.True(t, q.push(addrs[1], PriorityScrub, 0))
+	require.Equal(t, 4, q.len())
+
+	res := q.pop(3)
+	require.Len(t, res, 3)
+	require.Equal(t, addrs[1], res[0].addr)
+	require.Equal(t, PriorityNodeRemoval, res[0].priority)
+	require.Equal(t, addrs[2], res[1].addr)
+	require.EqualValues(t, 2, res[1].retries)
+	require.Equal(t, addrs[3], res[2].addr)
+
+	res = q.pop(10)
+	require.Len(t, res, 1)
+	require.Equal(t, addrs[0], res[0].addr)
+
+	require.Empty(t, q.pop(10))
+	require.True(t, q.push(addrs[4], PriorityNodeRemoval, 0))
+}
+
+func TestPlacementChangePriority(t *testing.T) {
+	nodes := make([]netmap.NodeInfo, 4)
+	for i := range nodes {
+		nodes[i] = netmaptest.NodeInfo()
+	}
+
+	var nm netmap.NetMap
+	nm.SetNodes(nodes[:3])
+
+	t.Run("same placement", func(t *testing.T) {
+		_, changed := placementChangePriority(
+			[][]netmap.NodeInfo{{nodes[0], nodes[1]}},
+			[][]netmap.NodeInfo{{nodes[1], nodes[0]}}, &nm)
+		require.False(t, changed)
+	})
+	t.Run("new node", func(t *testing.T) {
+		p, changed := placementChangePriority(
+			[][]netmap.NodeInfo{{nodes[0], nodes[1]}},
+			[][]netmap.NodeInfo{{nodes[0], nodes[2]}}, &nm)
+		require.True(t, changed)
+		require.Equal(t, PriorityPlacementChange, p)
+	})
+	t.Run("removed node", func(t *testing.T) {
+		p, changed := placementChangePriority(
+			[][]netmap.NodeInfo{{nodes[0], nodes[3]}},
+			[][]netmap.NodeInfo{{nodes[0], nodes[1]}}, &nm)
+		require.True(t, changed)
+		require.Equal(t, PriorityNodeRemoval, p)
+	})
+}
+
+func TestPolicer_submitObject(t *testing.T) {
+	pool, err := ants.NewPool(1)
+	require.NoError(t, err)
+
+	p := &Policer{
+		cfg: &cfg{
+			log:      zap.NewNop(),
+			taskPool: pool,
+		},
+		objsInWork: &objectsInWork{objs: make(map[oid.Address]struct{})},
+		queue:      newPriorityQueue(10),
+	}
+
+	var addr objectcore.AddressWithType
+	addr.Address = oidtest.Address()
+
+	t.Run("in work", func(t *testing.T) {
+		p.objsInWork.add(addr.Address)
+		defer p.objsInWork.remove(addr.Address)
+
+		p.submitObject(context.Background(), addr, PriorityNodeRemoval, 1)
+
+		res := p.queue.pop(10)
+		require.Len(t, res, 1)
+		require.Equal(t, addr, res[0].addr)
+		require.Equal(t, PriorityNodeRemoval, res[0].priority)
+		require.EqualValues(t, 1, res[0].retries)
+
+		// objects of the background sweep are checked in the next cycle
+		p.submitObject(context.Background(), addr, 0, 0)
+		require.Zero(t, p.queue.len())
+	})
+
+	t.Run("pool failure", func(t *testing.T) {
+		pool.Release()
+
+		p.submitObject(context.Background(), addr, PriorityScrub, 0)
+
+		res := p.queue.pop(10)
+		require.Len(t, res, 1)
+		require.Equal(t, PriorityScrub, res[0].priority)
+	})
+}
@@ -45,36 +45,37 @@ func (p *Policer) shardPolicyWorker(ctx context.Context) {
 		default:
 		}
 
-		addrs, cursor, err = p.jobQueue.Select(cursor, batchSize)
-		if err != nil {
-			if errors.Is(err, engine.ErrEndOfListing) {
-				time.Sleep(time.Second) // finished whole cycle, sleep a bit
-				continue
-			}
-			p.log.Warn("failure at object select for replication", zap.Error(err))
-		}
-
-		for i := range addrs {
+		// prioritized objects go first, the rest of the batch is filled
+		// by the background sweep over the whole local storage
+		prioritized := p.queue.pop(int(batchSize))
+		for i := range prioritized {
 			select {
 			case <-ctx.Done():
 				return
 			default:
-				addr := addrs[i]
-				if p.objsInWork.inWork(addr.Address) {
-					// do not process an object
-					// that is in work
+				p.submitObject(ctx, prioritized[i].addr, prioritized[i].priority, prioritized[i].retries)
+			}
+		}
+
+		if sweepSize := batchSize - uint32(len(prioritized)); sweepSize > 0 {
+			addrs, cursor, err = p.jobQueue.Select(cursor, sweepSize)
+			if err != nil {
+				if !errors.Is(err, engine.ErrEndOfListing) {
+					p.log.Warn("failure at object select for replication", zap.Error(err))
+				} else if p.queue.len() == 0 {
+					time.Sleep(time.Second) // finished whole cycle, sleep a bit
 					continue
 				}
+				// prioritized objects are checked without waiting for the end
+				// of the sleep
+			}
 
-				err = p.taskPool.Submit(func() {
-					p.objsInWork.add(addr.Address)
-
-					p.processObject(ctx, addr)
-
-					p.objsInWork.remove(addr.Address)
-				})
-				if err != nil {
-					p.log.Warn("pool submission", zap.Error(err))
+			for i := range addrs {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+					p.submitObject(ctx, addrs[i], 0, 0)
 				}
 			}
 		}
@@ -90,6 +91,42 @@ func (p *Policer) shardPolicyWorker(ctx context.Context) {
 	}
 }
 
+// submitObject submits the object check to the task pool unless the object is
+// already in work. Objects which could not be replicated are scheduled for the
+// prioritized check again until the retry limit is reached. Prioritized
+// objects (non-zero priority) which are in work or could not be submitted are
+// returned to the queue, so their check is not lost.
+func (p *Policer) submitObject(ctx context.Context, addr objectcore.AddressWithType, priority Priority, retries uint8) {
+	if p.objsInWork.inWork(addr.Address) {
+		// do not process an object
+		// that is in work
+		p.requeue(addr, priority, retries)
+		return
+	}
+
+	err := p.taskPool.Submit(func() {
+		p.objsInWork.add(addr.Address)
+
+		if !p.processObject(ctx, addr) && retries < maxReplicationRetries {
+			p.queue.push(addr, PriorityReplicationFailure, retries+1)
+		}
+
+		p.objsInWork.remove(addr.Address)
+	})
+	if err != nil {
+		p.log.Warn("pool submission", zap.Error(err))
+		p.requeue(addr, priority, retries)
+	}
+}
+
+// requeue returns the prioritized object to the queue.
+func (p *Policer) requeue(addr objectcore.AddressWithType, priority Priority, retries uint8) {
+	if priority != 0 && !p.queue.push(addr, priority, retries) {
+		p.log.Debug("prioritized check queue is full, object is left to the background check",
+			zap.Stringer("address", addr.Address))
+	}
+}
+
 func (p *Policer) poolCapacityWorker(ctx context.Context) {
 	p.cfg.RLock()
 	maxCapacity := p.maxCapacity
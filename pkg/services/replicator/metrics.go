@@ -0,0 +1,20 @@
+package replicator
+
+// MetricRegister tracks the replication statistics.
+type MetricRegister interface {
+	// AddReplicationPush registers the object push to the remote node.
+	AddReplicationPush(success bool, payloadSize int)
+	// AddReplicationPull registers the object pull requested from the remote
+	// node.
+	AddReplicationPull(success bool, payloadSize int)
+	// AddToReplicationTasks changes the number of the tasks in progress.
+	AddToReplicationTasks(delta int)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) AddReplicationPush(bool, int) {}
+
+func (noopMetrics) AddReplicationPull(bool, int) {}
+
+func (noopMetrics) AddToReplicationTasks(int) {}
@@ -18,13 +18,32 @@ type TaskResult interface {
 
 // HandleTask executes replication task inside invoking goroutine.
 // Passes all the nodes that accepted the replication to the TaskResult.
+//
+// If the task has replica holders, the nodes are asked to pull the object
+// from the nearest of them first, so the payload goes from the holder to the
+// node directly. The object is pushed from the local storage to the nodes
+// which fail to pull it, e.g. the ones not supporting pull requests.
 func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult) {
+	p.metrics.AddToReplicationTasks(1)
+
 	defer func() {
+		p.metrics.AddToReplicationTasks(-1)
+
 		p.log.Debug("finish work",
 			zap.Uint32("amount of unfinished replicas", task.quantity),
 		)
 	}()
 
+	nodes := task.nodes
+
+	if task.obj == nil && len(task.sources) > 0 {
+		nodes = p.requestPulls(ctx, &task, res)
+	}
+
+	if task.quantity == 0 || len(nodes) == 0 {
+		return
+	}
+
 	if task.obj == nil {
 		var err error
 		task.obj, err = engine.Get(p.localStorage, task.addr)
@@ -37,10 +56,13 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 		}
 	}
 
+	payloadSize := len(task.obj.Payload())
+
 	prm := new(putsvc.RemotePutPrm).
-		WithObject(task.obj)
+		WithObject(task.obj).
+		WithPayloadLimiter(p.limiter)
 
-	for i := 0; task.quantity > 0 && i < len(task.nodes); i++ {
+	for i := 0; task.quantity > 0 && i < len(nodes); i++ {
 		select {
 		case <-ctx.Done():
 			return
@@ -48,16 +70,18 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 		}
 
 		log := p.log.With(
-			zap.String("node", netmap.StringifyPublicKey(task.nodes[i])),
+			zap.String("node", netmap.StringifyPublicKey(nodes[i])),
 			zap.Stringer("object", task.addr),
 		)
 
 		callCtx, cancel := context.WithTimeout(ctx, p.putTimeout)
 
-		err := p.remoteSender.PutObject(callCtx, prm.WithNodeInfo(task.nodes[i]))
+		err := p.remoteSender.PutObject(callCtx, prm.WithNodeInfo(nodes[i]))
 
 		cancel()
 
+		p.metrics.AddReplicationPush(err == nil, payloadSize)
+
 		if err != nil {
 			log.Error("could not replicate object",
 				zap.String("error", err.Error()),
@@ -67,7 +91,66 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 
 			task.quantity--
 
-			res.SubmitSuccessfulReplication(task.nodes[i])
+			res.SubmitSuccessfulReplication(nodes[i])
+		}
+	}
+}
+
+// requestPulls asks the task nodes to pull the object from the task sources
+// until the required number of copies is stored. Returns the nodes failed to
+// pull the object.
+func (p *Replicator) requestPulls(ctx context.Context, task *Task, res TaskResult) []netmap.NodeInfo {
+	hdr, err := engine.Head(p.localStorage, task.addr)
+	if err != nil {
+		p.log.Error("could not get object header from local storage",
+			zap.Stringer("object", task.addr),
+			zap.Error(err))
+
+		return task.nodes
+	}
+
+	var failed []netmap.NodeInfo
+
+	for i := range task.nodes {
+		if task.quantity == 0 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		log := p.log.With(
+			zap.String("node", netmap.StringifyPublicKey(task.nodes[i])),
+			zap.Stringer("object", task.addr),
+		)
+
+		callCtx, cancel := context.WithTimeout(ctx, p.putTimeout)
+
+		err := p.remoteSender.RequestPull(callCtx, task.nodes[i], hdr, task.sources)
+
+		cancel()
+
+		p.metrics.AddReplicationPull(err == nil, int(hdr.PayloadSize()))
+
+		if err != nil {
+			log.Debug("node failed to pull object, pushing it...",
+				zap.String("error", err.Error()),
+			)
+
+			failed = append(failed, task.nodes[i])
+
+			continue
 		}
+
+		log.Debug("object successfully pulled by the node")
+
+		task.quantity--
+
+		res.SubmitSuccessfulReplication(task.nodes[i])
 	}
+
+	return failed
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
+	"github.com/nspcc-dev/neofs-node/pkg/util/rate"
 	"go.uber.org/zap"
 )
 
@@ -25,10 +26,17 @@ type cfg struct {
 	remoteSender *putsvc.RemoteSender
 
 	localStorage *engine.StorageEngine
+
+	// node-wide limit of the replication traffic, nil if unlimited
+	limiter *rate.Limiter
+
+	metrics MetricRegister
 }
 
 func defaultCfg() *cfg {
-	return &cfg{}
+	return &cfg{
+		metrics: noopMetrics{},
+	}
 }
 
 // New creates, initializes and returns Replicator instance.
@@ -73,3 +81,19 @@ func WithLocalStorage(v *engine.StorageEngine) Option {
 		c.localStorage = v
 	}
 }
+
+// WithBandwidthLimiter returns option to limit the total payload traffic of
+// all replications pushed by Replicator. The limiter should be shared with
+// the other replication traffic of the node, e.g. the pulled objects.
+func WithBandwidthLimiter(v *rate.Limiter) Option {
+	return func(c *cfg) {
+		c.limiter = v
+	}
+}
+
+// WithMetrics returns option to set replication metrics register.
+func WithMetrics(v MetricRegister) Option {
+	return func(c *cfg) {
+		c.metrics = v
+	}
+}
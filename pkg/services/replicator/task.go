@@ -15,6 +15,8 @@ type Task struct {
 	obj *objectSDK.Object
 
 	nodes []netmap.NodeInfo
+
+	sources []netmap.NodeInfo
 }
 
 // SetCopiesNumber sets number of copies to replicate.
@@ -36,3 +38,10 @@ func (t *Task) SetObject(obj *objectSDK.Object) {
 func (t *Task) SetNodes(v []netmap.NodeInfo) {
 	t.nodes = v
 }
+
+// SetSources sets a list of the nodes already holding the object including
+// the local one. Task nodes pull the object from the nearest of them instead
+// of receiving it from the local node.
+func (t *Task) SetSources(v []netmap.NodeInfo) {
+	t.sources = v
+}
@@ -5,11 +5,16 @@ import (
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	eaclMatcher "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
 	"go.uber.org/zap"
 )
 
+// ServiceExecutor creates the sessions.
 type ServiceExecutor interface {
-	Create(context.Context, *session.CreateRequestBody) (*session.CreateResponseBody, error)
+	// Create creates the session limited by the given constraints encoded
+	// by eaclMatcher.TokenConstraints.Encode, empty if the session is not
+	// limited.
+	Create(context.Context, *session.CreateRequestBody, map[string]string) (*session.CreateResponseBody, error)
 }
 
 type executorSvc struct {
@@ -32,7 +37,12 @@ func (s *executorSvc) Create(ctx context.Context, req *session.CreateRequest) (*
 		zap.String("request", "Create"),
 	)
 
-	respBody, err := s.exec.Create(ctx, req.GetBody())
+	c, err := readConstraints(req.GetMetaHeader())
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := s.exec.Create(ctx, req.GetBody(), c.Encode())
 	if err != nil {
 		return nil, fmt.Errorf("could not execute Create request: %w", err)
 	}
@@ -42,3 +52,20 @@ func (s *executorSvc) Create(ctx context.Context, req *session.CreateRequest) (*
 
 	return resp, nil
 }
+
+// readConstraints reads the session constraints from the X-headers of the
+// request, see eaclMatcher.TokenConstraints.
+func readConstraints(meta *session.RequestMetaHeader) (eaclMatcher.TokenConstraints, error) {
+	kv := make(map[string]string)
+
+	for _, x := range meta.GetXHeaders() {
+		kv[x.GetKey()] = x.GetValue()
+	}
+
+	c, err := eaclMatcher.DecodeTokenConstraints(kv)
+	if err != nil {
+		return c, fmt.Errorf("invalid session constraints: %w", err)
+	}
+
+	return c, nil
+}
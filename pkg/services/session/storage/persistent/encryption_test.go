@@ -2,6 +2,7 @@ package persistent
 
 import (
 	"bytes"
+	"crypto/rand"
 	"path/filepath"
 	"testing"
 
@@ -27,3 +28,22 @@ func TestTokenStore_Encryption(t *testing.T) {
 
 	require.Equal(t, data, decryptedData)
 }
+
+func TestTokenStore_EncryptionSecret(t *testing.T) {
+	secret := make([]byte, 32)
+	_, _ = rand.Read(secret)
+
+	ts, err := NewTokenStore(filepath.Join(t.TempDir(), ".storage"), WithEncryptionSecret(secret))
+	require.NoError(t, err)
+
+	data := []byte("nice encryption, awesome tests")
+
+	encryptedData, err := ts.encrypt(data)
+	require.NoError(t, err)
+	require.False(t, bytes.Equal(data, encryptedData))
+
+	decryptedData, err := ts.decrypt(encryptedData)
+	require.NoError(t, err)
+
+	require.Equal(t, data, decryptedData)
+}
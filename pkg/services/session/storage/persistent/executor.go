@@ -14,10 +14,10 @@ import (
 
 // Create inits a new private session token using information
 // from corresponding request, saves it to bolt database (and
-// encrypts private keys if storage has been configured so).
-// Returns response that is filled with just created token's
+// encrypts private keys if storage has been configured so)
+// along with the session constraints. Returns response that is filled with just created token's
 // ID and public key for it.
-func (s *TokenStore) Create(_ context.Context, body *session.CreateRequestBody) (*session.CreateResponseBody, error) {
+func (s *TokenStore) Create(_ context.Context, body *session.CreateRequestBody, constraints map[string]string) (*session.CreateResponseBody, error) {
 	idV2 := body.GetOwnerID()
 	if idV2 == nil {
 		return nil, errors.New("missing owner")
@@ -45,6 +45,14 @@ func (s *TokenStore) Create(_ context.Context, body *session.CreateRequestBody)
 		return nil, err
 	}
 
+	var rawConstraints []byte
+	if len(constraints) > 0 {
+		rawConstraints, err = packConstraints(body.GetExpiration(), constraints)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = s.db.Update(func(tx *bbolt.Tx) error {
 		rootBucket := tx.Bucket(sessionsBucket)
 
@@ -59,6 +67,13 @@ func (s *TokenStore) Create(_ context.Context, body *session.CreateRequestBody)
 			return fmt.Errorf("could not put session token for %s oid: %w", id, err)
 		}
 
+		if rawConstraints != nil {
+			err = tx.Bucket(constraintsBucket).Put(constraintsKey(id, uidBytes), rawConstraints)
+			if err != nil {
+				return fmt.Errorf("could not put session constraints for %s oid: %w", id, err)
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
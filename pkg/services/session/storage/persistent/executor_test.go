@@ -42,7 +42,7 @@ func TestTokenStore(t *testing.T) {
 	for i := 0; i < tokenNumber; i++ {
 		req.SetExpiration(uint64(i))
 
-		res, err := ts.Create(context.Background(), req)
+		res, err := ts.Create(context.Background(), req, nil)
 		require.NoError(t, err)
 
 		tokens = append(tokens, tok{
@@ -77,7 +77,9 @@ func TestTokenStore_Persistent(t *testing.T) {
 	req.SetOwnerID(&idOwnerV2)
 	req.SetExpiration(exp)
 
-	res, err := ts.Create(context.Background(), req)
+	constraints := map[string]string{"key": "value"}
+
+	res, err := ts.Create(context.Background(), req, constraints)
 	require.NoError(t, err)
 
 	id := res.GetID()
@@ -95,6 +97,16 @@ func TestTokenStore_Persistent(t *testing.T) {
 	savedToken := ts.Get(idOwner, id)
 
 	equalKeys(t, pubKey, savedToken.SessionKey())
+	require.Equal(t, constraints, savedToken.Constraints())
+
+	ts.RemoveOld(exp)
+	require.Nil(t, ts.Get(idOwner, id))
+
+	err = ts.db.View(func(tx *bbolt.Tx) error {
+		require.Nil(t, tx.Bucket(constraintsBucket).Get(constraintsKey(idOwner, id)))
+		return nil
+	})
+	require.NoError(t, err)
 }
 
 func TestTokenStore_RemoveOld(t *testing.T) {
@@ -138,7 +150,7 @@ func TestTokenStore_RemoveOld(t *testing.T) {
 	for _, test := range tests {
 		req.SetExpiration(test.epoch)
 
-		res, err := ts.Create(context.Background(), req)
+		res, err := ts.Create(context.Background(), req, nil)
 		require.NoError(t, err)
 
 		test.id = res.GetID()
@@ -11,6 +11,7 @@ type cfg struct {
 	l          *zap.Logger
 	timeout    time.Duration
 	privateKey *ecdsa.PrivateKey
+	secret     []byte
 }
 
 // Option allows setting optional parameters of the TokenStore.
@@ -46,3 +47,12 @@ func WithEncryptionKey(k *ecdsa.PrivateKey) Option {
 		c.privateKey = k
 	}
 }
+
+// WithEncryptionSecret return an option to encrypt private
+// session keys using provided AES key. The key must be 16,
+// 24 or 32 bytes long. Overrides WithEncryptionKey.
+func WithEncryptionSecret(secret []byte) Option {
+	return func(c *cfg) {
+		c.secret = secret
+	}
+}
@@ -26,7 +26,12 @@ type TokenStore struct {
 	gcm cipher.AEAD
 }
 
-var sessionsBucket = []byte("sessions")
+var (
+	sessionsBucket = []byte("sessions")
+
+	// session constraints by the owner and token ID, see constraintsKey
+	constraintsBucket = []byte("constraints")
+)
 
 // NewTokenStore creates, initializes and returns a new TokenStore instance.
 //
@@ -48,6 +53,11 @@ func NewTokenStore(path string, opts ...Option) (*TokenStore, error) {
 
 	err = db.Update(func(tx *bbolt.Tx) error {
 		_, err := tx.CreateBucketIfNotExists(sessionsBucket)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists(constraintsBucket)
 		return err
 	})
 	if err != nil {
@@ -60,9 +70,12 @@ func NewTokenStore(path string, opts ...Option) (*TokenStore, error) {
 
 	// enable encryption if it
 	// was configured so
-	if cfg.privateKey != nil {
-		rawKey := make([]byte, (cfg.privateKey.Curve.Params().N.BitLen()+7)/8)
-		cfg.privateKey.D.FillBytes(rawKey)
+	if cfg.privateKey != nil || cfg.secret != nil {
+		rawKey := cfg.secret
+		if rawKey == nil {
+			rawKey = make([]byte, (cfg.privateKey.Curve.Params().N.BitLen()+7)/8)
+			cfg.privateKey.D.FillBytes(rawKey)
+		}
 
 		c, err := aes.NewCipher(rawKey)
 		if err != nil {
@@ -97,9 +110,19 @@ func (s *TokenStore) Get(ownerID user.ID, tokenID []byte) (t *storage.PrivateTok
 			return nil
 		}
 
-		var err error
+		var (
+			constraints map[string]string
+			err         error
+		)
 
-		t, err = s.unpackToken(rawToken)
+		if raw := tx.Bucket(constraintsBucket).Get(constraintsKey(ownerID, tokenID)); raw != nil {
+			constraints, err = unpackConstraints(raw)
+			if err != nil {
+				return err
+			}
+		}
+
+		t, err = s.unpackToken(rawToken, constraints)
 		if err != nil {
 			return err
 		}
@@ -122,6 +145,15 @@ func (s *TokenStore) RemoveOld(epoch uint64) {
 	err := s.db.Update(func(tx *bbolt.Tx) error {
 		rootBucket := tx.Bucket(sessionsBucket)
 
+		c := tx.Bucket(constraintsBucket).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if epochFromToken(v) <= epoch {
+				if err := c.Delete(); err != nil {
+					return fmt.Errorf("could not delete session constraints: %w", err)
+				}
+			}
+		}
+
 		// iterating over ownerIDs
 		return iterateNestedBuckets(rootBucket, func(b *bbolt.Bucket) error {
 			c := b.Cursor()
@@ -4,9 +4,11 @@ import (
 	"crypto/ecdsa"
 	"crypto/x509"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/services/session/storage"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"go.etcd.io/bbolt"
 )
 
@@ -33,7 +35,7 @@ func (s *TokenStore) packToken(exp uint64, key *ecdsa.PrivateKey) ([]byte, error
 	return res, nil
 }
 
-func (s *TokenStore) unpackToken(raw []byte) (*storage.PrivateToken, error) {
+func (s *TokenStore) unpackToken(raw []byte, constraints map[string]string) (*storage.PrivateToken, error) {
 	var err error
 
 	epoch := epochFromToken(raw)
@@ -51,7 +53,41 @@ func (s *TokenStore) unpackToken(raw []byte) (*storage.PrivateToken, error) {
 		return nil, fmt.Errorf("could not unmarshal private key: %w", err)
 	}
 
-	return storage.NewPrivateToken(key, epoch), nil
+	return storage.NewPrivateToken(key, epoch, constraints), nil
+}
+
+func packConstraints(exp uint64, constraints map[string]string) ([]byte, error) {
+	raw, err := json.Marshal(constraints)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal session constraints: %w", err)
+	}
+
+	res := make([]byte, keyOffset, keyOffset+len(raw))
+	binary.LittleEndian.PutUint64(res, exp)
+
+	return append(res, raw...), nil
+}
+
+func unpackConstraints(raw []byte) (map[string]string, error) {
+	var res map[string]string
+
+	err := json.Unmarshal(raw[keyOffset:], &res)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal session constraints: %w", err)
+	}
+
+	return res, nil
+}
+
+// constraintsKey returns the key of the session constraints in the
+// constraints bucket.
+func constraintsKey(owner user.ID, tokenID []byte) []byte {
+	w := owner.WalletBytes()
+
+	res := make([]byte, 0, len(w)+len(tokenID))
+	res = append(res, w...)
+
+	return append(res, tokenID...)
 }
 
 func epochFromToken(rawToken []byte) uint64 {
@@ -20,7 +20,7 @@ func TestPack(t *testing.T) {
 
 	require.Equal(t, uint64(exp), epochFromToken(raw))
 
-	unpacked, err := ts.unpackToken(raw)
+	unpacked, err := ts.unpackToken(raw, nil)
 	require.NoError(t, err)
 
 	require.Equal(t, uint64(exp), unpacked.ExpiredAt())
@@ -12,7 +12,7 @@ import (
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
 
-func (s *TokenStore) Create(_ context.Context, body *session.CreateRequestBody) (*session.CreateResponseBody, error) {
+func (s *TokenStore) Create(_ context.Context, body *session.CreateRequestBody, constraints map[string]string) (*session.CreateResponseBody, error) {
 	idV2 := body.GetOwnerID()
 	if idV2 == nil {
 		return nil, errors.New("missing owner")
@@ -39,7 +39,7 @@ func (s *TokenStore) Create(_ context.Context, body *session.CreateRequestBody)
 	s.tokens[key{
 		tokenID: base58.Encode(uidBytes),
 		ownerID: base58.Encode(id.WalletBytes()),
-	}] = storage.NewPrivateToken(&sk.PrivateKey, body.GetExpiration())
+	}] = storage.NewPrivateToken(&sk.PrivateKey, body.GetExpiration(), constraints)
 	s.mtx.Unlock()
 
 	res := new(session.CreateResponseBody)
@@ -9,14 +9,17 @@ type PrivateToken struct {
 	sessionKey *ecdsa.PrivateKey
 
 	exp uint64
+
+	constraints map[string]string
 }
 
 // NewPrivateToken returns new private token based on the
 // passed values.
-func NewPrivateToken(sk *ecdsa.PrivateKey, exp uint64) *PrivateToken {
+func NewPrivateToken(sk *ecdsa.PrivateKey, exp uint64, constraints map[string]string) *PrivateToken {
 	return &PrivateToken{
-		sessionKey: sk,
-		exp:        exp,
+		sessionKey:  sk,
+		exp:         exp,
+		constraints: constraints,
 	}
 }
 
@@ -29,3 +32,9 @@ func (t *PrivateToken) SessionKey() *ecdsa.PrivateKey {
 func (t *PrivateToken) ExpiredAt() uint64 {
 	return t.exp
 }
+
+// Constraints returns the node-enforced usage limits of the session encoded
+// by eacl.TokenConstraints.Encode, empty if the session is not limited.
+func (t *PrivateToken) Constraints() map[string]string {
+	return t.constraints
+}
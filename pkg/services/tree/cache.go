@@ -2,9 +2,9 @@ package tree
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -12,12 +12,13 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type clientCache struct {
 	sync.Mutex
 	simplelru.LRU[string, cacheItem]
+
+	tlsCfg *tls.Config
 }
 
 type cacheItem struct {
@@ -33,7 +34,9 @@ const (
 
 var errRecentlyFailed = errors.New("client has recently failed")
 
-func (c *clientCache) init() {
+func (c *clientCache) init(tlsCfg *tls.Config) {
+	c.tlsCfg = tlsCfg
+
 	l, _ := simplelru.NewLRU[string, cacheItem](defaultClientCacheSize, func(_ string, v cacheItem) {
 		if conn := v.cc; conn != nil {
 			_ = conn.Close()
@@ -61,7 +64,7 @@ func (c *clientCache) get(ctx context.Context, netmapAddr string) (TreeServiceCl
 		}
 	}
 
-	cc, err := dialTreeService(ctx, netmapAddr)
+	cc, err := dialTreeService(ctx, netmapAddr, c.tlsCfg)
 	lastTry := time.Now()
 
 	c.Lock()
@@ -79,22 +82,17 @@ func (c *clientCache) get(ctx context.Context, netmapAddr string) (TreeServiceCl
 	return NewTreeServiceClient(cc), nil
 }
 
-func dialTreeService(ctx context.Context, netmapAddr string) (*grpc.ClientConn, error) {
+func dialTreeService(ctx context.Context, netmapAddr string, tlsCfg *tls.Config) (*grpc.ClientConn, error) {
 	var netAddr network.Address
 	if err := netAddr.FromString(netmapAddr); err != nil {
 		return nil, err
 	}
 
-	opts := make([]grpc.DialOption, 1, 2)
-	opts[0] = grpc.WithBlock()
-
-	// FIXME(@fyrchik): ugly hack #1322
-	if !strings.HasPrefix(netAddr.URIAddr(), "grpcs:") {
-		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	}
-
 	ctx, cancel := context.WithTimeout(ctx, defaultClientConnectTimeout)
-	cc, err := grpc.DialContext(ctx, netAddr.URIAddr(), opts...)
+	cc, err := grpc.DialContext(ctx, netAddr.HostAddr(),
+		grpc.WithBlock(),
+		transportCredentials(netAddr, tlsCfg),
+	)
 	cancel()
 
 	return cc, err
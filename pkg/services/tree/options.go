@@ -8,6 +8,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"go.uber.org/zap"
 )
@@ -23,6 +24,7 @@ type ContainerSource interface {
 type cfg struct {
 	log        *zap.Logger
 	key        *ecdsa.PrivateKey
+	signer     signer.Signer
 	rawPub     []byte
 	nmSource   netmap.Source
 	cnrSource  ContainerSource
@@ -33,6 +35,9 @@ type cfg struct {
 	replicatorWorkerCount     int
 	replicatorTimeout         time.Duration
 	containerCacheSize        int
+	// mutualTLS enables node key based authentication of
+	// the tree services talking to each other over TLS
+	mutualTLS bool
 }
 
 // Option represents configuration option for a tree service.
@@ -62,15 +67,28 @@ func WithNetmapSource(src netmap.Source) Option {
 	}
 }
 
-// WithPrivateKey sets a netmap source for a tree service.
-// This option is required.
+// WithPrivateKey sets a private key for a tree service. The key is used for
+// signing and for the mutual TLS authentication.
+// Either this option or WithSigner is required.
 func WithPrivateKey(key *ecdsa.PrivateKey) Option {
 	return func(c *cfg) {
 		c.key = key
+		c.signer = signer.FromPrivateKey(&keys.PrivateKey{PrivateKey: *key})
 		c.rawPub = (*keys.PublicKey)(&key.PublicKey).Bytes()
 	}
 }
 
+// WithSigner sets a signer of the tree service messages overriding the one
+// from WithPrivateKey. Mutual TLS authentication requires the private key set
+// by WithPrivateKey.
+// Either this option or WithPrivateKey is required.
+func WithSigner(s signer.Signer) Option {
+	return func(c *cfg) {
+		c.signer = s
+		c.rawPub = s.PublicKey().Bytes()
+	}
+}
+
 // WithLogger sets logger for a tree service.
 func WithLogger(log *zap.Logger) Option {
 	return func(c *cfg) {
@@ -116,3 +134,13 @@ func WithReplicationTimeout(t time.Duration) Option {
 		}
 	}
 }
+
+// WithMutualTLS enables mutual TLS authentication for the tree service
+// connections. If enabled, the service presents the certificate generated
+// from its private key when dialing TLS-enabled endpoints of the other nodes
+// and requires the same from the nodes connected to it over TLS.
+func WithMutualTLS(enabled bool) Option {
+	return func(c *cfg) {
+		c.mutualTLS = enabled
+	}
+}
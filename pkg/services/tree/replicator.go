@@ -123,7 +123,7 @@ func (s *Service) replicateLoop(ctx context.Context) {
 
 func (s *Service) replicate(op movePair) error {
 	req := newApplyRequest(&op)
-	err := SignMessage(req, s.key)
+	err := signMessage(req, s.signer)
 	if err != nil {
 		return fmt.Errorf("can't sign data: %w", err)
 	}
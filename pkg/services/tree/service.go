@@ -56,7 +56,14 @@ func New(opts ...Option) *Service {
 		s.log = zap.NewNop()
 	}
 
-	s.cache.init()
+	tlsCfg, err := newClientTLSConfig(s.key, s.mutualTLS)
+	if err != nil {
+		s.log.Error("can't generate node certificate, mutual TLS authentication is disabled",
+			zap.Error(err))
+		tlsCfg, _ = newClientTLSConfig(s.key, false)
+	}
+
+	s.cache.init(tlsCfg)
 	s.closeCh = make(chan struct{})
 	s.replicateCh = make(chan movePair, s.replicatorChannelCapacity)
 	s.replicateLocalCh = make(chan applyOp)
@@ -456,7 +463,7 @@ func getSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, b *GetSubTreeRe
 }
 
 // Apply locally applies operation from the remote node to the tree.
-func (s *Service) Apply(_ context.Context, req *ApplyRequest) (*ApplyResponse, error) {
+func (s *Service) Apply(ctx context.Context, req *ApplyRequest) (*ApplyResponse, error) {
 	err := verifyMessage(req)
 	if err != nil {
 		return nil, err
@@ -477,6 +484,11 @@ func (s *Service) Apply(_ context.Context, req *ApplyRequest) (*ApplyResponse, e
 		return nil, errors.New("`Apply` request must be signed by a container node")
 	}
 
+	err = s.verifyPeer(ctx, nil, func(pub []byte) bool { return bytes.Equal(pub, key) })
+	if err != nil {
+		return nil, err
+	}
+
 	op := req.GetBody().GetOperation()
 
 	var meta pilorama.Meta
@@ -494,7 +506,13 @@ func (s *Service) Apply(_ context.Context, req *ApplyRequest) (*ApplyResponse, e
 			Meta:   meta,
 		},
 	}:
-	default:
+	case <-s.closeCh:
+		return nil, ErrShuttingDown
+	case <-ctx.Done():
+		// do not lose the operation silently, the senders replicating
+		// the operations limit the waiting by their timeouts and the
+		// importing ones fail
+		return nil, ctx.Err()
 	}
 	return &ApplyResponse{Body: &ApplyResponse_Body{}, Signature: &Signature{}}, nil
 }
@@ -502,6 +520,10 @@ func (s *Service) Apply(_ context.Context, req *ApplyRequest) (*ApplyResponse, e
 func (s *Service) GetOpLog(req *GetOpLogRequest, srv TreeService_GetOpLogServer) error {
 	b := req.GetBody()
 
+	if err := s.verifyPeer(srv.Context(), req, s.isNetmapNode); err != nil {
+		return err
+	}
+
 	var cid cidSDK.ID
 	if err := cid.Decode(req.GetBody().GetContainerId()); err != nil {
 		return err
@@ -9,6 +9,7 @@ import (
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	core "github.com/nspcc-dev/neofs-node/pkg/core/container"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	statusSDK "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
@@ -144,19 +145,23 @@ func verifyMessage(m message) error {
 // message that was generated for the TreeService by the
 // protoc-gen-go-neofs generator. Returns any errors directly.
 func SignMessage(m message, key *ecdsa.PrivateKey) error {
+	return signMessage(m, signer.FromPrivateKey(&keys.PrivateKey{PrivateKey: *key}))
+}
+
+// signMessage is the same as SignMessage but signs via signer.Signer.
+func signMessage(m message, s signer.Signer) error {
 	binBody, err := m.ReadSignedData(nil)
 	if err != nil {
 		return err
 	}
 
-	keySDK := neofsecdsa.Signer(*key)
-	data, err := keySDK.Sign(binBody)
+	data, err := s.Sign(neofscrypto.ECDSA_SHA512, binBody)
 	if err != nil {
 		return err
 	}
 
 	m.SetSignature(&Signature{
-		Key:  neofscrypto.PublicKeyBytes(keySDK.Public()),
+		Key:  s.PublicKey().Bytes(),
 		Sign: data,
 	})
 
@@ -18,7 +18,6 @@ import (
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // ErrNotInContainer is returned when operation could not be performed
@@ -59,7 +58,7 @@ func (s *Service) synchronizeAllTrees(ctx context.Context, cid cid.ID) error {
 		},
 	}
 
-	err = SignMessage(req, s.key)
+	err = signMessage(req, s.signer)
 	if err != nil {
 		return fmt.Errorf("could not sign request: %w", err)
 	}
@@ -155,7 +154,7 @@ func (s *Service) synchronizeTree(ctx context.Context, d pilorama.CIDDescriptor,
 				return false
 			}
 
-			cc, err := grpc.DialContext(ctx, a.URIAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			cc, err := grpc.DialContext(ctx, a.HostAddr(), transportCredentials(a, s.cache.tlsCfg))
 			if err != nil {
 				// Failed to connect, try the next address.
 				return false
@@ -199,7 +198,7 @@ func (s *Service) synchronizeSingle(ctx context.Context, d pilorama.CIDDescripto
 				Height:      newHeight,
 			},
 		}
-		if err := SignMessage(req, s.key); err != nil {
+		if err := signMessage(req, s.signer); err != nil {
 			return newHeight, err
 		}
 
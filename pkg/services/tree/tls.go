@@ -0,0 +1,176 @@
+package tree
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+)
+
+// nodeCertificateLifetime is a validity period of the self-signed certificate
+// generated from the node key. The certificate is regenerated on each restart,
+// so the period just needs to be long enough.
+const nodeCertificateLifetime = 10 * 365 * 24 * time.Hour
+
+var errPeerNotAuthenticated = errors.New("peer is not authenticated with the node key")
+
+// newNodeCertificate generates self-signed X.509 certificate for the given
+// node key. The certificate is presented to the remote tree services when
+// mutual TLS authentication is enabled, so they can match it against the
+// network map.
+func newNodeCertificate(key *ecdsa.PrivateKey) (tls.Certificate, error) {
+	if key == nil {
+		return tls.Certificate{}, errors.New("node private key is not available")
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("generate serial number: %w", err)
+	}
+
+	now := time.Now()
+	tmpl := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName: (*keys.PublicKey)(&key.PublicKey).String(),
+		},
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    now.Add(nodeCertificateLifetime),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("create certificate: %w", err)
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}, nil
+}
+
+// newClientTLSConfig returns TLS configuration for the connections to the
+// remote tree services announced with TLS-enabled addresses. If mutual
+// authentication is requested, the node presents the certificate generated
+// from its key.
+func newClientTLSConfig(key *ecdsa.PrivateKey, mutual bool) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if mutual {
+		cert, err := newNodeCertificate(key)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+// transportCredentials returns gRPC transport credentials suitable for the
+// given address: TLS ones for TLS-enabled addresses and plaintext otherwise.
+func transportCredentials(addr network.Address, tlsCfg *tls.Config) grpc.DialOption {
+	if addr.IsTLSEnabled() {
+		return grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg.Clone()))
+	}
+	return grpc.WithTransportCredentials(insecure.NewCredentials())
+}
+
+// peerPublicKey returns binary public key from the client certificate of the
+// peer connected over TLS. Returns nil if the connection is not secured or the
+// peer has not presented any certificate.
+func peerPublicKey(ctx context.Context) ([]byte, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.PeerCertificates) == 0 {
+		return nil, nil
+	}
+
+	pub, ok := info.State.PeerCertificates[0].PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported certificate key type %T",
+			errPeerNotAuthenticated, info.State.PeerCertificates[0].PublicKey)
+	}
+
+	return (*keys.PublicKey)(pub).Bytes(), nil
+}
+
+// verifyPeer checks the remote peer when mutual TLS authentication is
+// enabled. Peers presenting the certificate must have one of the allowed
+// keys. The requests of the other peers (e.g. nodes connected to the
+// endpoints not requesting certificates) must be signed by one of the allowed
+// keys, nil req means the request signature and its key have already been
+// verified by the caller.
+func (s *Service) verifyPeer(ctx context.Context, req message, allowed func(pub []byte) bool) error {
+	if !s.mutualTLS {
+		return nil
+	}
+
+	pub, err := peerPublicKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	if pub == nil {
+		if req == nil {
+			return nil
+		}
+
+		if err = verifyMessage(req); err != nil {
+			return fmt.Errorf("%w: request from the peer without node certificate must be signed: %v",
+				errPeerNotAuthenticated, err)
+		}
+
+		if key := req.GetSignature().GetKey(); !allowed(key) {
+			return fmt.Errorf("%w: unknown request signer key %x", errPeerNotAuthenticated, key)
+		}
+
+		return nil
+	}
+
+	if !allowed(pub) {
+		return fmt.Errorf("%w: unknown certificate key %x", errPeerNotAuthenticated, pub)
+	}
+
+	return nil
+}
+
+// isNetmapNode checks whether the node with the given public key is present
+// in the current network map.
+func (s *Service) isNetmapNode(pub []byte) bool {
+	nm, err := s.nmSource.GetNetMap(0)
+	if err != nil {
+		return false
+	}
+
+	for _, n := range nm.Nodes() {
+		if bytes.Equal(n.PublicKey(), pub) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,79 @@
+package tree
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func tlsPeerContext(t *testing.T, certs ...tls.Certificate) context.Context {
+	var state tls.ConnectionState
+	for i := range certs {
+		c, err := x509.ParseCertificate(certs[i].Certificate[0])
+		require.NoError(t, err)
+		state.PeerCertificates = append(state.PeerCertificates, c)
+	}
+
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: state},
+	})
+}
+
+func TestNodeCertificate(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	cert, err := newNodeCertificate(&key.PrivateKey)
+	require.NoError(t, err)
+
+	pub, err := peerPublicKey(tlsPeerContext(t, cert))
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey().Bytes(), pub)
+}
+
+func TestService_verifyPeer(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	cert, err := newNodeCertificate(&key.PrivateKey)
+	require.NoError(t, err)
+
+	allowKey := func(pub []byte) bool { return bytes.Equal(pub, key.PublicKey().Bytes()) }
+	denyAll := func([]byte) bool { return false }
+
+	unsigned := &GetOpLogRequest{Body: &GetOpLogRequest_Body{TreeId: "tree"}}
+	signed := &GetOpLogRequest{Body: &GetOpLogRequest_Body{TreeId: "tree"}}
+	require.NoError(t, SignMessage(signed, &key.PrivateKey))
+
+	t.Run("disabled", func(t *testing.T) {
+		var s Service
+		require.NoError(t, s.verifyPeer(tlsPeerContext(t, cert), unsigned, denyAll))
+	})
+
+	s := Service{cfg: cfg{mutualTLS: true}}
+
+	t.Run("plaintext", func(t *testing.T) {
+		require.ErrorIs(t, s.verifyPeer(context.Background(), unsigned, allowKey), errPeerNotAuthenticated)
+		require.ErrorIs(t, s.verifyPeer(context.Background(), signed, denyAll), errPeerNotAuthenticated)
+		require.NoError(t, s.verifyPeer(context.Background(), signed, allowKey))
+	})
+	t.Run("no certificate", func(t *testing.T) {
+		require.ErrorIs(t, s.verifyPeer(tlsPeerContext(t), unsigned, allowKey), errPeerNotAuthenticated)
+		require.ErrorIs(t, s.verifyPeer(tlsPeerContext(t), signed, denyAll), errPeerNotAuthenticated)
+		require.NoError(t, s.verifyPeer(tlsPeerContext(t), signed, allowKey))
+		require.NoError(t, s.verifyPeer(tlsPeerContext(t), nil, denyAll))
+	})
+	t.Run("unknown key", func(t *testing.T) {
+		require.ErrorIs(t, s.verifyPeer(tlsPeerContext(t, cert), signed, denyAll), errPeerNotAuthenticated)
+	})
+	t.Run("allowed key", func(t *testing.T) {
+		require.NoError(t, s.verifyPeer(tlsPeerContext(t, cert), unsigned, allowKey))
+	})
+}
@@ -2,13 +2,20 @@ package util
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 
+	"github.com/nspcc-dev/neofs-api-go/v2/accounting"
+	"github.com/nspcc-dev/neofs-api-go/v2/container"
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/reputation"
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
 	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 )
 
 type RequestMessage interface {
@@ -19,12 +26,14 @@ type RequestMessage interface {
 type ResponseMessage interface {
 	GetMetaHeader() *session.ResponseMetaHeader
 	SetMetaHeader(*session.ResponseMetaHeader)
+	GetVerificationHeader() *session.ResponseVerificationHeader
+	SetVerificationHeader(*session.ResponseVerificationHeader)
 }
 
 type UnaryHandler func(context.Context, any) (ResponseMessage, error)
 
 type SignService struct {
-	key *ecdsa.PrivateKey
+	signer signer.Signer
 }
 
 type ResponseMessageWriter func(ResponseMessage) error
@@ -42,7 +51,7 @@ type RequestMessageWriter func(any) error
 type ClientStreamCloser func() (ResponseMessage, error)
 
 type RequestMessageStreamer struct {
-	key *ecdsa.PrivateKey
+	signer signer.Signer
 
 	send RequestMessageWriter
 
@@ -55,9 +64,9 @@ type RequestMessageStreamer struct {
 	sendErr error
 }
 
-func NewUnarySignService(key *ecdsa.PrivateKey) *SignService {
+func NewUnarySignService(s signer.Signer) *SignService {
 	return &SignService{
-		key: key,
+		signer: s,
 	}
 }
 
@@ -112,7 +121,7 @@ func (s *RequestMessageStreamer) CloseAndRecv() (ResponseMessage, error) {
 		setStatusV2(resp, err)
 	}
 
-	if err = signResponse(s.key, resp, s.statusSupported); err != nil {
+	if err = signResponse(s.signer, resp); err != nil {
 		return nil, err
 	}
 
@@ -121,9 +130,9 @@ func (s *RequestMessageStreamer) CloseAndRecv() (ResponseMessage, error) {
 
 func (s *SignService) CreateRequestStreamer(sender RequestMessageWriter, closer ClientStreamCloser, blankResp ResponseConstructor) *RequestMessageStreamer {
 	return &RequestMessageStreamer{
-		key:   s.key,
-		send:  sender,
-		close: closer,
+		signer: s.signer,
+		send:   sender,
+		close:  closer,
 
 		respCons: blankResp,
 	}
@@ -147,7 +156,7 @@ func (s *SignService) HandleServerStreamRequest(
 		err = fmt.Errorf("could not verify request: %w", err)
 	} else {
 		err = respWriterCaller(func(resp ResponseMessage) error {
-			if err := signResponse(s.key, resp, statusSupported); err != nil {
+			if err := signResponse(s.signer, resp); err != nil {
 				return err
 			}
 
@@ -164,7 +173,9 @@ func (s *SignService) HandleServerStreamRequest(
 
 		setStatusV2(resp, err)
 
-		_ = signResponse(s.key, resp, false) // panics or returns nil with false arg
+		if err = signResponse(s.signer, resp); err != nil {
+			return err
+		}
 
 		return respWriter(resp)
 	}
@@ -205,7 +216,7 @@ func (s *SignService) HandleUnaryRequest(ctx context.Context, req any, handler U
 	}
 
 	// sign the response
-	if err = signResponse(s.key, resp, statusSupported); err != nil {
+	if err = signResponse(s.signer, resp); err != nil {
 		return nil, err
 	}
 
@@ -229,21 +240,221 @@ func setStatusV2(resp ResponseMessage, err error) {
 	session.SetStatus(resp, apistatus.ErrorToV2(err))
 }
 
-// signs response with private key via signature.SignServiceMessage.
-// The signature error affects the result depending on the protocol version:
-//   - if status return is supported, panics since we cannot return the failed status, because it will not be signed;
-//   - otherwise, returns error in order to transport it directly.
-func signResponse(key *ecdsa.PrivateKey, resp any, statusSupported bool) error {
-	err := signature.SignServiceMessage(key, resp)
-	if err != nil {
-		err = fmt.Errorf("could not sign response: %w", err)
+// signs response using the signer. The signature error is returned directly
+// regardless of the protocol version since the failed status can not be
+// returned without the signature too.
+func signResponse(s signer.Signer, resp ResponseMessage) error {
+	if err := signServiceResponse(s, resp); err != nil {
+		return fmt.Errorf("could not sign response: %w", err)
+	}
+
+	return nil
+}
 
-		if statusSupported {
-			// We can't pass this error as status code since response will be unsigned.
-			// Isn't expected in practice, so panic is ok here.
-			panic(err)
+type stableMarshaler interface {
+	StableMarshal([]byte) []byte
+}
+
+// signServiceResponse does the same as signature.SignServiceMessage but signs
+// via signer.Signer instead of the private key.
+func signServiceResponse(s signer.Signer, resp ResponseMessage) error {
+	var (
+		verifyHdr    = new(session.ResponseVerificationHeader)
+		verifyOrigin = resp.GetVerificationHeader()
+		err          error
+	)
+
+	if verifyOrigin == nil {
+		body, err := responseBody(resp)
+		if err != nil {
+			return err
 		}
+
+		sig, err := signPart(s, body)
+		if err != nil {
+			return fmt.Errorf("could not sign body: %w", err)
+		}
+
+		verifyHdr.SetBodySignature(sig)
+	}
+
+	sig, err := signPart(s, resp.GetMetaHeader())
+	if err != nil {
+		return fmt.Errorf("could not sign meta header: %w", err)
+	}
+
+	verifyHdr.SetMetaSignature(sig)
+
+	var origin stableMarshaler
+	if verifyOrigin != nil {
+		origin = verifyOrigin
+	}
+
+	if sig, err = signPart(s, origin); err != nil {
+		return fmt.Errorf("could not sign origin of verification header: %w", err)
+	}
+
+	verifyHdr.SetOriginSignature(sig)
+	verifyHdr.SetOrigin(verifyOrigin)
+
+	resp.SetVerificationHeader(verifyHdr)
+
+	return nil
+}
+
+// responseBody returns body of the response. Body types are different for
+// each response, so GetBody method can not be a part of ResponseMessage.
+func responseBody(resp ResponseMessage) (stableMarshaler, error) {
+	switch v := resp.(type) {
+	default:
+		return nil, fmt.Errorf("unsupported response message %T", resp)
+
+		/* Accounting */
+	case *accounting.BalanceResponse:
+		return v.GetBody(), nil
+
+		/* Session */
+	case *session.CreateResponse:
+		return v.GetBody(), nil
+
+		/* Container */
+	case *container.PutResponse:
+		return v.GetBody(), nil
+	case *container.DeleteResponse:
+		return v.GetBody(), nil
+	case *container.GetResponse:
+		return v.GetBody(), nil
+	case *container.ListResponse:
+		return v.GetBody(), nil
+	case *container.SetExtendedACLResponse:
+		return v.GetBody(), nil
+	case *container.GetExtendedACLResponse:
+		return v.GetBody(), nil
+	case *container.AnnounceUsedSpaceResponse:
+		return v.GetBody(), nil
+
+		/* Object */
+	case *object.PutResponse:
+		return v.GetBody(), nil
+	case *object.GetResponse:
+		return v.GetBody(), nil
+	case *object.HeadResponse:
+		return v.GetBody(), nil
+	case *object.SearchResponse:
+		return v.GetBody(), nil
+	case *object.DeleteResponse:
+		return v.GetBody(), nil
+	case *object.GetRangeResponse:
+		return v.GetBody(), nil
+	case *object.GetRangeHashResponse:
+		return v.GetBody(), nil
+
+		/* Netmap */
+	case *netmap.LocalNodeInfoResponse:
+		return v.GetBody(), nil
+	case *netmap.NetworkInfoResponse:
+		return v.GetBody(), nil
+	case *netmap.SnapshotResponse:
+		return v.GetBody(), nil
+
+		/* Reputation */
+	case *reputation.AnnounceLocalTrustResponse:
+		return v.GetBody(), nil
+	case *reputation.AnnounceIntermediateResultResponse:
+		return v.GetBody(), nil
+	}
+}
+
+// SignableRequest is an interface of NeoFS request message which can be
+// signed by SignRequest.
+type SignableRequest interface {
+	RequestMessage
+	SetVerificationHeader(*session.RequestVerificationHeader)
+}
+
+// SignRequest signs the request with the given body formed by the node via
+// signer. Any verification header of the request is replaced, so the request
+// is sent on behalf of the node.
+func SignRequest(s signer.Signer, req SignableRequest, body stableMarshaler) error {
+	var verifyHdr session.RequestVerificationHeader
+
+	sig, err := signPart(s, body)
+	if err != nil {
+		return fmt.Errorf("could not sign body: %w", err)
+	}
+
+	verifyHdr.SetBodySignature(sig)
+
+	if sig, err = signPart(s, req.GetMetaHeader()); err != nil {
+		return fmt.Errorf("could not sign meta header: %w", err)
 	}
 
-	return err
+	verifyHdr.SetMetaSignature(sig)
+
+	if sig, err = signPart(s, nil); err != nil {
+		return fmt.Errorf("could not sign origin of verification header: %w", err)
+	}
+
+	verifyHdr.SetOriginSignature(sig)
+
+	req.SetVerificationHeader(&verifyHdr)
+
+	return nil
+}
+
+// ForwardedRequest is an interface of NeoFS request message which can be
+// signed by SignForwardedRequest.
+type ForwardedRequest interface {
+	SignableRequest
+	GetVerificationHeader() *session.RequestVerificationHeader
+}
+
+// SignForwardedRequest signs the request forwarded by the node via signer
+// like signature.SignServiceMessage does with the private key: verification
+// header of the request becomes the origin of the new one, so the signatures
+// of the sender are kept. The request must be signed by the sender.
+func SignForwardedRequest(s signer.Signer, req ForwardedRequest) error {
+	origin := req.GetVerificationHeader()
+	if origin == nil {
+		return errors.New("missing verification header of the forwarded request")
+	}
+
+	var verifyHdr session.RequestVerificationHeader
+
+	sig, err := signPart(s, req.GetMetaHeader())
+	if err != nil {
+		return fmt.Errorf("could not sign meta header: %w", err)
+	}
+
+	verifyHdr.SetMetaSignature(sig)
+
+	if sig, err = signPart(s, origin); err != nil {
+		return fmt.Errorf("could not sign origin of verification header: %w", err)
+	}
+
+	verifyHdr.SetOriginSignature(sig)
+	verifyHdr.SetOrigin(origin)
+
+	req.SetVerificationHeader(&verifyHdr)
+
+	return nil
+}
+
+func signPart(s signer.Signer, part stableMarshaler) (*refs.Signature, error) {
+	var data []byte
+	if part != nil {
+		data = part.StableMarshal(nil)
+	}
+
+	sigData, err := s.Sign(neofscrypto.ECDSA_SHA512, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var sig refs.Signature
+	sig.SetScheme(refs.ECDSA_SHA512)
+	sig.SetKey(s.PublicKey().Bytes())
+	sig.SetSign(sigData)
+
+	return &sig, nil
 }
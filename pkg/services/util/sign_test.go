@@ -0,0 +1,93 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-api-go/v2/accounting"
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignResponse(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var meta session.ResponseMetaHeader
+	meta.SetTTL(1)
+
+	var body accounting.BalanceResponseBody
+	body.SetBalance(new(accounting.Decimal))
+
+	var resp accounting.BalanceResponse
+	resp.SetBody(&body)
+	resp.SetMetaHeader(&meta)
+
+	require.NoError(t, signResponse(signer.FromPrivateKey(key), &resp))
+	require.NoError(t, signature.VerifyServiceMessage(&resp))
+
+	// response relayed by another node
+	var metaRelay session.ResponseMetaHeader
+	metaRelay.SetOrigin(resp.GetMetaHeader())
+	resp.SetMetaHeader(&metaRelay)
+
+	key2, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	require.NoError(t, signResponse(signer.FromPrivateKey(key2), &resp))
+	require.NoError(t, signature.VerifyServiceMessage(&resp))
+	require.Equal(t, key2.PublicKey().Bytes(), resp.GetVerificationHeader().GetMetaSignature().GetKey())
+	require.Nil(t, resp.GetVerificationHeader().GetBodySignature())
+
+	// empty response
+	var empty accounting.BalanceResponse
+
+	require.NoError(t, signResponse(signer.FromPrivateKey(key), &empty))
+	require.NoError(t, signature.VerifyServiceMessage(&empty))
+}
+
+type unsupportedResponse struct {
+	accounting.BalanceResponse
+}
+
+func TestSignResponse_Unsupported(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	require.ErrorContains(t, signResponse(signer.FromPrivateKey(key), new(unsupportedResponse)), "unsupported response message")
+}
+
+func TestSignForwardedRequest(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var meta session.RequestMetaHeader
+	meta.SetTTL(2)
+
+	var body object.HeadRequestBody
+	body.SetMainOnly(true)
+
+	var req object.HeadRequest
+	req.SetBody(&body)
+	req.SetMetaHeader(&meta)
+
+	require.Error(t, SignForwardedRequest(signer.FromPrivateKey(key), &req))
+
+	require.NoError(t, signature.SignServiceMessage(&key.PrivateKey, &req))
+
+	var metaFwd session.RequestMetaHeader
+	metaFwd.SetTTL(1)
+	metaFwd.SetOrigin(&meta)
+	req.SetMetaHeader(&metaFwd)
+
+	key2, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	require.NoError(t, SignForwardedRequest(signer.FromPrivateKey(key2), &req))
+	require.NoError(t, signature.VerifyServiceMessage(&req))
+	require.Equal(t, key2.PublicKey().Bytes(), req.GetVerificationHeader().GetMetaSignature().GetKey())
+	require.Equal(t, key.PublicKey().Bytes(), req.GetVerificationHeader().GetOrigin().GetBodySignature().GetKey())
+}
@@ -0,0 +1,78 @@
+package rate
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket limiting the number of bytes transmitted per
+// second. The bucket holds up to one second of the rate, so short bursts are
+// allowed. Limiter is safe for concurrent use. Nil Limiter does not limit
+// anything.
+type Limiter struct {
+	rate float64
+
+	mtx    sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns Limiter allowing to transmit bytesPerSec bytes per
+// second. Returns nil if bytesPerSec is zero.
+func NewLimiter(bytesPerSec uint64) *Limiter {
+	if bytesPerSec == 0 {
+		return nil
+	}
+
+	return &Limiter{
+		rate:   float64(bytesPerSec),
+		tokens: float64(bytesPerSec),
+		last:   time.Now(),
+	}
+}
+
+// WaitN blocks until n bytes may be transmitted or the context is done.
+// Requests exceeding the bucket size are allowed to borrow from the future,
+// so the following calls wait longer.
+func (l *Limiter) WaitN(ctx context.Context, n int) error {
+	if l == nil || n <= 0 {
+		return ctx.Err()
+	}
+
+	l.mtx.Lock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+	l.last = now
+	l.tokens -= float64(n)
+
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	l.mtx.Unlock()
+
+	if delay == 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		// return reserved tokens since nothing is transmitted
+		l.mtx.Lock()
+		l.tokens += float64(n)
+		l.mtx.Unlock()
+
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+package rate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("nil", func(t *testing.T) {
+		var l *Limiter
+		require.Nil(t, NewLimiter(0))
+		require.NoError(t, l.WaitN(ctx, 1<<30))
+	})
+
+	t.Run("burst", func(t *testing.T) {
+		l := NewLimiter(1000)
+
+		start := time.Now()
+		require.NoError(t, l.WaitN(ctx, 1000))
+		require.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		l := NewLimiter(1000)
+
+		require.NoError(t, l.WaitN(ctx, 1000))
+
+		start := time.Now()
+		require.NoError(t, l.WaitN(ctx, 200))
+		require.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	})
+
+	t.Run("context", func(t *testing.T) {
+		l := NewLimiter(1000)
+
+		require.NoError(t, l.WaitN(ctx, 1000))
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		require.ErrorIs(t, l.WaitN(ctx, 10000), context.DeadlineExceeded)
+	})
+}
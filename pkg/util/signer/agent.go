@@ -0,0 +1,288 @@
+package signer
+
+import (
+	"crypto/elliptic"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// Commands of the signing agent protocol.
+const (
+	// AgentCommandPublicKey requests the compressed public key of the agent.
+	AgentCommandPublicKey byte = 1
+
+	// AgentCommandSign requests the signature of the data. Payload of the
+	// request is the signature scheme (1 byte) followed by the data.
+	AgentCommandSign byte = 2
+
+	// AgentCommandDeriveKey requests the secret derived from the private key
+	// and the label (see Signer.DeriveKey). Payload of the request is the
+	// label.
+	AgentCommandDeriveKey byte = 3
+)
+
+// Statuses of the signing agent responses.
+const (
+	// AgentStatusOK is a status of the successful response, payload of the
+	// response is the command result.
+	AgentStatusOK byte = 0
+
+	// AgentStatusError is a status of the failed response, payload of the
+	// response is the UTF-8 error message.
+	AgentStatusError byte = 1
+)
+
+// AgentMaxMessageSize is the maximum size of the signing agent message.
+const AgentMaxMessageSize = 4 << 20
+
+// AgentMaxConnections is the maximum number of the simultaneous connections
+// to the signing agent, i.e. the number of the requests processed by the
+// agent concurrently.
+const AgentMaxConnections = 16
+
+// Agent is a Signer delegating signing to the external signing agent listening
+// to the local socket, so the private key is never exposed to the node.
+//
+// Agent protocol is a sequence of the request-response exchanges over the
+// stream connection. Each message is prefixed with its 4-byte big-endian
+// length. Request consists of the command byte (AgentCommandPublicKey,
+// AgentCommandSign or AgentCommandDeriveKey) followed by the command payload, response consists of the
+// status byte (AgentStatusOK or AgentStatusError) followed by the result.
+// Concurrent requests are sent over the separate connections, up to
+// AgentMaxConnections ones, idle connections are reused. Connection is
+// dropped after any failure.
+type Agent struct {
+	network, address string
+	timeout          time.Duration
+
+	pub *keys.PublicKey
+
+	// limits the number of the requests in flight
+	slots chan struct{}
+
+	mtx  sync.Mutex
+	idle []net.Conn
+}
+
+// NewAgent connects to the signing agent listening to the given address and
+// requests its public key. The address is a path to the Unix socket or a
+// TCP address in 'tcp://host:port' format. Non-positive timeout means no
+// timeout for the agent requests.
+func NewAgent(address string, timeout time.Duration) (*Agent, error) {
+	a := &Agent{
+		network: "unix",
+		address: address,
+		timeout: timeout,
+		slots:   make(chan struct{}, AgentMaxConnections),
+	}
+
+	if strings.HasPrefix(address, "tcp://") {
+		a.network, a.address = "tcp", strings.TrimPrefix(address, "tcp://")
+	}
+
+	bPub, err := a.exchange(AgentCommandPublicKey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request public key: %w", err)
+	}
+
+	a.pub, err = keys.NewPublicKeyFromBytes(bPub, elliptic.P256())
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key from agent: %w", err)
+	}
+
+	return a, nil
+}
+
+// PublicKey returns public key of the agent requested on creation.
+func (a *Agent) PublicKey() *keys.PublicKey {
+	return a.pub
+}
+
+// Sign requests the agent to sign the data using the given scheme.
+func (a *Agent) Sign(scheme neofscrypto.Scheme, data []byte) ([]byte, error) {
+	if scheme < 0 || scheme > 0xFF {
+		return nil, fmt.Errorf("unsupported signature scheme %s", scheme)
+	}
+
+	payload := make([]byte, 1+len(data))
+	payload[0] = byte(scheme)
+	copy(payload[1:], data)
+
+	sig, err := a.exchange(AgentCommandSign, payload)
+	if err != nil {
+		return nil, fmt.Errorf("sign data by agent: %w", err)
+	}
+
+	return sig, nil
+}
+
+// DeriveKey requests the agent to derive the secret from its private key and
+// the label.
+func (a *Agent) DeriveKey(label []byte) ([]byte, error) {
+	if len(label) == 0 {
+		return nil, errors.New("empty label")
+	}
+
+	key, err := a.exchange(AgentCommandDeriveKey, label)
+	if err != nil {
+		return nil, fmt.Errorf("derive key by agent: %w", err)
+	}
+
+	if len(key) != DerivedKeySize {
+		return nil, fmt.Errorf("invalid size of the derived key %d from agent", len(key))
+	}
+
+	return key, nil
+}
+
+// Close closes idle connections to the agent. Connections of the requests in
+// flight are kept until the requests are finished.
+func (a *Agent) Close() error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	var err error
+
+	for i := range a.idle {
+		if closeErr := a.idle[i].Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+
+	a.idle = nil
+
+	return err
+}
+
+// agentError is an error returned by the agent, it does not break the
+// connection.
+type agentError string
+
+func (e agentError) Error() string {
+	return "agent error: " + string(e)
+}
+
+func (a *Agent) exchange(cmd byte, payload []byte) ([]byte, error) {
+	a.slots <- struct{}{}
+	defer func() { <-a.slots }()
+
+	conn := a.takeIdle()
+	if conn == nil {
+		var err error
+
+		conn, err = net.DialTimeout(a.network, a.address, a.timeout)
+		if err != nil {
+			return nil, fmt.Errorf("connect to agent: %w", err)
+		}
+	}
+
+	res, err := a.exchangeConn(conn, cmd, payload)
+	if err != nil {
+		var errAgent agentError
+		if !errors.As(err, &errAgent) {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+
+	a.mtx.Lock()
+	a.idle = append(a.idle, conn)
+	a.mtx.Unlock()
+
+	return res, err
+}
+
+// takeIdle returns idle connection to the agent, nil if there are none.
+func (a *Agent) takeIdle() net.Conn {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if len(a.idle) == 0 {
+		return nil
+	}
+
+	conn := a.idle[len(a.idle)-1]
+	a.idle = a.idle[:len(a.idle)-1]
+
+	return conn
+}
+
+func (a *Agent) exchangeConn(conn net.Conn, cmd byte, payload []byte) ([]byte, error) {
+	if a.timeout > 0 {
+		if err := conn.SetDeadline(time.Now().Add(a.timeout)); err != nil {
+			return nil, fmt.Errorf("set deadline: %w", err)
+		}
+	}
+
+	msg := make([]byte, 1+len(payload))
+	msg[0] = cmd
+	copy(msg[1:], payload)
+
+	if err := WriteAgentMessage(conn, msg); err != nil {
+		return nil, fmt.Errorf("write request: %w", err)
+	}
+
+	resp, err := ReadAgentMessage(conn)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	if len(resp) == 0 {
+		return nil, errors.New("empty response")
+	}
+
+	switch resp[0] {
+	default:
+		return nil, fmt.Errorf("unknown response status %d", resp[0])
+	case AgentStatusOK:
+		return resp[1:], nil
+	case AgentStatusError:
+		return nil, agentError(resp[1:])
+	}
+}
+
+// WriteAgentMessage writes length-prefixed message of the signing agent
+// protocol.
+func WriteAgentMessage(w io.Writer, msg []byte) error {
+	if len(msg) > AgentMaxMessageSize {
+		return fmt.Errorf("message size %d exceeds limit %d", len(msg), AgentMaxMessageSize)
+	}
+
+	buf := make([]byte, 4+len(msg))
+	binary.BigEndian.PutUint32(buf, uint32(len(msg)))
+	copy(buf[4:], msg)
+
+	_, err := w.Write(buf)
+
+	return err
+}
+
+// ReadAgentMessage reads length-prefixed message of the signing agent
+// protocol.
+func ReadAgentMessage(r io.Reader) ([]byte, error) {
+	var bLen [4]byte
+	if _, err := io.ReadFull(r, bLen[:]); err != nil {
+		return nil, err
+	}
+
+	ln := binary.BigEndian.Uint32(bLen[:])
+	if ln > AgentMaxMessageSize {
+		return nil, fmt.Errorf("message size %d exceeds limit %d", ln, AgentMaxMessageSize)
+	}
+
+	msg := make([]byte, ln)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
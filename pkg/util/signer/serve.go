@@ -0,0 +1,77 @@
+package signer
+
+import (
+	"errors"
+	"net"
+
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// ServeAgent serves the signing agent protocol (see Agent) on the listener
+// signing the data by the given Signer until the listener is closed. Each
+// connection is served in a separate goroutine. ServeAgent allows to build the
+// signing agent on top of any Signer.
+func ServeAgent(l net.Listener, s Signer) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+
+			return err
+		}
+
+		go serveAgentConn(conn, s)
+	}
+}
+
+func serveAgentConn(conn net.Conn, s Signer) {
+	defer conn.Close()
+
+	for {
+		req, err := ReadAgentMessage(conn)
+		if err != nil {
+			return
+		}
+
+		if err = WriteAgentMessage(conn, handleAgentRequest(req, s)); err != nil {
+			return
+		}
+	}
+}
+
+func handleAgentRequest(req []byte, s Signer) []byte {
+	if len(req) == 0 {
+		return agentErrorResponse(errors.New("empty request"))
+	}
+
+	switch req[0] {
+	default:
+		return agentErrorResponse(errors.New("unknown command"))
+	case AgentCommandPublicKey:
+		return append([]byte{AgentStatusOK}, s.PublicKey().Bytes()...)
+	case AgentCommandSign:
+		if len(req) < 2 {
+			return agentErrorResponse(errors.New("missing signature scheme"))
+		}
+
+		sig, err := s.Sign(neofscrypto.Scheme(req[1]), req[2:])
+		if err != nil {
+			return agentErrorResponse(err)
+		}
+
+		return append([]byte{AgentStatusOK}, sig...)
+	case AgentCommandDeriveKey:
+		key, err := s.DeriveKey(req[1:])
+		if err != nil {
+			return agentErrorResponse(err)
+		}
+
+		return append([]byte{AgentStatusOK}, key...)
+	}
+}
+
+func agentErrorResponse(err error) []byte {
+	return append([]byte{AgentStatusError}, err.Error()...)
+}
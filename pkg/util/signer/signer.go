@@ -0,0 +1,118 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"golang.org/x/crypto/hkdf"
+)
+
+// DerivedKeySize is the size of the secret returned by Signer.DeriveKey.
+const DerivedKeySize = 32
+
+// Signer signs data on behalf of the key pair which private part may be
+// unavailable to the application.
+type Signer interface {
+	// PublicKey returns public key of the signer.
+	PublicKey() *keys.PublicKey
+
+	// Sign signs the data using the given scheme. Signer MUST support
+	// neofscrypto.ECDSA_SHA512 and neofscrypto.ECDSA_DETERMINISTIC_SHA256
+	// schemes.
+	Sign(scheme neofscrypto.Scheme, data []byte) ([]byte, error)
+
+	// DeriveKey returns DerivedKeySize-byte secret derived from the private
+	// key and the label. The secret MUST be the same for the same key and
+	// label and MUST NOT be computable without the private key, so it may be
+	// used as a symmetric encryption key.
+	DeriveKey(label []byte) ([]byte, error)
+}
+
+// FromPrivateKey returns Signer using the private key stored in memory, e.g.
+// loaded from the wallet file.
+func FromPrivateKey(key *keys.PrivateKey) Signer {
+	return keySigner{key: key}
+}
+
+type keySigner struct {
+	key *keys.PrivateKey
+}
+
+func (x keySigner) PublicKey() *keys.PublicKey {
+	return x.key.PublicKey()
+}
+
+func (x keySigner) Sign(scheme neofscrypto.Scheme, data []byte) ([]byte, error) {
+	switch scheme {
+	default:
+		return nil, fmt.Errorf("unsupported signature scheme %s", scheme)
+	case neofscrypto.ECDSA_SHA512:
+		return neofsecdsa.Signer(x.key.PrivateKey).Sign(data)
+	case neofscrypto.ECDSA_DETERMINISTIC_SHA256:
+		return neofsecdsa.SignerRFC6979(x.key.PrivateKey).Sign(data)
+	}
+}
+
+func (x keySigner) DeriveKey(label []byte) ([]byte, error) {
+	return deriveKey(x.key, label)
+}
+
+func deriveKey(key *keys.PrivateKey, label []byte) ([]byte, error) {
+	if len(label) == 0 {
+		return nil, errors.New("empty label")
+	}
+
+	res := make([]byte, DerivedKeySize)
+
+	_, err := io.ReadFull(hkdf.New(sha256.New, key.Bytes(), nil, label), res)
+	if err != nil {
+		return nil, fmt.Errorf("derive key: %w", err)
+	}
+
+	return res, nil
+}
+
+// SDK returns neofscrypto.Signer signing the data using the given scheme
+// through the Signer. The scheme MUST be either neofscrypto.ECDSA_SHA512 or
+// neofscrypto.ECDSA_DETERMINISTIC_SHA256.
+func SDK(s Signer, scheme neofscrypto.Scheme) neofscrypto.Signer {
+	return sdkSigner{signer: s, scheme: scheme}
+}
+
+type sdkSigner struct {
+	signer Signer
+	scheme neofscrypto.Scheme
+}
+
+func (x sdkSigner) Scheme() neofscrypto.Scheme {
+	return x.scheme
+}
+
+func (x sdkSigner) Sign(data []byte) ([]byte, error) {
+	return x.signer.Sign(x.scheme, data)
+}
+
+func (x sdkSigner) Public() neofscrypto.PublicKey {
+	pub := ecdsa.PublicKey(*x.signer.PublicKey())
+
+	if x.scheme == neofscrypto.ECDSA_DETERMINISTIC_SHA256 {
+		return (*neofsecdsa.PublicKeyRFC6979)(&pub)
+	}
+
+	return (*neofsecdsa.PublicKey)(&pub)
+}
+
+// User returns user.Signer signing the data using the given scheme through
+// the Signer on behalf of the user resolved from the Signer public key. The
+// scheme MUST be either neofscrypto.ECDSA_SHA512 or
+// neofscrypto.ECDSA_DETERMINISTIC_SHA256.
+func User(s Signer, scheme neofscrypto.Scheme) user.Signer {
+	return user.NewSigner(SDK(s, scheme), user.ResolveFromECDSAPublicKey(ecdsa.PublicKey(*s.PublicKey())))
+}
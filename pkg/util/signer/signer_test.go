@@ -0,0 +1,217 @@
+package signer_test
+
+import (
+	"errors"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/pkg/util/signer"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func testSigner(t *testing.T, s signer.Signer) {
+	data := []byte("Hello, world!")
+
+	for _, scheme := range []neofscrypto.Scheme{
+		neofscrypto.ECDSA_SHA512,
+		neofscrypto.ECDSA_DETERMINISTIC_SHA256,
+	} {
+		var sig neofscrypto.Signature
+		require.NoError(t, sig.Calculate(signer.SDK(s, scheme), data), scheme)
+		require.Equal(t, scheme, sig.Scheme())
+		require.Equal(t, s.PublicKey().Bytes(), sig.PublicKeyBytes())
+		require.True(t, sig.Verify(data), scheme)
+	}
+
+	_, err := s.Sign(neofscrypto.ECDSA_WALLETCONNECT+1, data)
+	require.Error(t, err)
+
+	key, err := s.DeriveKey([]byte("label"))
+	require.NoError(t, err)
+	require.Len(t, key, signer.DerivedKeySize)
+
+	key2, err := s.DeriveKey([]byte("label"))
+	require.NoError(t, err)
+	require.Equal(t, key, key2)
+
+	other, err := s.DeriveKey([]byte("other label"))
+	require.NoError(t, err)
+	require.NotEqual(t, key, other)
+
+	_, err = s.DeriveKey(nil)
+	require.Error(t, err)
+}
+
+func TestFromPrivateKey(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	s := signer.FromPrivateKey(key)
+	require.Equal(t, key.PublicKey(), s.PublicKey())
+
+	testSigner(t, s)
+
+	otherKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	derived, err := s.DeriveKey([]byte("label"))
+	require.NoError(t, err)
+
+	otherDerived, err := signer.FromPrivateKey(otherKey).DeriveKey([]byte("label"))
+	require.NoError(t, err)
+	require.NotEqual(t, derived, otherDerived)
+}
+
+func TestAgent(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	sock := filepath.Join(t.TempDir(), "signer.sock")
+
+	serve := func() net.Listener {
+		l, err := net.Listen("unix", sock)
+		require.NoError(t, err)
+
+		go func() { _ = signer.ServeAgent(l, signer.FromPrivateKey(key)) }()
+
+		return l
+	}
+
+	l := serve()
+
+	a, err := signer.NewAgent(sock, time.Second)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = a.Close() })
+
+	require.Equal(t, key.PublicKey(), a.PublicKey())
+
+	testSigner(t, a)
+
+	derived, err := a.DeriveKey([]byte("label"))
+	require.NoError(t, err)
+
+	expected, err := signer.FromPrivateKey(key).DeriveKey([]byte("label"))
+	require.NoError(t, err)
+	require.Equal(t, expected, derived)
+
+	t.Run("reconnect", func(t *testing.T) {
+		require.NoError(t, l.Close())
+		require.NoError(t, a.Close())
+
+		_, err := a.Sign(neofscrypto.ECDSA_SHA512, []byte("data"))
+		require.Error(t, err)
+
+		l = serve()
+		t.Cleanup(func() { _ = l.Close() })
+
+		testSigner(t, a)
+	})
+
+	t.Run("tcp", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = l.Close() })
+
+		go func() { _ = signer.ServeAgent(l, signer.FromPrivateKey(key)) }()
+
+		a, err := signer.NewAgent("tcp://"+l.Addr().String(), time.Second)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = a.Close() })
+
+		testSigner(t, a)
+	})
+
+	t.Run("unavailable", func(t *testing.T) {
+		_, err := signer.NewAgent(filepath.Join(t.TempDir(), "missing.sock"), time.Second)
+		require.Error(t, err)
+	})
+}
+
+// barrierSigner blocks the signing until the given number of the signing
+// requests are processed concurrently.
+type barrierSigner struct {
+	signer.Signer
+
+	wg *sync.WaitGroup
+}
+
+func (s barrierSigner) Sign(scheme neofscrypto.Scheme, data []byte) ([]byte, error) {
+	s.wg.Done()
+	s.wg.Wait()
+
+	return s.Signer.Sign(scheme, data)
+}
+
+func TestAgent_Concurrency(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var barrier sync.WaitGroup
+	barrier.Add(signer.AgentMaxConnections)
+
+	l, err := net.Listen("unix", filepath.Join(t.TempDir(), "signer.sock"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	go func() { _ = signer.ServeAgent(l, barrierSigner{Signer: signer.FromPrivateKey(key), wg: &barrier}) }()
+
+	a, err := signer.NewAgent(l.Addr().String(), 5*time.Second)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = a.Close() })
+
+	data := []byte("Hello, world!")
+	errs := make(chan error, signer.AgentMaxConnections)
+
+	// requests are processed by the agent at once, so none of them is
+	// signed if they are sent one by one
+	for i := 0; i < signer.AgentMaxConnections; i++ {
+		go func() {
+			var sig neofscrypto.Signature
+
+			err := sig.Calculate(signer.SDK(a, neofscrypto.ECDSA_SHA512), data)
+			if err == nil && !sig.Verify(data) {
+				err = errors.New("invalid signature")
+			}
+
+			errs <- err
+		}()
+	}
+
+	for i := 0; i < signer.AgentMaxConnections; i++ {
+		require.NoError(t, <-errs)
+	}
+}
+
+func BenchmarkAgent_Sign(b *testing.B) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(b, err)
+
+	l, err := net.Listen("unix", filepath.Join(b.TempDir(), "signer.sock"))
+	require.NoError(b, err)
+	b.Cleanup(func() { _ = l.Close() })
+
+	go func() { _ = signer.ServeAgent(l, signer.FromPrivateKey(key)) }()
+
+	a, err := signer.NewAgent(l.Addr().String(), time.Second)
+	require.NoError(b, err)
+	b.Cleanup(func() { _ = a.Close() })
+
+	data := make([]byte, 1024)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := a.Sign(neofscrypto.ECDSA_SHA512, data); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}